@@ -0,0 +1,178 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"time"
+)
+
+// KtCloudAPI is the whole API of a KtCloudClient, so that code using the SDK can depend on an interface
+// and be tested with ktcloudmock.KtCloudAPIMock. Depend on one of the smaller interfaces below when that is all you need.
+type KtCloudAPI interface {
+	ZoneAPI
+	AsyncJobAPI
+	ComputeAPI
+	VolumeAPI
+	NetworkAPI
+	LoadBalancerAPI
+	ImageAPI
+	TagAPI
+	KeyPairAPI
+
+	Do(ctx context.Context, command string, params url.Values, out interface{}) error
+	InvalidateCache(commands ...string)
+}
+
+// ZoneAPI lists the zones and the product types (VM specs and images) available in them.
+type ZoneAPI interface {
+	ListZones(isAvailable bool, domainId string, zoneId string, keyword string) (ListZonesResponse, error)
+	ListZonesWithContext(ctx context.Context, isAvailable bool, domainId string, zoneId string, keyword string) (ListZonesResponse, error)
+	ListAvailableProductTypes(zoneId string) (ListAvailableProductTypesResponse, error)
+	ListAvailableProductTypesWithContext(ctx context.Context, zoneId string) (ListAvailableProductTypesResponse, error)
+}
+
+// AsyncJobAPI queries and waits for async jobs.
+type AsyncJobAPI interface {
+	QueryAsyncJobResult(jobId string) (QueryAsyncJobResultResponse, error)
+	QueryAsyncJobResultWithContext(ctx context.Context, jobId string) (QueryAsyncJobResultResponse, error)
+	WaitForAsyncJob(jobId string, timeOut time.Duration) error
+	WaitForAsyncJobWithContext(ctx context.Context, jobId string, timeOut time.Duration) error
+}
+
+// ComputeAPI manages Virtual Machines.
+type ComputeAPI interface {
+	DeployVirtualMachine(vmReqInfo DeployVMReqInfo) (DeployVirtualMachineResponse, error)
+	DeployVirtualMachineWithContext(ctx context.Context, vmReqInfo DeployVMReqInfo) (DeployVirtualMachineResponse, error)
+	StartVirtualMachine(vmId string) (StartVirtualMachineResponse, error)
+	StartVirtualMachineWithContext(ctx context.Context, vmId string) (StartVirtualMachineResponse, error)
+	StopVirtualMachine(vmId string) (StopVirtualMachineResponse, error)
+	StopVirtualMachineWithContext(ctx context.Context, vmId string) (StopVirtualMachineResponse, error)
+	RebootVirtualMachine(vmId string) (RebootVirtualMachineResponse, error)
+	RebootVirtualMachineWithContext(ctx context.Context, vmId string) (RebootVirtualMachineResponse, error)
+	DestroyVirtualMachine(vmId string) (DestroyVirtualMachineResponse, error)
+	DestroyVirtualMachineWithContext(ctx context.Context, vmId string) (DestroyVirtualMachineResponse, error)
+	ListVirtualMachines(vmListReqInfo ListVMReqInfo) (ListVirtualMachinesResponse, error)
+	ListVirtualMachinesWithContext(ctx context.Context, vmListReqInfo ListVMReqInfo) (ListVirtualMachinesResponse, error)
+	ListAllVirtualMachines(vmListReqInfo ListVMReqInfo) ([]Virtualmachine, error)
+	ListAllVirtualMachinesWithContext(ctx context.Context, vmListReqInfo ListVMReqInfo) ([]Virtualmachine, error)
+	AllVirtualMachines(ctx context.Context, vmListReqInfo ListVMReqInfo) iter.Seq2[Virtualmachine, error]
+	UpdateVirtualMachine(vmId string, displayname string, haenable string) (UpdateVirtualMachineResponse, error)
+	UpdateVirtualMachineWithContext(ctx context.Context, vmId string, displayname string, haenable string) (UpdateVirtualMachineResponse, error)
+	WaitForVirtualMachineState(zoneId string, vmId string, wantedState string, timeOut time.Duration) error
+	WaitForVirtualMachineStateWithContext(ctx context.Context, zoneId string, vmId string, wantedState string, timeOut time.Duration) error
+}
+
+// VolumeAPI manages Disk Volumes.
+type VolumeAPI interface {
+	CreateVolume(req CreateVolumeReqInfo) (CreateVolumeResponse, error)
+	CreateVolumeWithContext(ctx context.Context, req CreateVolumeReqInfo) (CreateVolumeResponse, error)
+	ListVolumes(req ListVolumeReqInfo) (ListVolumesResponse, error)
+	ListVolumesWithContext(ctx context.Context, req ListVolumeReqInfo) (ListVolumesResponse, error)
+	ResizeVolume(req ResizeVolumeReqInfo) (ResizeVolumeResponse, error)
+	ResizeVolumeWithContext(ctx context.Context, req ResizeVolumeReqInfo) (ResizeVolumeResponse, error)
+	DeleteVolume(id string) (DeleteVolumeResponse, error)
+	DeleteVolumeWithContext(ctx context.Context, id string) (DeleteVolumeResponse, error)
+	AttachVolume(req AttachVolumeReqInfo) (AttachVolumeResponse, error)
+	AttachVolumeWithContext(ctx context.Context, req AttachVolumeReqInfo) (AttachVolumeResponse, error)
+	DetachVolume(req DetachVolumeReqInfo) (DetachVolumeResponse, error)
+	DetachVolumeWithContext(ctx context.Context, req DetachVolumeReqInfo) (DetachVolumeResponse, error)
+	ListAllVolumes(req ListVolumeReqInfo) ([]Volume, error)
+	ListAllVolumesWithContext(ctx context.Context, req ListVolumeReqInfo) ([]Volume, error)
+	AllVolumes(ctx context.Context, req ListVolumeReqInfo) iter.Seq2[Volume, error]
+}
+
+// NetworkAPI manages Public IPs, and their Firewall and PortForwarding Rules.
+type NetworkAPI interface {
+	AssociateIpAddress(ipReqInfo AssociatePublicIpReqInfo) (AssociateIpAddressResponse, error)
+	AssociateIpAddressWithContext(ctx context.Context, ipReqInfo AssociatePublicIpReqInfo) (AssociateIpAddressResponse, error)
+	ListPublicIpAddresses(ipListReqInfo ListPublicIpReqInfo) (ListPublicIpAddressesResponse, error)
+	ListPublicIpAddressesWithContext(ctx context.Context, ipListReqInfo ListPublicIpReqInfo) (ListPublicIpAddressesResponse, error)
+	DisassociateIpAddress(publicIpId string) (DisassociateIpAddressResponse, error)
+	DisassociateIpAddressWithContext(ctx context.Context, publicIpId string) (DisassociateIpAddressResponse, error)
+	ListAllPublicIpAddresses(req ListPublicIpReqInfo) ([]PublicIpAddress, error)
+	ListAllPublicIpAddressesWithContext(ctx context.Context, req ListPublicIpReqInfo) ([]PublicIpAddress, error)
+	AllPublicIpAddresses(ctx context.Context, req ListPublicIpReqInfo) iter.Seq2[PublicIpAddress, error]
+
+	CreateFirewallRule(filewallRuleCreateReqInfo CreateFirewallRuleReqInfo) (CreateFirewallRuleResponse, error)
+	CreateFirewallRuleWithContext(ctx context.Context, filewallRuleCreateReqInfo CreateFirewallRuleReqInfo) (CreateFirewallRuleResponse, error)
+	ListFirewallRules(filewallRuleListReqInfo ListFirewallRulesReqInfo) (ListFirewallRulesResponse, error)
+	ListFirewallRulesWithContext(ctx context.Context, filewallRuleListReqInfo ListFirewallRulesReqInfo) (ListFirewallRulesResponse, error)
+	DeleteFirewallRule(ruleId string) (DeleteFirewallRuleResponse, error)
+	DeleteFirewallRuleWithContext(ctx context.Context, ruleId string) (DeleteFirewallRuleResponse, error)
+	ListAllFirewallRules(req ListFirewallRulesReqInfo) ([]FirewallRule, error)
+	ListAllFirewallRulesWithContext(ctx context.Context, req ListFirewallRulesReqInfo) ([]FirewallRule, error)
+	AllFirewallRules(ctx context.Context, req ListFirewallRulesReqInfo) iter.Seq2[FirewallRule, error]
+
+	CreatePortForwardingRule(portForwardingRuleCreateReqInfo CreatePortForwardingRuleReqInfo) (CreatePortForwardingRuleResponse, error)
+	CreatePortForwardingRuleWithContext(ctx context.Context, portForwardingRuleCreateReqInfo CreatePortForwardingRuleReqInfo) (CreatePortForwardingRuleResponse, error)
+	ListPortForwardingRules(portForwardingRulesListReqInfo ListPortForwardingRulesReqInfo) (ListPortForwardingRulesResponse, error)
+	ListPortForwardingRulesWithContext(ctx context.Context, portForwardingRulesListReqInfo ListPortForwardingRulesReqInfo) (ListPortForwardingRulesResponse, error)
+	DeletePortForwardingRule(ruleId string) (DeletePortForwardingRuleResponse, error)
+	DeletePortForwardingRuleWithContext(ctx context.Context, ruleId string) (DeletePortForwardingRuleResponse, error)
+	ListAllPortForwardingRules(req ListPortForwardingRulesReqInfo) ([]PortForwardingRule, error)
+	ListAllPortForwardingRulesWithContext(ctx context.Context, req ListPortForwardingRulesReqInfo) ([]PortForwardingRule, error)
+	AllPortForwardingRules(ctx context.Context, req ListPortForwardingRulesReqInfo) iter.Seq2[PortForwardingRule, error]
+}
+
+// LoadBalancerAPI manages NLBs (Network Load-Balancers) and their VMs (web servers).
+type LoadBalancerAPI interface {
+	CreateNLB(req CreateNLBReqInfo) (CreateNLBResponse, error)
+	CreateNLBWithContext(ctx context.Context, req CreateNLBReqInfo) (CreateNLBResponse, error)
+	ListNLBs(req ListNLBsReqInfo) (ListNLBsResponse, error)
+	ListNLBsWithContext(ctx context.Context, req ListNLBsReqInfo) (ListNLBsResponse, error)
+	DeleteNLB(nlbId string) (DeleteNLBResponse, error)
+	DeleteNLBWithContext(ctx context.Context, nlbId string) (DeleteNLBResponse, error)
+	AddNLBVM(req AddNLBVMReqInfo) (AddNLBVMResponse, error)
+	AddNLBVMWithContext(ctx context.Context, req AddNLBVMReqInfo) (AddNLBVMResponse, error)
+	ListNLBVMs(nlbId string) (ListNLBVMsResponse, error)
+	ListNLBVMsWithContext(ctx context.Context, nlbId string) (ListNLBVMsResponse, error)
+	RemoveNLBVM(serviceId string) (RemoveNLBVMResponse, error)
+	RemoveNLBVMWithContext(ctx context.Context, serviceId string) (RemoveNLBVMResponse, error)
+}
+
+// ImageAPI manages Templates (Server Images).
+type ImageAPI interface {
+	CreateTemplate(req *CreateTemplateReqInfo) (CreateTemplateResponse, error)
+	CreateTemplateWithContext(ctx context.Context, req *CreateTemplateReqInfo) (CreateTemplateResponse, error)
+	ListTemplates(req *ListTemplateReqInfo) (ListTemplatesResponse, error)
+	ListTemplatesWithContext(ctx context.Context, req *ListTemplateReqInfo) (ListTemplatesResponse, error)
+	DeleteTemplate(id string, zoneId string) (DeleteTemplateResponse, error)
+	DeleteTemplateWithContext(ctx context.Context, id string, zoneId string) (DeleteTemplateResponse, error)
+	ListAllTemplates(req *ListTemplateReqInfo) ([]Template, error)
+	ListAllTemplatesWithContext(ctx context.Context, req *ListTemplateReqInfo) ([]Template, error)
+	AllTemplates(ctx context.Context, req *ListTemplateReqInfo) iter.Seq2[Template, error]
+}
+
+// TagAPI manages the Tags of resources.
+type TagAPI interface {
+	CreateTags(options *CreateTagsReqInfo) (CreateTagsResponse, error)
+	CreateTagsWithContext(ctx context.Context, options *CreateTagsReqInfo) (CreateTagsResponse, error)
+	ListTags(options *ListTagsReqInfo) (ListTagsResponse, error)
+	ListTagsWithContext(ctx context.Context, options *ListTagsReqInfo) (ListTagsResponse, error)
+	DeleteTags(options *DeleteTagsReqInfo) (DeleteTagsResponse, error)
+	DeleteTagsWithContext(ctx context.Context, options *DeleteTagsReqInfo) (DeleteTagsResponse, error)
+}
+
+// KeyPairAPI manages SSH Key Pairs.
+type KeyPairAPI interface {
+	CreateSSHKeyPair(name string) (CreateSshKeyPairResponse, error)
+	CreateSSHKeyPairWithContext(ctx context.Context, name string) (CreateSshKeyPairResponse, error)
+	ListSSHKeyPairs(name string) (ListSshKeyPairsResponse, error)
+	ListSSHKeyPairsWithContext(ctx context.Context, name string) (ListSshKeyPairsResponse, error)
+	DeleteSSHKeyPair(name string) (DeleteSshKeyPairResponse, error)
+	DeleteSSHKeyPairWithContext(ctx context.Context, name string) (DeleteSshKeyPairResponse, error)
+}
+
+// Both a KtCloudClient and the *KtCloudClient returned by New() satisfy KtCloudAPI.
+var (
+	_ KtCloudAPI = KtCloudClient{}
+	_ KtCloudAPI = (*KtCloudClient)(nil)
+)
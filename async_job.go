@@ -10,11 +10,13 @@
 package ktcloudsdk
 
 import (
+	"context"
 	"net/url"
 )
 
 type JobResult struct {
-	ErrorCode int 	 `json:"errorcode"`
+	ErrorCode FlexInt `json:"errorcode"`
+	CSErrorCode FlexInt `json:"cserrorcode"`
 	ErrorText string `json:"errortext"`
 }
 
@@ -23,30 +25,33 @@ type QueryAsyncJobResultResponse struct {
 		AccountId     	string  `json:"accountid"`
 		UserId        	string  `json:"userid"`
 		Cmd           	string  `json:"cmd"`
-		JobStatus     	int 	`json:"jobstatus"`
-		JobProcStatus 	int 	`json:"jobprocstatus"`
-		JobResultCode 	int	  	`json:"jobresultcode"`
+		JobStatus     	FlexInt `json:"jobstatus"`
+		JobProcStatus 	FlexInt `json:"jobprocstatus"`
+		JobResultCode 	FlexInt `json:"jobresultcode"`
 		JobResultType 	string  `json:"jobresulttype"`
 		State 		  	string  `json:"state"`
 		JobResult 		JobResult `json:"jobresult"`
 		JobInstanceType string 	`json:"jobinstancetype"`
 		JobInstanceId 	string 	`json:"jobinstanceid"`
-		Created       	string  `json:"created"`
+		Created       	Timestamp `json:"created"`
 		JobId         	string  `json:"jobid"`
 	} `json:"queryasyncjobresultresponse"`
 }
 
 // Query KT Cloud for the state of a scheduled job
 func (c KtCloudClient) QueryAsyncJobResult(jobId string) (QueryAsyncJobResultResponse, error) {
+	return c.QueryAsyncJobResultWithContext(context.Background(), jobId)
+}
+
+// QueryAsyncJobResultWithContext is QueryAsyncJobResult with a context that bounds the underlying API call.
+func (c KtCloudClient) QueryAsyncJobResultWithContext(ctx context.Context, jobId string) (QueryAsyncJobResultResponse, error) {
 	var resp QueryAsyncJobResultResponse
 	params := url.Values{}
 
 	params.Set("jobid", jobId)
 
-	response, err := NewRequest(c, "queryAsyncJobResult", params)
-	if err != nil {
+	if err := c.Do(ctx, "queryAsyncJobResult", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(QueryAsyncJobResultResponse)
 	return resp, nil
 }
@@ -0,0 +1,234 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// Cache stores raw responses of read-only commands, grouped by command.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	Get(command string, key string) ([]byte, bool)
+	Set(command string, key string, body []byte, ttl time.Duration)
+	Invalidate(command string) // Drops every entry of the command
+}
+
+// Commands cached by WithCache(), and for how long. Their catalogs hardly ever change.
+var DefaultCacheTTLs = map[string]time.Duration{
+	"listZones":                 time.Hour,
+	"listAvailableProductTypes": 30 * time.Minute,
+	"listTemplates":             10 * time.Minute,
+}
+
+// Cached commands dropped after a successful call of a mutating command.
+// When the command starts an async job, they are dropped again once the job is over, and not cached until then.
+var defaultCacheInvalidations = map[string][]string{
+	"createTemplate": {"listTemplates", "listAvailableProductTypes"},
+	"deleteTemplate": {"listTemplates", "listAvailableProductTypes"},
+	"createTags":     {"listTemplates"},
+	"deleteTags":     {"listTemplates"},
+}
+
+// How long the commands invalidated by an async job stay uncached, at most, when nobody asks for the job status.
+const pendingJobHold = 30 * time.Minute
+
+// responseCache is the cache configuration of a client.
+type responseCache struct {
+	cache         Cache
+	ttls          map[string]time.Duration
+	invalidations map[string][]string
+
+	mu      sync.Mutex
+	pending map[string]pendingJob // Async job ID => the job of a mutating command, until it is over
+}
+
+// pendingJob holds back the caching of the commands its mutating command invalidates.
+type pendingJob struct {
+	invalidated []string
+	until       time.Time
+}
+
+// jobResponse picks the async job fields out of any '<command>response' body.
+type jobResponse map[string]struct {
+	JobId     string  `json:"jobid"`
+	JobStatus FlexInt `json:"jobstatus"`
+}
+
+func newResponseCache(cache Cache) *responseCache {
+	rc := &responseCache{
+		cache:         cache,
+		ttls:          make(map[string]time.Duration),
+		invalidations: make(map[string][]string),
+		pending:       make(map[string]pendingJob),
+	}
+	for command, ttl := range DefaultCacheTTLs {
+		rc.ttls[command] = ttl
+	}
+	for command, invalidates := range defaultCacheInvalidations {
+		rc.invalidations[command] = append([]string(nil), invalidates...)
+	}
+	return rc
+}
+
+// key identifies a call by endpoint, account and the params given by the caller.
+// It must be made before the signing params (apikey, signature, expires ...) are added.
+func (rc *responseCache) key(endpoint string, apiKey string, params url.Values) string {
+	account := sha256.Sum256([]byte(apiKey))
+	return endpoint + "|" + hex.EncodeToString(account[:8]) + "|" + params.Encode()
+}
+
+func (rc *responseCache) get(command string, key string) ([]byte, bool) {
+	if rc == nil || rc.ttls[command] <= 0 || rc.held(command) {
+		return nil, false
+	}
+	return rc.cache.Get(command, key)
+}
+
+// store keeps the response of a cacheable command, or invalidates what a mutating command may have changed.
+// params are the ones sent, and are only read.
+func (rc *responseCache) store(command string, key string, params url.Values, body []byte) {
+	if rc == nil {
+		return
+	}
+	if ttl := rc.ttls[command]; ttl > 0 && !rc.held(command) {
+		rc.cache.Set(command, key, body, ttl)
+	}
+	if invalidated := rc.invalidations[command]; len(invalidated) > 0 {
+		for _, command := range invalidated {
+			rc.cache.Invalidate(command)
+		}
+		// An async command changes things only when its job is done, so they are dropped again then.
+		if jobId, _ := parseJobResponse(body); jobId != "" {
+			rc.mu.Lock()
+			rc.pending[jobId] = pendingJob{invalidated: invalidated, until: time.Now().Add(pendingJobHold)}
+			rc.mu.Unlock()
+		}
+	}
+	if command == "queryAsyncJobResult" {
+		if _, status := parseJobResponse(body); status != 0 { // 1 - Succeeded, 2 - Failed
+			rc.jobOver(params.Get("jobid"))
+		}
+	}
+}
+
+// held checks whether a pending async job will change the responses of the command.
+func (rc *responseCache) held(command string) bool {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	now := time.Now()
+	for jobId, job := range rc.pending {
+		if now.After(job.until) {
+			delete(rc.pending, jobId)
+			continue
+		}
+		for _, invalidated := range job.invalidated {
+			if invalidated == command {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// jobOver drops what the async job changed, if it was started by a mutating command.
+func (rc *responseCache) jobOver(jobId string) {
+	rc.mu.Lock()
+	job, ok := rc.pending[jobId]
+	delete(rc.pending, jobId)
+	rc.mu.Unlock()
+	if !ok {
+		return
+	}
+	for _, command := range job.invalidated {
+		rc.cache.Invalidate(command)
+	}
+}
+
+// parseJobResponse returns the async job ID and status of a response body, if any.
+func parseJobResponse(body []byte) (string, FlexInt) {
+	var r jobResponse
+	if err := json.Unmarshal(body, &r); err != nil {
+		return "", 0
+	}
+	for _, job := range r {
+		return job.JobId, job.JobStatus
+	}
+	return "", 0
+}
+
+// Drops the cached responses of the given commands, or of all the cached commands if none is given.
+func (c KtCloudClient) InvalidateCache(commands ...string) {
+	if c.cache == nil {
+		return
+	}
+	if len(commands) == 0 {
+		for command := range c.cache.ttls {
+			commands = append(commands, command)
+		}
+	}
+	for _, command := range commands {
+		c.cache.cache.Invalidate(command)
+	}
+}
+
+// MemoryCache is an in-memory Cache. The zero value is not usable; call NewMemoryCache().
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	body    []byte
+	expires time.Time
+}
+
+// Creates an empty MemoryCache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{
+		entries: make(map[string]map[string]cacheEntry),
+	}
+}
+
+func (m *MemoryCache) Get(command string, key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, ok := m.entries[command][key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(m.entries[command], key)
+		return nil, false
+	}
+	// A copy, so that the caller can't change what is cached.
+	return append([]byte(nil), entry.body...), true
+}
+
+func (m *MemoryCache) Set(command string, key string, body []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.entries[command] == nil {
+		m.entries[command] = make(map[string]cacheEntry)
+	}
+	m.entries[command][key] = cacheEntry{
+		body:    append([]byte(nil), body...),
+		expires: time.Now().Add(ttl),
+	}
+}
+
+func (m *MemoryCache) Invalidate(command string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.entries, command)
+}
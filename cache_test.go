@@ -0,0 +1,91 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk_test
+
+import (
+	"testing"
+	"time"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+	"github.com/cloud-barista/ktcloud-sdk-go/ktcloudtest"
+)
+
+func TestMemoryCacheCopies(t *testing.T) {
+	cache := ktsdk.NewMemoryCache()
+	body := []byte(`{"listzonesresponse":{}}`)
+	cache.Set("listZones", "key", body, time.Minute)
+	body[0] = 'x'
+
+	got, ok := cache.Get("listZones", "key")
+	if !ok || string(got) != `{"listzonesresponse":{}}` {
+		t.Fatalf("Get() = %q, %v, want the body as it was set", got, ok)
+	}
+	got[0] = 'x'
+	if again, _ := cache.Get("listZones", "key"); string(again) != `{"listzonesresponse":{}}` {
+		t.Errorf("Get() = %q after the returned body was changed, want the body as it was set", again)
+	}
+}
+
+func TestCacheInvalidationByAsyncJobs(t *testing.T) {
+	srv := ktcloudtest.NewServer()
+	defer srv.Close()
+	client := srv.Client(ktsdk.WithCache(ktsdk.NewMemoryCache()))
+
+	deployed, err := client.DeployVirtualMachine(ktsdk.DeployVMReqInfo{ZoneId: ktcloudtest.ZoneSeoulM, ServiceOfferingId: "s", TemplateId: "t"})
+	if err != nil {
+		t.Fatalf("DeployVirtualMachine() = %v", err)
+	}
+	if err := client.WaitForAsyncJob(deployed.Deployvirtualmachineresponse.JobId, 10*time.Second); err != nil {
+		t.Fatalf("WaitForAsyncJob() = %v", err)
+	}
+
+	readyTemplates := func() ktsdk.Template {
+		t.Helper()
+		resp, err := client.ListTemplates(&ktsdk.ListTemplateReqInfo{TemplateFilter: ktsdk.TemplateFilterSelfExecutable})
+		if err != nil {
+			t.Fatalf("ListTemplates() = %v", err)
+		}
+		if len(resp.Listtemplatesresponse.Template) == 0 {
+			return ktsdk.Template{}
+		}
+		return resp.Listtemplatesresponse.Template[0]
+	}
+	if tmpl := readyTemplates(); tmpl.ID != "" {
+		t.Fatalf("ListTemplates() = %s, want no template yet", tmpl.ID)
+	}
+
+	// The template is only ready once the job is over, so the lists made in the meantime must not be cached.
+	srv.HoldJobs("createTemplate")
+	created, err := client.CreateTemplate(&ktsdk.CreateTemplateReqInfo{Name: "img", DisplayText: "img", OsTypeId: "os", VolumeId: deployed.Deployvirtualmachineresponse.RootId})
+	if err != nil {
+		t.Fatalf("CreateTemplate() = %v", err)
+	}
+	if tmpl := readyTemplates(); tmpl.ID != "" {
+		t.Fatalf("ListTemplates() = %s while the job is pending, want no template", tmpl.ID)
+	}
+	srv.ReleaseJobs("createTemplate")
+	if err := client.WaitForAsyncJob(created.Createtemplateresponse.JobId, 10*time.Second); err != nil {
+		t.Fatalf("WaitForAsyncJob() = %v", err)
+	}
+	tmpl := readyTemplates()
+	if tmpl.ID != created.Createtemplateresponse.ID {
+		t.Fatalf("ListTemplates() = '%s' after the job, want the new template '%s'", tmpl.ID, created.Createtemplateresponse.ID)
+	}
+
+	// Tags are part of the listed templates as well.
+	tagged, err := client.CreateTags(&ktsdk.CreateTagsReqInfo{ResourceType: "Template", ResourceIds: []string{tmpl.ID}, Tags: []ktsdk.TagArg{{Key: "env", Value: "test"}}})
+	if err != nil {
+		t.Fatalf("CreateTags() = %v", err)
+	}
+	if err := client.WaitForAsyncJob(tagged.Createtagsresponse.JobId, 10*time.Second); err != nil {
+		t.Fatalf("WaitForAsyncJob() = %v", err)
+	}
+	if value, ok := readyTemplates().Tags.Get("env"); !ok || value != "test" {
+		t.Errorf("the listed template has no 'env' tag after CreateTags()")
+	}
+}
@@ -11,304 +11,372 @@
 package ktcloudsdk
 
 import (
-	"crypto/hmac"
-	"crypto/sha1"
-	"crypto/tls"
-	"encoding/base64"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
-	"log"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
 type KtCloudClient struct {
 	// The http client for communicating
 	client *http.Client
 
+	// User-Agent header of the API calls
+	userAgent string
+
+	// Requests whose URL would be longer than postThreshold are sent with POST. 0 disables it.
+	postThreshold int
+	forcePOST     bool
+
+	// Validity window of a signed request. 0 makes signatures that never expire.
+	signatureExpiry time.Duration
+
 	// The base URL of the API
 	BaseURL string
 
 	// Credentials
 	APIKey    string
 	SecretKey string
+
+	// Debug log of the API calls. nil disables it.
+	debug *debugLogger
+
+	// Run around every call, in order.
+	interceptors []Interceptor
+
+	// OpenTelemetry instruments. nil disables them.
+	telemetry *telemetry
+
+	// Shared by all the copies of the client, and by other clients given the same one.
+	rateLimiter *RateLimiter
+
+	// Cache of the read-only catalog commands. nil disables it.
+	cache *responseCache
+
+	// When set, it replaces APIKey and SecretKey (and an empty BaseURL) on every call.
+	credentials CredentialsProvider
+
+	// Retries of failed calls. nil disables retries.
+	RetryPolicy *RetryPolicy
+
+	// Sends the requests without checking them with their Validate() method.
+	skipValidation bool
 }
 
 // Creates a new client for communicating with KT Cloud
-func (ktcloud KtCloudClient) New(apiUrl string, apiKey string, secretKey string, insecureSkipVerify bool) *KtCloudClient {
+// apiKey and secretKey may be empty when WithCredentials() is given.
+func (ktcloud KtCloudClient) New(apiUrl string, apiKey string, secretKey string, insecureSkipVerify bool, opts ...ClientOption) *KtCloudClient {
+	o := clientOptions{
+		userAgent:     DefaultUserAgent,
+		retryPolicy:   DefaultRetryPolicy(),
+		postThreshold: DefaultPOSTThreshold,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	c := &KtCloudClient{
-		client: &http.Client{
-			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{InsecureSkipVerify: insecureSkipVerify},
-				Proxy:           http.ProxyFromEnvironment,
-			},
-		},
-		BaseURL:   apiUrl,
-		APIKey:    apiKey,
-		SecretKey: secretKey,
+		client:          o.buildHTTPClient(insecureSkipVerify),
+		userAgent:       o.userAgent,
+		postThreshold:   o.postThreshold,
+		forcePOST:       o.forcePOST,
+		signatureExpiry: o.signatureExpiry,
+		BaseURL:         apiUrl,
+		APIKey:          apiKey,
+		SecretKey:       secretKey,
+		RetryPolicy:     o.retryPolicy,
+		credentials:     o.credentials,
+		debug:           o.debug,
+		interceptors:    o.interceptors,
+		telemetry:       o.telemetry(),
+		rateLimiter:     o.rateLimiter,
+		cache:           o.responseCache(),
+		skipValidation:  o.skipValidation,
 	}
 	return c
 }
 
-func NewRequest(c KtCloudClient, request string, params url.Values) (interface{}, error) {
-	client := c.client
+// Returns the http.Client used for the API calls.
+func (c KtCloudClient) HTTPClient() *http.Client {
+	if c.client == nil {
+		return http.DefaultClient
+	}
+	return c.client
+}
 
-	params.Set("apikey", c.APIKey)
-	params.Set("command", request)
-	params.Set("response", "json")
+// Calls a KT Cloud API command and returns the decoded response as one of the '...Response' types of this package.
+//
+// Deprecated: Use KtCloudClient.Do() or Call(), which decode into a caller-supplied type.
+func NewRequest(c KtCloudClient, request string, params url.Values) (interface{}, error) {
+	return NewRequestWithContext(context.Background(), c, request, params)
+}
 
-	// Generate signature for API call
-	// * Serialize parameters and sort them by key, done by Encode()
-	// * Use byte sequence for '+' character as KT Cloud requires this
-	// * For the signature only, un-encode [ and ].
-	// * Convert the entire argument string to lowercase
-	// * Calculate HMAC SHA1 of argument string with KT Cloud secret key
-	// * URL encode the string and convert to base64
-	s := params.Encode()
-	s2 := strings.Replace(s, "+", "%20", -1)
-	s3 := strings.ToLower(strings.Replace(strings.Replace(s2, "%5B", "[", -1), "%5D", "]", -1))
-	mac := hmac.New(sha1.New, []byte(c.SecretKey))
-	mac.Write([]byte(s3))
-	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
-	signature = url.QueryEscape(signature)
-
-	// Create the final URL before we issue the request
-	// For some reason KT Cloud refuses to accept '+' as a space character so we byte escape it instead.
-	url := c.BaseURL + "?" + s2 + "&signature=" + signature
-	// log.Printf("\n\n### Request URI : %s\n\n", url)	// For Testing
-
-	resp, err := client.Get(url)
-	if err != nil {
+// NewRequestWithContext is NewRequest bound to ctx. Cancelling ctx or letting its deadline expire aborts the HTTP request.
+// A command without a known response type is returned as json.RawMessage.
+//
+// Deprecated: Use KtCloudClient.Do() or Call(), which decode into a caller-supplied type.
+func NewRequestWithContext(ctx context.Context, c KtCloudClient, request string, params url.Values) (interface{}, error) {
+	var body json.RawMessage
+	if err := c.Do(ctx, request, params, &body); err != nil {
 		return nil, err
 	}
 
-	body, err := io.ReadAll(resp.Body)
-	resp.Body.Close()
+	decode, ok := responseDecoders[request]
+	if !ok {
+		return body, nil
+	}
+	return decode(request, body)
+}
+
+// Calls a KT Cloud API command and decodes the JSON response into out, which must be a pointer.
+// Any KT Cloud command can be called this way, including those this SDK doesn't wrap yet.
+// If out is nil, the response body is discarded.
+// The call goes through the interceptors given by WithInterceptors(), if any.
+func (c KtCloudClient) Do(ctx context.Context, command string, params url.Values, out interface{}) error {
+	if params == nil {
+		params = url.Values{}
+	}
+	if len(c.interceptors) == 0 {
+		return c.invoke(ctx, command, params, out)
+	}
+	return chainInterceptors(c.interceptors, c.invoke)(ctx, command, params, out)
+}
+
+// invoke is the Invoker at the end of the interceptor chain.
+func (c KtCloudClient) invoke(ctx context.Context, command string, params url.Values, out interface{}) error {
+	body, err := c.doRequest(ctx, command, params)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	if out == nil {
+		return nil
+	}
+	return decodeResponse(command, body, out)
+}
+
+// Call is a typed form of KtCloudClient.Do() : it returns the response of the command decoded as T.
+func Call[T any](ctx context.Context, c KtCloudClient, command string, params url.Values) (T, error) {
+	var resp T
+	err := c.Do(ctx, command, params, &resp)
+	return resp, err
+}
+
+func decodeResponse(command string, body []byte, out interface{}) error {
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("Failed to decode the response of '%s' from KT Cloud : %w", command, err)
 	}
-	// log.Printf("\n\n### Response from KT Cloud: %d, %s\n\n", resp.StatusCode, body)	// For Testing
-	if resp.StatusCode != 200 {
-		err = errors.New(fmt.Sprintf("Received HTTP client/server error from KT Cloud: %d - %s", resp.StatusCode, body))
+	return nil
+}
+
+func decodeAs[T any](command string, body []byte) (interface{}, error) {
+	var resp T
+	if err := decodeResponse(command, body, &resp); err != nil {
 		return nil, err
 	}
+	return resp, nil
+}
 
-	switch request {
+// Response types of the commands wrapped by this SDK, used by NewRequest().
+var responseDecoders = map[string]func(string, []byte) (interface{}, error){
 	// SSH Key
-	case "createSSHKeyPair": // Request Command according to KT Cloud API doc.
-		var decodedResponse CreateSshKeyPairResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
-
-	case "listSSHKeyPairs":
-		var decodedResponse ListSshKeyPairsResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
-		// Caution!!) When list ~, ~ KeyPair's'
-
-	case "deleteSSHKeyPair":
-		var decodedResponse DeleteSshKeyPairResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
+	"createSSHKeyPair": decodeAs[CreateSshKeyPairResponse], // Request Command according to KT Cloud API doc.
+	"listSSHKeyPairs":  decodeAs[ListSshKeyPairsResponse],  // Caution!!) When list ~, ~ KeyPair's'
+	"deleteSSHKeyPair": decodeAs[DeleteSshKeyPairResponse],
 
 	// Virtual Machine (Server)
-	case "deployVirtualMachine":
-		var decodedResponse DeployVirtualMachineResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
-
-	case "destroyVirtualMachine":
-		var decodedResponse DestroyVirtualMachineResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
-
-	case "startVirtualMachine":
-		var decodedResponse StartVirtualMachineResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
-
-	case "stopVirtualMachine":
-		var decodedResponse StopVirtualMachineResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
-
-	case "rebootVirtualMachine":
-		var decodedResponse RebootVirtualMachineResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
-
-	case "listVirtualMachines":
-		var decodedResponse ListVirtualMachinesResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
+	"deployVirtualMachine":  decodeAs[DeployVirtualMachineResponse],
+	"destroyVirtualMachine": decodeAs[DestroyVirtualMachineResponse],
+	"startVirtualMachine":   decodeAs[StartVirtualMachineResponse],
+	"stopVirtualMachine":    decodeAs[StopVirtualMachineResponse],
+	"rebootVirtualMachine":  decodeAs[RebootVirtualMachineResponse],
+	"listVirtualMachines":   decodeAs[ListVirtualMachinesResponse],
+	"updateVirtualMachine":  decodeAs[UpdateVirtualMachineResponse],
 
 	// Product Type
-	case "listAvailableProductTypes":
-		var decodedResponse ListAvailableProductTypesResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
+	"listAvailableProductTypes": decodeAs[ListAvailableProductTypesResponse],
 
 	// AsyncJob
-	case "queryAsyncJobResult":
-		var decodedResponse QueryAsyncJobResultResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
+	"queryAsyncJobResult": decodeAs[QueryAsyncJobResultResponse],
 
 	// Tag
-	case "createTags":
-		var decodedResponse CreateTagsResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
-
-	case "listTags":
-		var decodedResponse ListTagsResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
-
-	case "deleteTags":
-		var decodedResponse DeleteTagsResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
+	"createTags": decodeAs[CreateTagsResponse],
+	"listTags":   decodeAs[ListTagsResponse],
+	"deleteTags": decodeAs[DeleteTagsResponse],
 
 	// Zone
-	case "listZones":
-		var decodedResponse ListZonesResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
+	"listZones": decodeAs[ListZonesResponse],
 
 	// Firewallrule
-	case "createFirewallRule":
-		var decodedResponse CreateFirewallRuleResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
+	"createFirewallRule": decodeAs[CreateFirewallRuleResponse],
+	"listFirewallRules":  decodeAs[ListFirewallRulesResponse],
+	"deleteFirewallRule": decodeAs[DeleteFirewallRuleResponse],
 
-	case "listFirewallRules":
-		var decodedResponse ListFirewallRulesResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
+	// Public IP
+	"associateIpAddress":    decodeAs[AssociateIpAddressResponse],
+	"listPublicIpAddresses": decodeAs[ListPublicIpAddressesResponse],
+	"disassociateIpAddress": decodeAs[DisassociateIpAddressResponse],
 
-	case "deleteFirewallRule":
-		var decodedResponse DeleteFirewallRuleResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
+	// PortForwarding Rule
+	"createPortForwardingRule": decodeAs[CreatePortForwardingRuleResponse],
+	"listPortForwardingRules":  decodeAs[ListPortForwardingRulesResponse],
+	"deletePortForwardingRule": decodeAs[DeletePortForwardingRuleResponse],
 
-	// Public IP
-	case "associateIpAddress":
-		var decodedResponse AssociateIpAddressResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
+	// Disk Volume
+	"createVolume": decodeAs[CreateVolumeResponse],
+	"listVolumes":  decodeAs[ListVolumesResponse],
+	"resizeVolume": decodeAs[ResizeVolumeResponse],
+	"deleteVolume": decodeAs[DeleteVolumeResponse],
+	"attachVolume": decodeAs[AttachVolumeResponse],
+	"detachVolume": decodeAs[DetachVolumeResponse],
 
-	case "listPublicIpAddresses":
-		var decodedResponse ListPublicIpAddressesResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
+	// Load Balancer
+	"createLoadBalancer":          decodeAs[CreateNLBResponse], // Request Command according to KT Cloud API doc.
+	"listLoadBalancers":           decodeAs[ListNLBsResponse],
+	"deleteLoadBalancer":          decodeAs[DeleteNLBResponse],
+	"addLoadBalancerWebServer":    decodeAs[AddNLBVMResponse],
+	"listLoadBalancerWebServers":  decodeAs[ListNLBVMsResponse],
+	"removeLoadBalancerWebServer": decodeAs[RemoveNLBVMResponse],
 
-	case "disassociateIpAddress":
-		var decodedResponse DisassociateIpAddressResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
+	// Template (Server Image)
+	"createTemplate": decodeAs[CreateTemplateResponse], // Request Command according to KT Cloud API doc.
+	"listTemplates":  decodeAs[ListTemplatesResponse],
+	"deleteTemplate": decodeAs[DeleteTemplateResponse],
+}
 
-	// PortForwarding Rule
-	case "createPortForwardingRule":
-		var decodedResponse CreatePortForwardingRuleResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
+// doRequest signs and sends a command, and returns the raw response body.
+// A non-200 status or an error embedded in the body is returned as an *APIError.
+func (c KtCloudClient) doRequest(ctx context.Context, request string, params url.Values) (body []byte, err error) {
+	ctx, finish := c.telemetry.startCall(ctx, request, params)
+	defer func() { finish(err) }()
+
+	// The caller's params are left untouched : they may be reused for another call, and the cache key is made from them.
+	params = copyParams(params)
+
+	// c is a copy, so filling in the provided credentials here doesn't touch the caller's client.
+	if c.credentials != nil {
+		creds, err := c.credentials.Retrieve(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to Get the KT Cloud credentials : %w", err)
+		}
+		c.APIKey = creds.APIKey
+		c.SecretKey = creds.SecretKey
+		if c.BaseURL == "" {
+			c.BaseURL = creds.Endpoint
+		}
+	}
 
-	case "listPortForwardingRules":
-		var decodedResponse ListPortForwardingRulesResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
+	// Read-only catalogs (ex. listZones) may be served from the cache.
+	var cacheKey string
+	if c.cache != nil {
+		cacheKey = c.cache.key(c.BaseURL, c.APIKey, params)
+		if body, ok := c.cache.get(request, cacheKey); ok {
+			return body, nil
+		}
+	}
 
-	case "deletePortForwardingRule":
-		var decodedResponse DeletePortForwardingRuleResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
+	params.Set("apikey", c.APIKey)
+	params.Set("command", request)
+	params.Set("response", "json")
 
-	// Disk Volume
-	case "createVolume":
-		var decodedResponse CreateVolumeResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
-
-	case "listVolumes":
-		var decodedResponse ListVolumesResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
-	
-	case "resizeVolume":
-		var decodedResponse ResizeVolumeResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
-
-	case "deleteVolume":
-		var decodedResponse DeleteVolumeResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
-
-	case "attachVolume":
-		var decodedResponse AttachVolumeResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
-
-	case "detachVolume":
-		var decodedResponse DetachVolumeResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
+	policy := c.RetryPolicy
+	for attempt := 1; ; attempt++ {
+		// Signed on every attempt, so that an expiring signature stays valid across retries.
+		query := signedQuery(params, c.SecretKey, c.signatureExpiry)
+
+		// Large parameter sets (ex. userdata) don't fit in a URL, so they are sent as a form-encoded POST body.
+		method := http.MethodGet
+		if c.forcePOST || (c.postThreshold > 0 && len(c.BaseURL)+1+len(query) > c.postThreshold) {
+			method = http.MethodPost
+		}
+
+		body, err := c.throttledSend(ctx, request, method, query)
+		if err == nil {
+			c.cache.store(request, cacheKey, params, body)
+			return body, nil
+		}
+		if !policy.allows(request) || attempt >= policy.MaxAttempts || !policy.retryable(err) {
+			return nil, err
+		}
+
+		var retryAfter time.Duration
+		var apiErr *APIError
+		if errors.As(err, &apiErr) {
+			retryAfter = apiErr.RetryAfter
+		}
+		delay := policy.delay(attempt, retryAfter)
+		cblogger.Infof("Retrying '%s' in %v. (attempt: %d) : %v", request, delay, attempt, err)
+		if err := sleepContext(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
 
-	// Load Balancer
-	case "createLoadBalancer": // Request Command according to KT Cloud API doc.
-		var decodedResponse CreateNLBResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
-
-	case "listLoadBalancers":
-		var decodedResponse ListNLBsResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
-
-	case "deleteLoadBalancer":
-		var decodedResponse DeleteNLBResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
-
-	case "addLoadBalancerWebServer":
-		var decodedResponse AddNLBVMResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
-
-	case "listLoadBalancerWebServers":
-		var decodedResponse ListNLBVMsResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
-
-	case "removeLoadBalancerWebServer":
-		var decodedResponse RemoveNLBVMResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
+// copyParams returns a copy of params that can be filled in, even when params is nil.
+func copyParams(params url.Values) url.Values {
+	copied := make(url.Values, len(params)+6)
+	for k, v := range params {
+		copied[k] = append([]string(nil), v...)
+	}
+	return copied
+}
 
-	// Template (Server Image)
-	case "createTemplate": // Request Command according to KT Cloud API doc.
-		var decodedResponse CreateTemplateResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
-
-	case "listTemplates":
-		var decodedResponse ListTemplatesResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
-
-	case "deleteTemplate":
-		var decodedResponse DeleteTemplateResponse
-		json.Unmarshal(body, &decodedResponse)
-		return decodedResponse, nil
-	
-	default:
-		log.Printf("Unknown request %s", request)
+// throttledSend is send() held back by the rate limiter, if any.
+func (c KtCloudClient) throttledSend(ctx context.Context, request string, method string, query string) ([]byte, error) {
+	if c.rateLimiter == nil {
+		return c.send(ctx, request, method, query)
+	}
+	release, err := c.rateLimiter.wait(ctx, c.BaseURL, request)
+	if err != nil {
+		return nil, err
 	}
+	defer release()
+	return c.send(ctx, request, method, query)
+}
+
+// send makes a single HTTP attempt with a signed query, either in the URL (GET) or in the body (POST).
+func (c KtCloudClient) send(ctx context.Context, request string, method string, query string) ([]byte, error) {
+	var req *http.Request
+	var err error
+	if method == http.MethodPost {
+		req, err = http.NewRequestWithContext(ctx, http.MethodPost, c.BaseURL, strings.NewReader(query))
+		if err == nil {
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		}
+	} else {
+		req, err = http.NewRequestWithContext(ctx, http.MethodGet, c.BaseURL+"?"+query, nil)
+	}
+	if err != nil {
+		return nil, redactError(err)
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	c.debug.logRequest(request, method, query)
+	start := time.Now()
 
-	// only reached with unknown request
-	return "", nil
+	// Transport errors carry the signed URL, so its apikey and signature are masked before the error goes anywhere.
+	resp, err := c.HTTPClient().Do(req)
+	if err != nil {
+		err = redactError(err)
+		c.debug.logResponse(request, 0, time.Since(start), nil, err)
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	c.debug.logResponse(request, resp.StatusCode, time.Since(start), body, err)
+	if err != nil {
+		return nil, err
+	}
+	if apiErr := newAPIError(request, resp.StatusCode, body); apiErr != nil {
+		apiErr.RetryAfter = parseRetryAfter(resp.Header)
+		return nil, apiErr
+	}
+	return body, nil
 }
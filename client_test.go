@@ -0,0 +1,138 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+	"github.com/cloud-barista/ktcloud-sdk-go/ktcloudtest"
+)
+
+func TestDo(t *testing.T) {
+	srv := ktcloudtest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	var resp ktsdk.ListZonesResponse
+	if err := client.Do(context.Background(), "listZones", url.Values{"available": {"true"}}, &resp); err != nil {
+		t.Fatalf("Do() = %v", err)
+	}
+	if resp.Listzonesresponse.Count != 2 {
+		t.Errorf("Do() = %+v, want the 2 zones", resp.Listzonesresponse)
+	}
+
+	// A command this SDK doesn't wrap can be decoded into a caller's type.
+	var raw map[string]json.RawMessage
+	if err := client.Do(context.Background(), "listZones", nil, &raw); err != nil {
+		t.Fatalf("Do() = %v", err)
+	}
+	if _, ok := raw["listZonesResponse"]; !ok {
+		t.Errorf("Do() = %v, want the listZonesResponse", raw)
+	}
+
+	// Without out, the body is discarded.
+	if err := client.Do(context.Background(), "listZones", nil, nil); err != nil {
+		t.Errorf("Do() without out = %v", err)
+	}
+}
+
+func TestCall(t *testing.T) {
+	srv := ktcloudtest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	resp, err := ktsdk.Call[ktsdk.ListZonesResponse](context.Background(), *client, "listZones", nil)
+	if err != nil {
+		t.Fatalf("Call() = %v", err)
+	}
+	if resp.Listzonesresponse.Count != 2 {
+		t.Errorf("Call() = %+v, want the 2 zones", resp.Listzonesresponse)
+	}
+
+	_, err = ktsdk.Call[ktsdk.ListZonesResponse](context.Background(), *client, "noSuchCommand", nil)
+	var apiErr *ktsdk.APIError
+	if !errors.As(err, &apiErr) || apiErr.ErrorCode != ktsdk.ErrCodeUnsupportedAction {
+		t.Errorf("Call() of an unknown command = %v, want an *APIError", err)
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		call func(c *ktsdk.KtCloudClient) error
+	}{
+		{"Do", `{"listzonesresponse":{"count":"two"}}`, func(c *ktsdk.KtCloudClient) error {
+			var resp struct {
+				Listzonesresponse struct {
+					Count int `json:"count"`
+				} `json:"listzonesresponse"`
+			}
+			return c.Do(context.Background(), "listZones", nil, &resp)
+		}},
+		{"Call", `{"listzonesresponse":[]}`, func(c *ktsdk.KtCloudClient) error {
+			_, err := ktsdk.Call[ktsdk.ListZonesResponse](context.Background(), *c, "listZones", nil)
+			return err
+		}},
+		{"wrapped command", `{"listzonesresponse":[]}`, func(c *ktsdk.KtCloudClient) error {
+			_, err := c.ListZones(true, "", "", "")
+			return err
+		}},
+		{"NewRequest", `{"listzonesresponse":[]}`, func(c *ktsdk.KtCloudClient) error {
+			_, err := ktsdk.NewRequest(*c, "listZones", nil)
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := ktcloudtest.NewServer()
+			defer srv.Close()
+			srv.InjectFault(ktcloudtest.Fault{Command: "listZones", Body: tt.body})
+			client := srv.Client(ktsdk.WithRetryPolicy(nil))
+
+			err := tt.call(client)
+			var syntaxErr *json.SyntaxError
+			var typeErr *json.UnmarshalTypeError
+			if !errors.As(err, &typeErr) && !errors.As(err, &syntaxErr) {
+				t.Errorf("call = %v, want a JSON decoding error", err)
+			}
+			if ktsdk.IsRetryable(err) || ktsdk.IsNotFound(err) {
+				t.Errorf("call = %v, a decoding error must not be classified as an API error", err)
+			}
+		})
+	}
+}
+
+func TestParamsLeftUntouched(t *testing.T) {
+	srv := ktcloudtest.NewServer()
+	defer srv.Close()
+	var requests requestRecorder
+	client := srv.Client(ktsdk.WithSignatureExpiry(time.Minute), ktsdk.WithTransport(&requests),
+		ktsdk.WithCache(ktsdk.NewMemoryCache()))
+
+	params := url.Values{"available": {"true"}}
+	for i := 0; i < 2; i++ {
+		if err := client.Do(context.Background(), "listZones", params, nil); err != nil {
+			t.Fatalf("Do() = %v", err)
+		}
+		if want := (url.Values{"available": {"true"}}); !reflect.DeepEqual(params, want) {
+			t.Fatalf("params = %v after the call, want %v", params, want)
+		}
+	}
+	// The same params make the same cache key, so the second call is served from the cache.
+	if got := requests.count(); got != 1 {
+		t.Errorf("%d requests sent, want 1", got)
+	}
+}
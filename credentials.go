@@ -0,0 +1,300 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Environment variables read by EnvProvider and ProfileProvider.
+const (
+	EnvAPIKey      = "KTCLOUD_API_KEY"
+	EnvSecretKey   = "KTCLOUD_SECRET_KEY"
+	EnvAPIURL      = "KTCLOUD_API_URL"
+	EnvProfile     = "KTCLOUD_PROFILE"
+	EnvCredentials = "KTCLOUD_CREDENTIALS_FILE"
+)
+
+// ErrNoCredentials is returned (wrapped) by a CredentialsProvider that has no credentials to give.
+var ErrNoCredentials = errors.New("No KT Cloud credentials found")
+
+// Credentials are the keys used to sign API calls.
+type Credentials struct {
+	APIKey    string
+	SecretKey string
+	Endpoint  string // Optional. API URL used when the client has no BaseURL.
+}
+
+// CredentialsProvider supplies the credentials of a KtCloudClient.
+// It is consulted on every API call, so a provider may return rotated keys at any time.
+type CredentialsProvider interface {
+	Retrieve(ctx context.Context) (Credentials, error)
+}
+
+// CredentialsProviderFunc adapts a function to a CredentialsProvider.
+type CredentialsProviderFunc func(ctx context.Context) (Credentials, error)
+
+func (f CredentialsProviderFunc) Retrieve(ctx context.Context) (Credentials, error) {
+	return f(ctx)
+}
+
+// StaticProvider always returns the same credentials.
+type StaticProvider struct {
+	Credentials Credentials
+}
+
+func (p StaticProvider) Retrieve(ctx context.Context) (Credentials, error) {
+	if p.Credentials.APIKey == "" || p.Credentials.SecretKey == "" {
+		return Credentials{}, fmt.Errorf("Static credentials are empty : %w", ErrNoCredentials)
+	}
+	return p.Credentials, nil
+}
+
+// EnvProvider reads KTCLOUD_API_KEY, KTCLOUD_SECRET_KEY and, optionally, KTCLOUD_API_URL.
+type EnvProvider struct{}
+
+func (p EnvProvider) Retrieve(ctx context.Context) (Credentials, error) {
+	creds := Credentials{
+		APIKey:    os.Getenv(EnvAPIKey),
+		SecretKey: os.Getenv(EnvSecretKey),
+		Endpoint:  os.Getenv(EnvAPIURL),
+	}
+	if creds.APIKey == "" || creds.SecretKey == "" {
+		return Credentials{}, fmt.Errorf("%s or %s is not set : %w", EnvAPIKey, EnvSecretKey, ErrNoCredentials)
+	}
+	return creds, nil
+}
+
+// ProfileProvider reads a named profile from an INI style credentials file, like below.
+//
+//	[default]
+//	api_key    = ...
+//	secret_key = ...
+//	endpoint   = https://api.ucloudbiz.olleh.com/server/v1/client/api
+//
+// or from a YAML one, like below.
+//
+//	default:
+//	  api_key: ...
+//	  secret_key: ...
+//	  endpoint: https://api.ucloudbiz.olleh.com/server/v1/client/api
+//
+// A '.yaml' or '.yml' file is read as YAML, an '.ini' file as INI. Otherwise the content decides :
+// a file starting with a '[section]' is INI, anything else YAML.
+// The file is read once, and read again when its modification time or size changes, so edits take effect
+// without rebuilding the client. Refresh() makes the next Retrieve() read it again in any case.
+type ProfileProvider struct {
+	Filename string // Default : $KTCLOUD_CREDENTIALS_FILE, or ~/.ktcloud/credentials
+	Profile  string // Default : $KTCLOUD_PROFILE, or "default"
+}
+
+func (p ProfileProvider) Retrieve(ctx context.Context) (Credentials, error) {
+	filename, err := p.filename()
+	if err != nil {
+		return Credentials{}, err
+	}
+	profile := p.Profile
+	if profile == "" {
+		profile = os.Getenv(EnvProfile)
+	}
+	if profile == "" {
+		profile = "default"
+	}
+
+	data, err := readProfileFile(filename)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return Credentials{}, fmt.Errorf("Credentials file '%s' doesn't exist : %w", filename, ErrNoCredentials)
+		}
+		return Credentials{}, err
+	}
+
+	var creds Credentials
+	var found bool
+	if isYAMLCredentials(filename, data) {
+		creds, found, err = parseYAMLProfile(data, profile)
+	} else {
+		creds, found, err = parseINIProfile(data, profile)
+	}
+	if err != nil {
+		return Credentials{}, fmt.Errorf("Failed to Read the Credentials file '%s' : %w", filename, err)
+	}
+
+	if !found || creds.APIKey == "" || creds.SecretKey == "" {
+		return Credentials{}, fmt.Errorf("Profile '%s' in '%s' has no keys : %w", profile, filename, ErrNoCredentials)
+	}
+	return creds, nil
+}
+
+// Makes the next Retrieve() read the credentials file again, even if its modification time didn't change.
+func (p ProfileProvider) Refresh() error {
+	filename, err := p.filename()
+	if err != nil {
+		return err
+	}
+	profileFiles.Lock()
+	defer profileFiles.Unlock()
+	delete(profileFiles.files, filename)
+	return nil
+}
+
+// profileFiles keeps the content of the credentials files read by ProfileProvider, until they change.
+var profileFiles = struct {
+	sync.Mutex
+	files map[string]profileFile
+}{files: make(map[string]profileFile)}
+
+type profileFile struct {
+	modTime time.Time
+	size    int64
+	data    []byte
+}
+
+// readProfileFile returns the content of filename, read again only when its modification time or size changed.
+func readProfileFile(filename string) ([]byte, error) {
+	info, err := os.Stat(filename)
+	if err != nil {
+		return nil, err
+	}
+	profileFiles.Lock()
+	defer profileFiles.Unlock()
+	if f, ok := profileFiles.files[filename]; ok && f.modTime.Equal(info.ModTime()) && f.size == info.Size() {
+		return f.data, nil
+	}
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		delete(profileFiles.files, filename)
+		return nil, err
+	}
+	profileFiles.files[filename] = profileFile{modTime: info.ModTime(), size: info.Size(), data: data}
+	return data, nil
+}
+
+// isYAMLCredentials tells the format of a credentials file by its extension, or else by its first line.
+func isYAMLCredentials(filename string, data []byte) bool {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".yaml", ".yml":
+		return true
+	case ".ini":
+		return false
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		return !strings.HasPrefix(line, "[")
+	}
+	return false
+}
+
+// parseINIProfile reads the keys of the profile section. found tells whether the section is there.
+func parseINIProfile(data []byte, profile string) (creds Credentials, found bool, err error) {
+	section := ""
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			if section == profile {
+				found = true
+			}
+			continue
+		}
+		if section != profile {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		creds.set(key, strings.Trim(strings.TrimSpace(value), `"'`))
+	}
+	return creds, found, scanner.Err()
+}
+
+// parseYAMLProfile reads the keys of the profile mapping. found tells whether the mapping is there.
+func parseYAMLProfile(data []byte, profile string) (creds Credentials, found bool, err error) {
+	var profiles map[string]map[string]string
+	if err := yaml.Unmarshal(data, &profiles); err != nil {
+		return Credentials{}, false, err
+	}
+	keys, found := profiles[profile]
+	for key, value := range keys {
+		creds.set(key, value)
+	}
+	return creds, found, nil
+}
+
+// set fills in the field named by a key of a credentials file. Unknown keys are ignored.
+func (creds *Credentials) set(key string, value string) {
+	switch strings.ToLower(strings.TrimSpace(key)) {
+	case "api_key", "apikey":
+		creds.APIKey = value
+	case "secret_key", "secretkey":
+		creds.SecretKey = value
+	case "endpoint", "api_url":
+		creds.Endpoint = value
+	}
+}
+
+func (p ProfileProvider) filename() (string, error) {
+	if p.Filename != "" {
+		return p.Filename, nil
+	}
+	if f := os.Getenv(EnvCredentials); f != "" {
+		return f, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("Failed to Find the Home directory : %w", err)
+	}
+	return filepath.Join(home, ".ktcloud", "credentials"), nil
+}
+
+// ChainProvider returns the credentials of the first provider that has them.
+// A provider failing with anything other than ErrNoCredentials stops the chain.
+type ChainProvider []CredentialsProvider
+
+func (chain ChainProvider) Retrieve(ctx context.Context) (Credentials, error) {
+	var errs []error
+	for _, p := range chain {
+		creds, err := p.Retrieve(ctx)
+		if err == nil {
+			return creds, nil
+		}
+		if !errors.Is(err, ErrNoCredentials) {
+			return Credentials{}, err
+		}
+		errs = append(errs, err)
+	}
+	if len(errs) == 0 {
+		return Credentials{}, ErrNoCredentials
+	}
+	return Credentials{}, errors.Join(errs...)
+}
+
+// Returns the chain of the environment variables, then the default profile file.
+func DefaultCredentialsChain() ChainProvider {
+	return ChainProvider{EnvProvider{}, ProfileProvider{}}
+}
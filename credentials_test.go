@@ -0,0 +1,134 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+)
+
+func TestProfileProvider(t *testing.T) {
+	const ini = `# KT Cloud
+[default]
+api_key    = default-key
+secret_key = "default-secret"
+
+[prod]
+api_key    = prod-key
+secret_key = prod-secret
+endpoint   = https://api.ucloudbiz.olleh.com/server/v2/client/api
+`
+	const yml = `# KT Cloud
+default:
+  api_key: default-key
+  secret_key: "default-secret"
+prod:
+  apikey: prod-key
+  secretkey: prod-secret
+  endpoint: https://api.ucloudbiz.olleh.com/server/v2/client/api
+`
+	prod := ktsdk.Credentials{APIKey: "prod-key", SecretKey: "prod-secret", Endpoint: ktsdk.APIv2URL}
+
+	tests := []struct {
+		name     string
+		filename string
+		content  string
+		profile  string
+		want     ktsdk.Credentials
+		wantErr  error // nil when credentials are expected
+	}{
+		{"ini default", "credentials.ini", ini, "", ktsdk.Credentials{APIKey: "default-key", SecretKey: "default-secret"}, nil},
+		{"ini profile by content", "credentials", ini, "prod", prod, nil},
+		{"yaml default", "credentials.yaml", yml, "", ktsdk.Credentials{APIKey: "default-key", SecretKey: "default-secret"}, nil},
+		{"yml profile", "credentials.yml", yml, "prod", prod, nil},
+		{"yaml profile by content", "credentials", yml, "prod", prod, nil},
+		{"missing profile", "credentials.yaml", yml, "dev", ktsdk.Credentials{}, ktsdk.ErrNoCredentials},
+		{"missing file", "", "", "", ktsdk.Credentials{}, ktsdk.ErrNoCredentials},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filename := filepath.Join(t.TempDir(), "credentials")
+			if tt.filename != "" {
+				filename = filepath.Join(filepath.Dir(filename), tt.filename)
+				if err := os.WriteFile(filename, []byte(tt.content), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			creds, err := ktsdk.ProfileProvider{Filename: filename, Profile: tt.profile}.Retrieve(context.Background())
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Retrieve() = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Retrieve() = %v", err)
+			}
+			if creds != tt.want {
+				t.Errorf("Retrieve() = %+v, want %+v", creds, tt.want)
+			}
+		})
+	}
+}
+
+func TestProfileProviderReload(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "credentials")
+	modTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	write := func(apiKey string, modTime time.Time) {
+		t.Helper()
+		if err := os.WriteFile(filename, []byte("[default]\napi_key = "+apiKey+"\nsecret_key = secret\n"), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(filename, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	provider := ktsdk.ProfileProvider{Filename: filename}
+	retrieve := func(want string) {
+		t.Helper()
+		creds, err := provider.Retrieve(context.Background())
+		if err != nil {
+			t.Fatalf("Retrieve() = %v", err)
+		}
+		if creds.APIKey != want {
+			t.Errorf("api_key = %s, want %s", creds.APIKey, want)
+		}
+	}
+
+	write("key-1", modTime)
+	retrieve("key-1")
+
+	// Same modification time and size : the file isn't read again.
+	write("key-2", modTime)
+	retrieve("key-1")
+
+	// Unless asked to.
+	if err := provider.Refresh(); err != nil {
+		t.Fatalf("Refresh() = %v", err)
+	}
+	retrieve("key-2")
+
+	// A new modification time is seen on the next call.
+	write("key-3", modTime.Add(time.Minute))
+	retrieve("key-3")
+
+	// A removed file stops handing out its keys.
+	if err := os.Remove(filename); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := provider.Retrieve(context.Background()); !errors.Is(err, ktsdk.ErrNoCredentials) {
+		t.Errorf("Retrieve() of a removed file = %v, want %v", err, ktsdk.ErrNoCredentials)
+	}
+}
@@ -10,6 +10,7 @@
 package ktcloudsdk
 
 import (
+	"context"
 	"net/url"
 )
 // KT Cloud (G1/G2 Platform) Disk Volume API : https://cloud.kt.com/docs/open-api-guide/g/computing/disk-volume
@@ -18,7 +19,7 @@ type CreateVolumeReqInfo struct {
 	Name             string // Required. Volume Name.
 	DiskOfferingId   string // Required. DiskOfferingId
 	ZoneId           string // Required
-	UsagePlanType    string // default : hourly
+	UsagePlanType    UsagePlanType // default : hourly
 	Account          string
 	DomainId         string
 	Size             string // Custom size of Volume
@@ -38,7 +39,7 @@ type ListVolumeReqInfo struct {
 	Name             string // Volume Name
 	Page             string
 	PageSize         string
-	Type             string
+	Type             VolumeType
 	VMId 			 string
 	ZoneId           string
 	Install          bool // Default : false
@@ -63,17 +64,84 @@ type DetachVolumeReqInfo struct {
 	DeviceId         string
 }
 
+// Checks the fields of the request before it is sent. A problem is returned as a *ValidationError.
+func (r CreateVolumeReqInfo) Validate() error {
+	var errs fieldErrors
+	errs.required("Name", r.Name)
+	errs.required("ZoneId", r.ZoneId)
+	if r.DiskOfferingId == "" && r.ProductCode == "" {
+		errs.add("DiskOfferingId", "", "is required unless ProductCode is given")
+	}
+	knownEnum(&errs, "UsagePlanType", r.UsagePlanType)
+	errs.number("Size", r.Size, 1)
+	errs.number("IOPS", r.IOPS, 1)
+	if r.VMId != "" && r.SnapshotId == "" {
+		errs.add("VMId", r.VMId, "is only valid along with SnapshotId")
+	}
+	return errs.err("CreateVolumeReqInfo")
+}
+
+// Checks the fields of the request before it is sent. A problem is returned as a *ValidationError.
+func (r ListVolumeReqInfo) Validate() error {
+	var errs fieldErrors
+	knownEnum(&errs, "Type", r.Type)
+	errs.paging(r.Page, r.PageSize)
+	return errs.err("ListVolumeReqInfo")
+}
+
+// Checks the fields of the request before it is sent. A problem is returned as a *ValidationError.
+func (r ResizeVolumeReqInfo) Validate() error {
+	var errs fieldErrors
+	errs.required("ID", r.ID)
+	errs.required("VMId", r.VMId)
+	errs.required("Size", r.Size)
+	errs.oneOf("Size", r.Size, "50", "80", "100")
+	errs.required("IsLinux", r.IsLinux)
+	errs.oneOf("IsLinux", r.IsLinux, "Y", "N")
+	if r.Size == "50" && r.IsLinux == "N" {
+		errs.add("Size", r.Size, "of 50 is only available for Linux series (IsLinux 'Y')")
+	}
+	return errs.err("ResizeVolumeReqInfo")
+}
+
+// Checks the fields of the request before it is sent. A problem is returned as a *ValidationError.
+func (r AttachVolumeReqInfo) Validate() error {
+	var errs fieldErrors
+	errs.required("ID", r.ID)
+	errs.required("VMId", r.VMId)
+	errs.number("DeviceId", r.DeviceId, 0)
+	return errs.err("AttachVolumeReqInfo")
+}
+
+// Checks the fields of the request before it is sent. A problem is returned as a *ValidationError.
+func (r DetachVolumeReqInfo) Validate() error {
+	var errs fieldErrors
+	errs.required("ID", r.ID)
+	errs.number("DeviceId", r.DeviceId, 0)
+	return errs.err("DetachVolumeReqInfo")
+}
+
 // # Create a Disk Volume
 // DiskOfferingId : https://cloud.kt.com/docs/open-api-guide/g/computing/disk-volume
 func (c KtCloudClient) CreateVolume(req CreateVolumeReqInfo) (CreateVolumeResponse, error) {
+	return c.CreateVolumeWithContext(context.Background(), req)
+}
+
+// CreateVolumeWithContext is CreateVolume with a context that bounds the underlying API call.
+func (c KtCloudClient) CreateVolumeWithContext(ctx context.Context, req CreateVolumeReqInfo) (CreateVolumeResponse, error) {
 	var resp CreateVolumeResponse
+	if err := c.validate(req); err != nil {
+		return resp, err
+	}
 	params := url.Values{}
 	params.Set("name", req.Name)
-	params.Set("diskofferingid", req.DiskOfferingId)
+	if req.DiskOfferingId != "" {
+		params.Set("diskofferingid", req.DiskOfferingId)
+	}
 	params.Set("zoneid", req.ZoneId)
 
 	if req.UsagePlanType != "" {
-		params.Set("usageplantype", req.UsagePlanType)
+		params.Set("usageplantype", string(req.UsagePlanType.Canonical()))
 	}
 	if req.Account != "" {
 		params.Set("account", req.Account)
@@ -97,17 +165,23 @@ func (c KtCloudClient) CreateVolume(req CreateVolumeReqInfo) (CreateVolumeRespon
 		params.Set("iops", req.IOPS)
 	}
 
-	response, err := NewRequest(c, "createVolume", params)
-	if err != nil {
+	if err := c.Do(ctx, "createVolume", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(CreateVolumeResponse)
 	return resp, nil
 }
 
 // # List Disk Volumes
 func (c KtCloudClient) ListVolumes(req ListVolumeReqInfo) (ListVolumesResponse, error) {
+	return c.ListVolumesWithContext(context.Background(), req)
+}
+
+// ListVolumesWithContext is ListVolumes with a context that bounds the underlying API call.
+func (c KtCloudClient) ListVolumesWithContext(ctx context.Context, req ListVolumeReqInfo) (ListVolumesResponse, error) {
 	var resp ListVolumesResponse
+	if err := c.validate(req); err != nil {
+		return resp, err
+	}
 	params := url.Values{}
 
 	if req.Account != "" {
@@ -135,7 +209,7 @@ func (c KtCloudClient) ListVolumes(req ListVolumeReqInfo) (ListVolumesResponse,
 		params.Set("pagesize", req.PageSize)
 	}
 	if req.Type != "" {
-		params.Set("type", req.Type)
+		params.Set("type", string(req.Type.Canonical()))
 	}
 	if req.VMId != "" {
 		params.Set("virtualmachineid", req.VMId)
@@ -147,17 +221,23 @@ func (c KtCloudClient) ListVolumes(req ListVolumeReqInfo) (ListVolumesResponse,
 		params.Set("install", "true")
 	}
 
-	response, err := NewRequest(c, "listVolumes", params)
-	if err != nil {
+	if err := c.Do(ctx, "listVolumes", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(ListVolumesResponse)
 	return resp, nil
 }
 
 // # Resize(Change) Disk Volume Size (This is only for Bootalbe Disk of a VM.)
 func (c KtCloudClient) ResizeVolume(req ResizeVolumeReqInfo) (ResizeVolumeResponse, error) {
+	return c.ResizeVolumeWithContext(context.Background(), req)
+}
+
+// ResizeVolumeWithContext is ResizeVolume with a context that bounds the underlying API call.
+func (c KtCloudClient) ResizeVolumeWithContext(ctx context.Context, req ResizeVolumeReqInfo) (ResizeVolumeResponse, error) {
 	var resp ResizeVolumeResponse
+	if err := c.validate(req); err != nil {
+		return resp, err
+	}
 	params := url.Values{}
 
 	params.Set("id", req.ID) // Volume ID
@@ -165,30 +245,39 @@ func (c KtCloudClient) ResizeVolume(req ResizeVolumeReqInfo) (ResizeVolumeRespon
 	params.Set("size", req.Size)
 	params.Set("isLinux", req.IsLinux)
 
-	response, err := NewRequest(c, "resizeVolume", params)
-	if err != nil {
+	if err := c.Do(ctx, "resizeVolume", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(ResizeVolumeResponse)
 	return resp, nil
 }
 
 // # Delete a Disk Volume
 func (c KtCloudClient) DeleteVolume(id string) (DeleteVolumeResponse, error) {
+	return c.DeleteVolumeWithContext(context.Background(), id)
+}
+
+// DeleteVolumeWithContext is DeleteVolume with a context that bounds the underlying API call.
+func (c KtCloudClient) DeleteVolumeWithContext(ctx context.Context, id string) (DeleteVolumeResponse, error) {
 	var resp DeleteVolumeResponse
 	params := url.Values{}
 	params.Set("id", id)
-	response, err := NewRequest(c, "deleteVolume", params)
-	if err != nil {
+	if err := c.Do(ctx, "deleteVolume", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(DeleteVolumeResponse)
 	return resp, nil
 }
 
 // # Attach a Disk Volume to VM
 func (c KtCloudClient) AttachVolume(req AttachVolumeReqInfo) (AttachVolumeResponse, error) {
+	return c.AttachVolumeWithContext(context.Background(), req)
+}
+
+// AttachVolumeWithContext is AttachVolume with a context that bounds the underlying API call.
+func (c KtCloudClient) AttachVolumeWithContext(ctx context.Context, req AttachVolumeReqInfo) (AttachVolumeResponse, error) {
 	var resp AttachVolumeResponse
+	if err := c.validate(req); err != nil {
+		return resp, err
+	}
 	params := url.Values{}
 	
 	params.Set("id", req.ID) // Volume ID
@@ -198,17 +287,23 @@ func (c KtCloudClient) AttachVolume(req AttachVolumeReqInfo) (AttachVolumeRespon
 		params.Set("deviceid", req.DeviceId)
 	}
 
-	response, err := NewRequest(c, "attachVolume", params)
-	if err != nil {
+	if err := c.Do(ctx, "attachVolume", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(AttachVolumeResponse)
 	return resp, nil
 }
 
 // # Detach a Disk Volume from VM
 func (c KtCloudClient) DetachVolume(req DetachVolumeReqInfo) (DetachVolumeResponse, error) {
+	return c.DetachVolumeWithContext(context.Background(), req)
+}
+
+// DetachVolumeWithContext is DetachVolume with a context that bounds the underlying API call.
+func (c KtCloudClient) DetachVolumeWithContext(ctx context.Context, req DetachVolumeReqInfo) (DetachVolumeResponse, error) {
 	var resp DetachVolumeResponse
+	if err := c.validate(req); err != nil {
+		return resp, err
+	}
 	params := url.Values{}
 
 	params.Set("id", req.ID) // Volume ID
@@ -221,11 +316,9 @@ func (c KtCloudClient) DetachVolume(req DetachVolumeReqInfo) (DetachVolumeRespon
 		params.Set("deviceid", req.DeviceId)
 	}
 
-	response, err := NewRequest(c, "detachVolume", params)
-	if err != nil {
+	if err := c.Do(ctx, "detachVolume", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(DetachVolumeResponse)
 	return resp, nil
 }
 
@@ -234,21 +327,21 @@ type Volume struct {
 	Name                       	string   `json:"name"`
 	ZoneId                     	string   `json:"zoneid"`
 	ZoneName                   	string   `json:"zonename"`
-	Type                       	string   `json:"type"`
-	DeviceId                   	int      `json:"deviceid"`
+	Type                       	VolumeType   `json:"type"`
+	DeviceId                   	FlexInt  `json:"deviceid"`
 	VMId           			   	string   `json:"virtualmachineid"`
 	VMName                     	string   `json:"vmname"`
 	VMDisplayName              	string   `json:"vmdisplayname"`
-	VMState                    	string   `json:"vmstate"`
+	VMState                    	VMState   `json:"vmstate"`
 	TemplateId                 	string   `json:"templateid"`   			// Volume with the OS installed
 	TemplateName               	string   `json:"templatename"`  		// Volume with the OS installed
 	TemplateDisplayText        	string   `json:"templatedisplaytext"`	// Volume with the OS installed
 	ProvisioningType           	string   `json:"provisioningtype"`
-	Size                       	int64    `json:"size"`
-	MinIOPS                    	int64    `json:"miniops"`
-	MaxIOPS                    	int64    `json:"maxiops"`
-	Created                    	string   `json:"created"`
-	State                      	string   `json:"state"`
+	Size                       	FlexInt  `json:"size"`
+	MinIOPS                    	FlexInt  `json:"miniops"`
+	MaxIOPS                    	FlexInt  `json:"maxiops"`
+	Created                    	Timestamp `json:"created"`
+	State                      	VolumeState   `json:"state"`
 	Account                    	string   `json:"account"`
 	DomainId                   	string   `json:"domainid"`
 	Domain                     	string   `json:"domain"`
@@ -259,12 +352,12 @@ type Volume struct {
 	ServiceofferingId			string   `json:"serviceofferingid"`				// In case, Type value : ROOT
 	ServiceofferingName        	string   `json:"serviceofferingname"`			// In case, Type value : ROOT
 	ServiceofferingDisplayText 	string   `json:"serviceofferingdisplaytext"`	// In case, Type value : ROOT	
-	AttachedTime               	string   `json:"attached"`
-	Destroyed                  	bool     `json:"destroyed"`
-	IsExtractable              	bool     `json:"isextractable"`
-	QuiesceVM                  	bool     `json:"quiescevm"`
-	Tags                       	[]string `json:"tags"`
-	UsagePlanType              	string   `json:"usageplantype"`
+	AttachedTime               	Timestamp `json:"attached"`
+	Destroyed                  	FlexBool `json:"destroyed"`
+	IsExtractable              	FlexBool `json:"isextractable"`
+	QuiesceVM                  	FlexBool `json:"quiescevm"`
+	Tags                       	Tags     `json:"tags"`
+	UsagePlanType              	UsagePlanType   `json:"usageplantype"`
 	VolumeType                 	string   `json:"volumetype"`
 }
 
@@ -279,7 +372,7 @@ type CreateVolumeResponse struct {
 type ListVolumesResponse struct {
 	Listvolumesresponse struct {
 		Volume []Volume `json:"volume"`
-		Count  int      `json:"count"`
+		Count  FlexInt      `json:"count"`
 	} `json:"listvolumesresponse"`
 }
 
@@ -291,7 +384,7 @@ type ResizeVolumeResponse struct {
 
 type DeleteVolumeResponse struct {
 	Deletevolumeresponse struct {
-		Success string `json:"success"` // 'string' type of value!! 'true' or 'false'
+		Success FlexBool `json:"success"` // Sent as a string : "true" or "false"
 	} `json:"deletevolumeresponse"`
 }
 
@@ -0,0 +1,223 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// The enum types below accept any value when decoded from a response, so a value added by KT Cloud doesn't break decoding :
+//   - A known value is matched regardless of case, and normalized to its constant. (ex. "RUNNING" => VMStateRunning)
+//   - An unknown string is kept as is, and IsKnown() returns false.
+//   - A value that isn't a string (number, object ...) decodes to the Unknown constant.
+// The same goes for the values set in requests : they are matched regardless of case (ex. Protocol("TCP")),
+// and sent as their constant.
+//
+// Every enum type has the same three methods :
+//   - UnmarshalJSON() decodes as described above.
+//   - IsKnown() checks whether the value is one of its constants (other than the Unknown one), regardless of case.
+//   - Canonical() returns the constant matching the value regardless of case, or the value as is if there is none.
+
+// VMState is the state of a Virtual Machine.
+type VMState string
+
+const (
+	VMStateUnknown   VMState = ""
+	VMStateRunning   VMState = "Running"
+	VMStateStopped   VMState = "Stopped"
+	VMStateStarting  VMState = "Starting"
+	VMStateStopping  VMState = "Stopping"
+	VMStateDestroyed VMState = "Destroyed"
+	VMStateExpunging VMState = "Expunging"
+	VMStateMigrating VMState = "Migrating"
+	VMStateError     VMState = "Error"
+)
+
+var vmStates = enumSet[VMState]{VMStateRunning, VMStateStopped, VMStateStarting, VMStateStopping,
+	VMStateDestroyed, VMStateExpunging, VMStateMigrating, VMStateError}
+
+func (s *VMState) UnmarshalJSON(data []byte) error { return vmStates.unmarshal(s, data) }
+func (s VMState) IsKnown() bool                    { return vmStates.isKnown(s) }
+func (s VMState) Canonical() VMState               { return vmStates.canonical(s) }
+
+// VolumeState is the state of a Disk Volume.
+type VolumeState string
+
+const (
+	VolumeStateUnknown   VolumeState = ""
+	VolumeStateAllocated VolumeState = "Allocated" // Created, but not attached to a VM yet
+	VolumeStateCreating  VolumeState = "Creating"
+	VolumeStateReady     VolumeState = "Ready"
+	VolumeStateResizing  VolumeState = "Resizing"
+	VolumeStateMigrating VolumeState = "Migrating"
+	VolumeStateDestroy   VolumeState = "Destroy"
+	VolumeStateExpunging VolumeState = "Expunging"
+)
+
+var volumeStates = enumSet[VolumeState]{VolumeStateAllocated, VolumeStateCreating, VolumeStateReady, VolumeStateResizing,
+	VolumeStateMigrating, VolumeStateDestroy, VolumeStateExpunging}
+
+func (s *VolumeState) UnmarshalJSON(data []byte) error { return volumeStates.unmarshal(s, data) }
+func (s VolumeState) IsKnown() bool                    { return volumeStates.isKnown(s) }
+func (s VolumeState) Canonical() VolumeState           { return volumeStates.canonical(s) }
+
+// VolumeType tells a bootable (ROOT) volume from an additional (DATADISK) one.
+type VolumeType string
+
+const (
+	VolumeTypeUnknown  VolumeType = ""
+	VolumeTypeRoot     VolumeType = "ROOT"
+	VolumeTypeDataDisk VolumeType = "DATADISK"
+)
+
+var volumeTypes = enumSet[VolumeType]{VolumeTypeRoot, VolumeTypeDataDisk}
+
+func (t *VolumeType) UnmarshalJSON(data []byte) error { return volumeTypes.unmarshal(t, data) }
+func (t VolumeType) IsKnown() bool                    { return volumeTypes.isKnown(t) }
+func (t VolumeType) Canonical() VolumeType            { return volumeTypes.canonical(t) }
+
+// NLBOption is the balancing method of a Load-Balancer.
+type NLBOption string
+
+const (
+	NLBOptionUnknown          NLBOption = ""
+	NLBOptionRoundRobin       NLBOption = "roundrobin"
+	NLBOptionLeastConnection  NLBOption = "leastconnection"
+	NLBOptionLeastResponse    NLBOption = "leastresponse"
+	NLBOptionSourceIPHash     NLBOption = "sourceiphash"
+	NLBOptionSrcIPSrcPortHash NLBOption = "srcipsrcporthash"
+)
+
+var nlbOptions = enumSet[NLBOption]{NLBOptionRoundRobin, NLBOptionLeastConnection, NLBOptionLeastResponse,
+	NLBOptionSourceIPHash, NLBOptionSrcIPSrcPortHash}
+
+func (o *NLBOption) UnmarshalJSON(data []byte) error { return nlbOptions.unmarshal(o, data) }
+func (o NLBOption) IsKnown() bool                    { return nlbOptions.isKnown(o) }
+func (o NLBOption) Canonical() NLBOption             { return nlbOptions.canonical(o) }
+
+// ServiceType is the protocol a Load-Balancer serves.
+type ServiceType string
+
+const (
+	ServiceTypeUnknown   ServiceType = ""
+	ServiceTypeHTTPS     ServiceType = "https"
+	ServiceTypeHTTP      ServiceType = "http"
+	ServiceTypeSSLBridge ServiceType = "sslbridge"
+	ServiceTypeTCP       ServiceType = "tcp"
+	ServiceTypeFTP       ServiceType = "ftp"
+)
+
+var serviceTypes = enumSet[ServiceType]{ServiceTypeHTTPS, ServiceTypeHTTP, ServiceTypeSSLBridge, ServiceTypeTCP, ServiceTypeFTP}
+
+func (t *ServiceType) UnmarshalJSON(data []byte) error { return serviceTypes.unmarshal(t, data) }
+func (t ServiceType) IsKnown() bool                    { return serviceTypes.isKnown(t) }
+func (t ServiceType) Canonical() ServiceType           { return serviceTypes.canonical(t) }
+
+// HealthCheckType is how a Load-Balancer checks its VMs.
+type HealthCheckType string
+
+const (
+	HealthCheckTypeUnknown HealthCheckType = ""
+	HealthCheckTypeHTTP    HealthCheckType = "http"
+	HealthCheckTypeHTTPS   HealthCheckType = "https"
+	HealthCheckTypeTCP     HealthCheckType = "tcp"
+)
+
+var healthCheckTypes = enumSet[HealthCheckType]{HealthCheckTypeHTTP, HealthCheckTypeHTTPS, HealthCheckTypeTCP}
+
+func (t *HealthCheckType) UnmarshalJSON(data []byte) error {
+	return healthCheckTypes.unmarshal(t, data)
+}
+func (t HealthCheckType) IsKnown() bool              { return healthCheckTypes.isKnown(t) }
+func (t HealthCheckType) Canonical() HealthCheckType { return healthCheckTypes.canonical(t) }
+
+// Protocol is the protocol of a Firewall or PortForwarding Rule.
+type Protocol string
+
+const (
+	ProtocolUnknown Protocol = ""
+	ProtocolTCP     Protocol = "tcp"
+	ProtocolUDP     Protocol = "udp"
+	ProtocolICMP    Protocol = "icmp" // Firewall Rule only
+)
+
+var protocols = enumSet[Protocol]{ProtocolTCP, ProtocolUDP, ProtocolICMP}
+
+func (p *Protocol) UnmarshalJSON(data []byte) error { return protocols.unmarshal(p, data) }
+func (p Protocol) IsKnown() bool                    { return protocols.isKnown(p) }
+func (p Protocol) Canonical() Protocol              { return protocols.canonical(p) }
+
+// UsagePlanType is the billing plan of a VM, Volume or Public IP.
+type UsagePlanType string
+
+const (
+	UsagePlanTypeUnknown UsagePlanType = ""
+	UsagePlanTypeHourly  UsagePlanType = "hourly"
+	UsagePlanTypeMonthly UsagePlanType = "monthly"
+)
+
+var usagePlanTypes = enumSet[UsagePlanType]{UsagePlanTypeHourly, UsagePlanTypeMonthly}
+
+func (t *UsagePlanType) UnmarshalJSON(data []byte) error { return usagePlanTypes.unmarshal(t, data) }
+func (t UsagePlanType) IsKnown() bool                    { return usagePlanTypes.isKnown(t) }
+func (t UsagePlanType) Canonical() UsagePlanType         { return usagePlanTypes.canonical(t) }
+
+// TemplateFilter selects the templates listed by ListTemplates().
+type TemplateFilter string
+
+const (
+	TemplateFilterUnknown        TemplateFilter = ""
+	TemplateFilterFeatured       TemplateFilter = "featured"       // Provided by KT Cloud
+	TemplateFilterSelf           TemplateFilter = "self"           // Created by the user
+	TemplateFilterSelfExecutable TemplateFilter = "selfexecutable" // Created by the user, and ready to use
+	TemplateFilterExecutable     TemplateFilter = "executable"     // Usable by the user
+	TemplateFilterCommunity      TemplateFilter = "community"      // Public
+)
+
+var templateFilters = enumSet[TemplateFilter]{TemplateFilterFeatured, TemplateFilterSelf, TemplateFilterSelfExecutable,
+	TemplateFilterExecutable, TemplateFilterCommunity}
+
+func (f *TemplateFilter) UnmarshalJSON(data []byte) error { return templateFilters.unmarshal(f, data) }
+func (f TemplateFilter) IsKnown() bool                    { return templateFilters.isKnown(f) }
+func (f TemplateFilter) Canonical() TemplateFilter        { return templateFilters.canonical(f) }
+
+// enumSet lists the constants of an enum type, other than its Unknown one.
+// The UnmarshalJSON, IsKnown and Canonical methods of the enum types above are built on it.
+type enumSet[T ~string] []T
+
+// unmarshal decodes a JSON value into v, as described at the top of this file. It never fails.
+func (e enumSet[T]) unmarshal(v *T, data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		*v = ""
+		return nil
+	}
+	*v = e.canonical(T(s))
+	return nil
+}
+
+// isKnown checks whether v is one of the constants, regardless of case.
+func (e enumSet[T]) isKnown(v T) bool {
+	for _, k := range e {
+		if strings.EqualFold(string(k), string(v)) {
+			return true
+		}
+	}
+	return false
+}
+
+// canonical returns the constant matching v regardless of case, or v as is if there is none.
+func (e enumSet[T]) canonical(v T) T {
+	for _, k := range e {
+		if strings.EqualFold(string(k), string(v)) {
+			return k
+		}
+	}
+	return v
+}
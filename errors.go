@@ -0,0 +1,170 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// KT Cloud (CloudStack based) API error codes returned in the 'errorcode' field.
+const (
+	ErrCodeUnauthorized         = 401
+	ErrCodeNotFound             = 404
+	ErrCodeAPILimitExceeded     = 429
+	ErrCodeMalformedParameter   = 430
+	ErrCodeParamError           = 431
+	ErrCodeUnsupportedAction    = 432
+	ErrCodeInternalError        = 530
+	ErrCodeAccountError         = 531
+	ErrCodeAccountResourceLimit = 532
+	ErrCodeInsufficientCapacity = 533
+	ErrCodeResourceUnavailable  = 534
+	ErrCodeResourceAllocation   = 535
+	ErrCodeResourceInUse        = 536
+	ErrCodeNetworkRuleConflict  = 537
+)
+
+// APIError is returned by every client method when KT Cloud rejects a call,
+// either with a non-200 HTTP status or with an 'errorcode' / 'errortext' in a 200 response body.
+type APIError struct {
+	Command     string // KT Cloud API command. (ex. deployVirtualMachine)
+	HTTPStatus  int    // HTTP status code of the response
+	ErrorCode   int    // KT Cloud 'errorcode'. 0 if the body didn't carry one.
+	CSErrorCode int    // CloudStack 'cserrorcode'. 0 if the body didn't carry one.
+	ErrorText   string // KT Cloud 'errortext', or the raw body when it couldn't be decoded
+
+	RetryAfter time.Duration // 'Retry-After' sent along with the error, if any
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("KT Cloud API error (command: %s, HTTP status: %d, errorcode: %d, cserrorcode: %d) : %s",
+		e.Command, e.HTTPStatus, e.ErrorCode, e.CSErrorCode, e.ErrorText)
+}
+
+// Checks whether err is an *APIError about a resource that doesn't exist.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	if apiErr.HTTPStatus == http.StatusNotFound || apiErr.ErrorCode == ErrCodeNotFound {
+		return true
+	}
+	// KT Cloud reports unknown IDs as a parameter error, so the text is the only hint.
+	if apiErr.ErrorCode == ErrCodeParamError {
+		text := strings.ToLower(apiErr.ErrorText)
+		return strings.Contains(text, "unable to find") || strings.Contains(text, "not found") || strings.Contains(text, "does not exist")
+	}
+	return false
+}
+
+// Checks whether err is an *APIError caused by invalid or unauthorized credentials.
+func IsAuthError(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.HTTPStatus == http.StatusUnauthorized || apiErr.HTTPStatus == http.StatusForbidden ||
+		apiErr.ErrorCode == ErrCodeUnauthorized
+}
+
+// Checks whether err is an *APIError caused by an account resource limit (quota).
+func IsQuotaExceeded(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.ErrorCode == ErrCodeAccountResourceLimit
+}
+
+// Checks whether err is an *APIError that is likely to succeed when the same call is made again later.
+func IsRetryable(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.HTTPStatus {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	switch apiErr.ErrorCode {
+	case ErrCodeAPILimitExceeded, ErrCodeResourceUnavailable:
+		return true
+	}
+	text := strings.ToLower(apiErr.ErrorText)
+	return strings.Contains(text, "too many requests") || strings.Contains(text, "busy")
+}
+
+// errorBody is the error part KT Cloud puts in a '<command>response' object.
+type errorBody struct {
+	ErrorCode   json.RawMessage `json:"errorcode"`
+	CSErrorCode json.RawMessage `json:"cserrorcode"`
+	ErrorText   string          `json:"errortext"`
+}
+
+// newAPIError builds an *APIError from a response. It returns nil for a 200 response without an error in the body.
+func newAPIError(command string, statusCode int, body []byte) *APIError {
+	apiErr := &APIError{
+		Command:    command,
+		HTTPStatus: statusCode,
+	}
+
+	found := false
+	var top map[string]json.RawMessage
+	if err := json.Unmarshal(body, &top); err == nil {
+		for _, v := range top {
+			var eb errorBody
+			if json.Unmarshal(v, &eb) != nil {
+				continue
+			}
+			code := parseErrorCode(eb.ErrorCode)
+			if code == 0 && eb.ErrorText == "" {
+				continue
+			}
+			apiErr.ErrorCode = code
+			apiErr.CSErrorCode = parseErrorCode(eb.CSErrorCode)
+			apiErr.ErrorText = eb.ErrorText
+			found = true
+			break
+		}
+	}
+
+	if statusCode == http.StatusOK {
+		if !found {
+			return nil
+		}
+		return apiErr
+	}
+	if !found {
+		apiErr.ErrorText = string(body)
+	}
+	return apiErr
+}
+
+// KT Cloud sends error codes either as a number or as a string.
+func parseErrorCode(raw json.RawMessage) int {
+	if len(raw) == 0 {
+		return 0
+	}
+	var n int
+	if json.Unmarshal(raw, &n) == nil {
+		return n
+	}
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		n, _ = strconv.Atoi(strings.TrimSpace(s))
+	}
+	return n
+}
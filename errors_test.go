@@ -0,0 +1,165 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+)
+
+func TestAPIErrorDecoding(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		retryAfter string
+		body       string
+		want       *ktsdk.APIError // nil when the call succeeds
+	}{
+		{
+			name:   "success",
+			status: http.StatusOK,
+			body:   `{"listzonesresponse":{"count":0}}`,
+		},
+		{
+			name:   "error in a 200 body",
+			status: http.StatusOK,
+			body:   `{"listzonesresponse":{"errorcode":431,"cserrorcode":9999,"errortext":"Unable to find zone"}}`,
+			want:   &ktsdk.APIError{HTTPStatus: 200, ErrorCode: 431, CSErrorCode: 9999, ErrorText: "Unable to find zone"},
+		},
+		{
+			name:   "codes as strings",
+			status: http.StatusUnauthorized,
+			body:   `{"errorresponse":{"errorcode":"401","cserrorcode":"4365","errortext":"unable to verify user credentials"}}`,
+			want:   &ktsdk.APIError{HTTPStatus: 401, ErrorCode: 401, CSErrorCode: 4365, ErrorText: "unable to verify user credentials"},
+		},
+		{
+			name:   "body that isn't JSON",
+			status: http.StatusBadGateway,
+			body:   `<html>Bad Gateway</html>`,
+			want:   &ktsdk.APIError{HTTPStatus: 502, ErrorText: "<html>Bad Gateway</html>"},
+		},
+		{
+			name:       "Retry-After in seconds",
+			status:     http.StatusTooManyRequests,
+			retryAfter: "3",
+			body:       `{"listzonesresponse":{"errorcode":429,"errortext":"Too many requests"}}`,
+			want:       &ktsdk.APIError{HTTPStatus: 429, ErrorCode: 429, ErrorText: "Too many requests", RetryAfter: 3 * time.Second},
+		},
+		{
+			name:       "invalid Retry-After",
+			status:     http.StatusServiceUnavailable,
+			retryAfter: "soon",
+			body:       `{"listzonesresponse":{"errorcode":534,"errortext":"busy"}}`,
+			want:       &ktsdk.APIError{HTTPStatus: 503, ErrorCode: 534, ErrorText: "busy"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.retryAfter != "" {
+					w.Header().Set("Retry-After", tt.retryAfter)
+				}
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, tt.body)
+			}))
+			defer srv.Close()
+			client := ktsdk.KtCloudClient{}.New(srv.URL, "key", "secret", false, ktsdk.WithRetryPolicy(nil))
+
+			err := client.Do(context.Background(), "listZones", nil, nil)
+			if tt.want == nil {
+				if err != nil {
+					t.Errorf("Do() = %v", err)
+				}
+				return
+			}
+			var apiErr *ktsdk.APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("Do() = %v, want an *APIError", err)
+			}
+			tt.want.Command = "listZones"
+			if *apiErr != *tt.want {
+				t.Errorf("Do() = %+v, want %+v", *apiErr, *tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryAfterDate(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+	client := ktsdk.KtCloudClient{}.New(srv.URL, "key", "secret", false, ktsdk.WithRetryPolicy(nil))
+
+	err := client.Do(context.Background(), "listZones", nil, nil)
+	var apiErr *ktsdk.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Do() = %v, want an *APIError", err)
+	}
+	if apiErr.RetryAfter < 58*time.Second || apiErr.RetryAfter > time.Minute {
+		t.Errorf("RetryAfter = %v, want about a minute", apiErr.RetryAfter)
+	}
+}
+
+func TestAPIErrorClassification(t *testing.T) {
+	tests := []struct {
+		name          string
+		err           *ktsdk.APIError
+		wantNotFound  bool
+		wantAuth      bool
+		wantQuota     bool
+		wantRetryable bool
+	}{
+		{"HTTP 404", &ktsdk.APIError{HTTPStatus: 404}, true, false, false, false},
+		{"errorcode 404", &ktsdk.APIError{HTTPStatus: 200, ErrorCode: ktsdk.ErrCodeNotFound}, true, false, false, false},
+		{"unknown id", &ktsdk.APIError{HTTPStatus: 431, ErrorCode: ktsdk.ErrCodeParamError, ErrorText: "Unable to find virtual machine with specified id"}, true, false, false, false},
+		{"other param error", &ktsdk.APIError{HTTPStatus: 431, ErrorCode: ktsdk.ErrCodeParamError, ErrorText: "Invalid parameter name"}, false, false, false, false},
+		{"HTTP 401", &ktsdk.APIError{HTTPStatus: 401}, false, true, false, false},
+		{"HTTP 403", &ktsdk.APIError{HTTPStatus: 403}, false, true, false, false},
+		{"errorcode 401", &ktsdk.APIError{HTTPStatus: 200, ErrorCode: ktsdk.ErrCodeUnauthorized}, false, true, false, false},
+		{"resource limit", &ktsdk.APIError{HTTPStatus: 532, ErrorCode: ktsdk.ErrCodeAccountResourceLimit}, false, false, true, false},
+		{"HTTP 429", &ktsdk.APIError{HTTPStatus: 429}, false, false, false, true},
+		{"HTTP 503", &ktsdk.APIError{HTTPStatus: 503}, false, false, false, true},
+		{"API limit", &ktsdk.APIError{HTTPStatus: 200, ErrorCode: ktsdk.ErrCodeAPILimitExceeded}, false, false, false, true},
+		{"resource unavailable", &ktsdk.APIError{HTTPStatus: 534, ErrorCode: ktsdk.ErrCodeResourceUnavailable}, false, false, false, true},
+		{"busy", &ktsdk.APIError{HTTPStatus: 530, ErrorCode: ktsdk.ErrCodeInternalError, ErrorText: "Server is busy"}, false, false, false, true},
+		{"internal error", &ktsdk.APIError{HTTPStatus: 530, ErrorCode: ktsdk.ErrCodeInternalError}, false, false, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The checks see through wrapping.
+			for _, err := range []error{tt.err, fmt.Errorf("Failed to Get the VM List : %w", tt.err)} {
+				if got := ktsdk.IsNotFound(err); got != tt.wantNotFound {
+					t.Errorf("IsNotFound(%v) = %v, want %v", err, got, tt.wantNotFound)
+				}
+				if got := ktsdk.IsAuthError(err); got != tt.wantAuth {
+					t.Errorf("IsAuthError(%v) = %v, want %v", err, got, tt.wantAuth)
+				}
+				if got := ktsdk.IsQuotaExceeded(err); got != tt.wantQuota {
+					t.Errorf("IsQuotaExceeded(%v) = %v, want %v", err, got, tt.wantQuota)
+				}
+				if got := ktsdk.IsRetryable(err); got != tt.wantRetryable {
+					t.Errorf("IsRetryable(%v) = %v, want %v", err, got, tt.wantRetryable)
+				}
+			}
+		})
+	}
+
+	plain := errors.New("Unable to find virtual machine")
+	if ktsdk.IsNotFound(plain) || ktsdk.IsAuthError(plain) || ktsdk.IsQuotaExceeded(plain) || ktsdk.IsRetryable(plain) {
+		t.Errorf("an error that isn't an *APIError was classified")
+	}
+}
@@ -8,12 +8,13 @@
 package ktcloudsdk
 
 import (
+	"context"
 	"net/url"
 )
 
 type CreateFirewallRuleReqInfo struct {
 	IpAddressId string
-	Protocol    string
+	Protocol    Protocol
 	CidrList    string
 	// StartPort   int
 	// EndPort     int
@@ -36,18 +37,64 @@ type ListFirewallRulesReqInfo struct {
 	ListAll     bool
 }
 
+// Checks the fields of the request before it is sent. A problem is returned as a *ValidationError.
+func (r CreateFirewallRuleReqInfo) Validate() error {
+	var errs fieldErrors
+	errs.required("IpAddressId", r.IpAddressId)
+	errs.required("Protocol", string(r.Protocol))
+	knownEnum(&errs, "Protocol", r.Protocol)
+	errs.cidrList("CidrList", r.CidrList)
+	if r.Protocol.Canonical() == ProtocolICMP {
+		if r.StartPort != "" {
+			errs.add("StartPort", r.StartPort, "is not used with the icmp Protocol")
+		}
+		if r.EndPort != "" {
+			errs.add("EndPort", r.EndPort, "is not used with the icmp Protocol")
+		}
+		errs.number("IcmpType", r.IcmpType, -1) // -1 : Any type
+		errs.number("IcmpCode", r.IcmpCode, -1) // -1 : Any code
+	} else {
+		errs.portRange("StartPort", r.StartPort, "EndPort", r.EndPort)
+		if r.IcmpType != "" {
+			errs.add("IcmpType", r.IcmpType, "is only used with the icmp Protocol")
+		}
+		if r.IcmpCode != "" {
+			errs.add("IcmpCode", r.IcmpCode, "is only used with the icmp Protocol")
+		}
+	}
+	errs.oneOf("Type", r.Type, "user", "system")
+	return errs.err("CreateFirewallRuleReqInfo")
+}
+
+// Checks the fields of the request before it is sent. A problem is returned as a *ValidationError.
+func (r ListFirewallRulesReqInfo) Validate() error {
+	var errs fieldErrors
+	errs.paging(r.Page, r.PageSize)
+	return errs.err("ListFirewallRulesReqInfo")
+}
+
 func (c KtCloudClient) CreateFirewallRule(filewallRuleCreateReqInfo CreateFirewallRuleReqInfo) (CreateFirewallRuleResponse, error) {
+	return c.CreateFirewallRuleWithContext(context.Background(), filewallRuleCreateReqInfo)
+}
+
+// CreateFirewallRuleWithContext is CreateFirewallRule with a context that bounds the underlying API call.
+func (c KtCloudClient) CreateFirewallRuleWithContext(ctx context.Context, filewallRuleCreateReqInfo CreateFirewallRuleReqInfo) (CreateFirewallRuleResponse, error) {
 	var resp CreateFirewallRuleResponse
+	if err := c.validate(filewallRuleCreateReqInfo); err != nil {
+		return resp, err
+	}
 	params := url.Values{}
 
 	params.Set("ipaddressid", filewallRuleCreateReqInfo.IpAddressId)
-	params.Set("protocol", filewallRuleCreateReqInfo.Protocol)
+	params.Set("protocol", string(filewallRuleCreateReqInfo.Protocol.Canonical()))
 
 	if filewallRuleCreateReqInfo.CidrList != "" {
 		params.Set("cidrlist", filewallRuleCreateReqInfo.CidrList)
 	}
 
-	params.Set("startport", filewallRuleCreateReqInfo.StartPort)
+	if filewallRuleCreateReqInfo.StartPort != "" {
+		params.Set("startport", filewallRuleCreateReqInfo.StartPort)
+	}
 
 	if filewallRuleCreateReqInfo.EndPort != "" {
 		params.Set("endport", filewallRuleCreateReqInfo.EndPort)
@@ -65,16 +112,22 @@ func (c KtCloudClient) CreateFirewallRule(filewallRuleCreateReqInfo CreateFirewa
 		params.Set("type", filewallRuleCreateReqInfo.Type)
 	}
 
-	response, err := NewRequest(c, "createFirewallRule", params)
-	if err != nil {
+	if err := c.Do(ctx, "createFirewallRule", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(CreateFirewallRuleResponse)
-	return resp, err
+	return resp, nil
 }
 
 func (c KtCloudClient) ListFirewallRules(filewallRuleListReqInfo ListFirewallRulesReqInfo) (ListFirewallRulesResponse, error) {
+	return c.ListFirewallRulesWithContext(context.Background(), filewallRuleListReqInfo)
+}
+
+// ListFirewallRulesWithContext is ListFirewallRules with a context that bounds the underlying API call.
+func (c KtCloudClient) ListFirewallRulesWithContext(ctx context.Context, filewallRuleListReqInfo ListFirewallRulesReqInfo) (ListFirewallRulesResponse, error) {
 	var resp ListFirewallRulesResponse
+	if err := c.validate(filewallRuleListReqInfo); err != nil {
+		return resp, err
+	}
 	params := url.Values{}
 
 	if filewallRuleListReqInfo.ID != "" {
@@ -113,40 +166,41 @@ func (c KtCloudClient) ListFirewallRules(filewallRuleListReqInfo ListFirewallRul
 		params.Set("listall", "true")
 	}
 
-	response, err := NewRequest(c, "listFirewallRules", params)
-	if err != nil {
+	if err := c.Do(ctx, "listFirewallRules", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(ListFirewallRulesResponse)
-	return resp, err
+	return resp, nil
 }
 
 func (c KtCloudClient) DeleteFirewallRule(ruleId string) (DeleteFirewallRuleResponse, error) {
+	return c.DeleteFirewallRuleWithContext(context.Background(), ruleId)
+}
+
+// DeleteFirewallRuleWithContext is DeleteFirewallRule with a context that bounds the underlying API call.
+func (c KtCloudClient) DeleteFirewallRuleWithContext(ctx context.Context, ruleId string) (DeleteFirewallRuleResponse, error) {
 	var resp DeleteFirewallRuleResponse
 	params := url.Values{}
 
 	params.Set("id", ruleId) // FirewallRule ID
 
-	response, err := NewRequest(c, "deleteFirewallRule", params)
-	if err != nil {
+	if err := c.Do(ctx, "deleteFirewallRule", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(DeleteFirewallRuleResponse)
-	return resp, err
+	return resp, nil
 }
 
 type FirewallRule struct {
 	ID          string        `json:"id"`
-	Protocol    string        `json:"protocol"`
-	StartPort   int           `json:"startport"` // Caution!! (Parameter type)
-	EndPort     int           `json:"endport"`   // Caution!! (Parameter type)
+	Protocol    Protocol        `json:"protocol"`
+	StartPort   FlexInt       `json:"startport"` // Caution!! (Parameter type)
+	EndPort     FlexInt       `json:"endport"`   // Caution!! (Parameter type)
 	IpAddressId string        `json:"ipaddressid"`
 	NetworkId   string        `json:"networkid"`
 	IpAddress   string        `json:"ipaddress"`
 	State       string        `json:"state"`
 	CidrList    string        `json:"cidrlist"`
-	Tags        []interface{} `json:"tags"`
-	ForDisplay  bool          `json:"fordisplay"`
+	Tags        Tags          `json:"tags"`
+	ForDisplay  FlexBool      `json:"fordisplay"`
 }
 
 type CreateFirewallRuleResponse struct {
@@ -158,7 +212,7 @@ type CreateFirewallRuleResponse struct {
 
 type ListFirewallRulesResponse struct {
 	Listfirewallrulesresponse struct {
-		Count        int            `json:"count"`
+		Count        FlexInt            `json:"count"`
 		FirewallRule []FirewallRule `json:"firewallrule"`
 	} `json:"listfirewallrulesresponse"`
 }
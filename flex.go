@@ -0,0 +1,128 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// KT Cloud doesn't encode a field the same way in every response. (ex. 'success' is "true" in one response and true in another)
+// The Flex types below accept every encoding seen so far, so that the response structs don't depend on it.
+// null and "" decode to the zero value. Any other value that doesn't fit is a decoding error, rather than a silent zero.
+
+// FlexBool is a bool sent as true / false, "true" / "false", "Y" / "N" or 1 / 0.
+type FlexBool bool
+
+func (b *FlexBool) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch v := v.(type) {
+	case nil:
+		*b = false
+		return nil
+	case bool:
+		*b = FlexBool(v)
+		return nil
+	case float64:
+		if v == 0 || v == 1 {
+			*b = v == 1
+			return nil
+		}
+	case string:
+		switch strings.ToLower(strings.TrimSpace(v)) {
+		case "true", "y", "yes", "1":
+			*b = true
+			return nil
+		case "false", "n", "no", "0", "":
+			*b = false
+			return nil
+		}
+	}
+	return fmt.Errorf("%s is not a valid FlexBool", data)
+}
+
+// FlexInt is an integer sent as a number (ex. 1024, 1024.0) or as a string. (ex. "1024")
+type FlexInt int64
+
+func (i *FlexInt) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*i = 0
+		return nil
+	}
+	s := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		s = strings.TrimSpace(s)
+		if s == "" {
+			*i = 0
+			return nil
+		}
+	}
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		*i = FlexInt(n)
+		return nil
+	}
+	// Some numbers come as floats (ex. 'cpunumber' : 2.0), which is fine as long as they are whole.
+	if f, err := strconv.ParseFloat(s, 64); err == nil && f == math.Trunc(f) && math.Abs(f) <= math.MaxInt64 {
+		*i = FlexInt(f)
+		return nil
+	}
+	return fmt.Errorf("%s is not a valid FlexInt", data)
+}
+
+// FlexString is an ID or other text value sent as a string or as a number. (ex. 'loadbalancerid' : "1234" or 1234)
+type FlexString string
+
+func (s *FlexString) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return fmt.Errorf("empty value is not a valid FlexString")
+	}
+	switch data[0] {
+	case '"':
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return err
+		}
+		*s = FlexString(str)
+		return nil
+	case 'n':
+		if bytes.Equal(data, []byte("null")) {
+			*s = ""
+			return nil
+		}
+	case 't', 'f':
+		var b bool
+		if err := json.Unmarshal(data, &b); err == nil {
+			*s = FlexString(strconv.FormatBool(b))
+			return nil
+		}
+	default:
+		var n json.Number
+		if err := json.Unmarshal(data, &n); err == nil {
+			*s = FlexString(n.String())
+			return nil
+		}
+	}
+	return fmt.Errorf("%s is not a valid FlexString", data)
+}
+
+// Returns the value as a string, for the request fields and method params taking an ID.
+func (s FlexString) String() string {
+	return string(s)
+}
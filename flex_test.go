@@ -0,0 +1,123 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+)
+
+func TestFlexBool(t *testing.T) {
+	tests := []struct {
+		json    string
+		want    ktsdk.FlexBool
+		wantErr bool
+	}{
+		{`true`, true, false},
+		{`false`, false, false},
+		{`"true"`, true, false},
+		{`"False"`, false, false},
+		{`"Y"`, true, false},
+		{`"n"`, false, false},
+		{`1`, true, false},
+		{`0`, false, false},
+		{`""`, false, false},
+		{`null`, false, false},
+		{`2`, false, true},
+		{`"maybe"`, false, true},
+		{`{}`, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.json, func(t *testing.T) {
+			var got ktsdk.FlexBool
+			err := json.Unmarshal([]byte(tt.json), &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unmarshal(%s) = %v, want error: %v", tt.json, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Unmarshal(%s) = %v, want %v", tt.json, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlexInt(t *testing.T) {
+	tests := []struct {
+		json    string
+		want    ktsdk.FlexInt
+		wantErr bool
+	}{
+		{`1024`, 1024, false},
+		{`-1`, -1, false},
+		{`2.0`, 2, false},
+		{`"1024"`, 1024, false},
+		{`" 80 "`, 80, false},
+		{`"2.0"`, 2, false},
+		{`""`, 0, false},
+		{`null`, 0, false},
+		{`2.5`, 0, true},
+		{`"eighty"`, 0, true},
+		{`true`, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.json, func(t *testing.T) {
+			var got ktsdk.FlexInt
+			err := json.Unmarshal([]byte(tt.json), &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unmarshal(%s) = %v, want error: %v", tt.json, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Unmarshal(%s) = %d, want %d", tt.json, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlexString(t *testing.T) {
+	tests := []struct {
+		json    string
+		want    ktsdk.FlexString
+		wantErr bool
+	}{
+		{`"1234"`, "1234", false},
+		{`1234`, "1234", false},
+		{`12.5`, "12.5", false},
+		{`true`, "true", false},
+		{`null`, "", false},
+		{`""`, "", false},
+		{`[1]`, "", true},
+		{`{"id":1}`, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.json, func(t *testing.T) {
+			var got ktsdk.FlexString
+			err := json.Unmarshal([]byte(tt.json), &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unmarshal(%s) = %v, want error: %v", tt.json, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Unmarshal(%s) = %q, want %q", tt.json, got, tt.want)
+			}
+		})
+	}
+}
+
+// The Flex types decode the fields of real responses, whatever their encoding.
+func TestFlexFieldsInResponses(t *testing.T) {
+	const body = `{"listloadbalancersresponse":{"count":"1","loadbalancer":[{"loadbalancerid":1234,"serviceport":"80","establishedconn":3.0}]}}`
+	var resp ktsdk.ListNLBsResponse
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatalf("Unmarshal() = %v", err)
+	}
+	r := resp.Listnlbsresponse
+	if r.Count != 1 || len(r.NLB) != 1 || r.NLB[0].NLBId != "1234" || r.NLB[0].ServicePort != 80 || r.NLB[0].EstablishedConn != 3 {
+		t.Errorf("Unmarshal() = %+v", r)
+	}
+}
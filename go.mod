@@ -2,4 +2,18 @@ module github.com/cloud-barista/ktcloud-sdk-go
 
 go 1.25.0
 
-require github.com/davecgh/go-spew v1.1.1
+require (
+	github.com/cloud-barista/cb-log v0.8.0
+	github.com/davecgh/go-spew v1.1.1
+	go.opentelemetry.io/otel v1.44.0
+	go.opentelemetry.io/otel/metric v1.44.0
+	go.opentelemetry.io/otel/trace v1.44.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	github.com/cespare/xxhash/v2 v2.3.0 // indirect
+	github.com/go-logr/logr v1.4.3 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	go.opentelemetry.io/auto/sdk v1.2.1 // indirect
+)
@@ -0,0 +1,42 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk
+
+import (
+	"context"
+	"net/url"
+)
+
+// Invoker makes an API call : it sends the command with params and decodes the response into out.
+type Invoker func(ctx context.Context, command string, params url.Values, out interface{}) error
+
+// Interceptor wraps every API call made by a KtCloudClient.
+// It sees the command and its params before they are signed, and may change them before calling next.
+// After next returns, out holds the decoded response (or err the failure), and both may be inspected or replaced.
+// Not calling next short-circuits the call : nothing is sent to KT Cloud.
+//
+// Example) Tagging every call made from a controller
+//
+//	func(ctx context.Context, command string, params url.Values, out interface{}, next ktsdk.Invoker) error {
+//		start := time.Now()
+//		err := next(ctx, command, params, out)
+//		audit.Record(ctx, command, time.Since(start), err)
+//		return err
+//	}
+type Interceptor func(ctx context.Context, command string, params url.Values, out interface{}, next Invoker) error
+
+// chainInterceptors returns an Invoker that runs the interceptors in order around invoker.
+func chainInterceptors(interceptors []Interceptor, invoker Invoker) Invoker {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], invoker
+		invoker = func(ctx context.Context, command string, params url.Values, out interface{}) error {
+			return interceptor(ctx, command, params, out, next)
+		}
+	}
+	return invoker
+}
@@ -0,0 +1,134 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+	"github.com/cloud-barista/ktcloud-sdk-go/ktcloudtest"
+)
+
+func TestInterceptorOrder(t *testing.T) {
+	srv := ktcloudtest.NewServer()
+	defer srv.Close()
+	var requests requestRecorder
+
+	var calls []string
+	tracing := func(name string) ktsdk.Interceptor {
+		return func(ctx context.Context, command string, params url.Values, out interface{}, next ktsdk.Invoker) error {
+			calls = append(calls, name+" before "+command)
+			err := next(ctx, command, params, out)
+			calls = append(calls, name+" after "+command)
+			return err
+		}
+	}
+	// The first interceptor given, across every WithInterceptors(), is the outermost.
+	client := srv.Client(ktsdk.WithInterceptors(tracing("a"), tracing("b")), ktsdk.WithInterceptors(tracing("c")),
+		ktsdk.WithTransport(&requests))
+
+	if err := listZones(client); err != nil {
+		t.Fatalf("ListZones() = %v", err)
+	}
+	want := []string{"a before listZones", "b before listZones", "c before listZones",
+		"c after listZones", "b after listZones", "a after listZones"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls = %q, want %q", calls, want)
+	}
+	if requests.count() != 1 {
+		t.Errorf("%d requests sent, want 1", requests.count())
+	}
+}
+
+func TestInterceptorChangesParams(t *testing.T) {
+	srv := ktcloudtest.NewServer()
+	defer srv.Close()
+	var requests requestRecorder
+
+	// An outer interceptor's change is seen by the inner ones, and sent.
+	addZone := func(ctx context.Context, command string, params url.Values, out interface{}, next ktsdk.Invoker) error {
+		params.Set("id", ktcloudtest.ZoneCentralA)
+		return next(ctx, command, params, out)
+	}
+	var seen string
+	inner := func(ctx context.Context, command string, params url.Values, out interface{}, next ktsdk.Invoker) error {
+		seen = params.Get("id")
+		return next(ctx, command, params, out)
+	}
+	client := srv.Client(ktsdk.WithInterceptors(addZone, inner), ktsdk.WithTransport(&requests))
+
+	resp, err := client.ListZones(true, "", "", "")
+	if err != nil {
+		t.Fatalf("ListZones() = %v", err)
+	}
+	if seen != ktcloudtest.ZoneCentralA {
+		t.Errorf("the inner interceptor saw id %q, want %q", seen, ktcloudtest.ZoneCentralA)
+	}
+	if got := requests.requests[0].URL.Query().Get("id"); got != ktcloudtest.ZoneCentralA {
+		t.Errorf("id = %q sent, want %q", got, ktcloudtest.ZoneCentralA)
+	}
+	if zones := resp.Listzonesresponse.Zone; len(zones) != 1 || zones[0].ID != ktcloudtest.ZoneCentralA {
+		t.Errorf("ListZones() = %+v, want the zone set by the interceptor", zones)
+	}
+}
+
+func TestInterceptorShortCircuit(t *testing.T) {
+	srv := ktcloudtest.NewServer()
+	defer srv.Close()
+	errDenied := errors.New("listZones is denied")
+
+	var innerCalled bool
+	inner := func(ctx context.Context, command string, params url.Values, out interface{}, next ktsdk.Invoker) error {
+		innerCalled = true
+		return next(ctx, command, params, out)
+	}
+	tests := []struct {
+		name      string
+		outer     ktsdk.Interceptor
+		wantErr   error
+		wantCount int
+	}{
+		{
+			name: "canned response",
+			outer: func(ctx context.Context, command string, params url.Values, out interface{}, next ktsdk.Invoker) error {
+				return json.Unmarshal([]byte(`{"listzonesresponse":{"count":1,"zone":[{"id":"canned"}]}}`), out)
+			},
+			wantCount: 1,
+		},
+		{
+			name: "error",
+			outer: func(ctx context.Context, command string, params url.Values, out interface{}, next ktsdk.Invoker) error {
+				return errDenied
+			},
+			wantErr: errDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests requestRecorder
+			innerCalled = false
+			client := srv.Client(ktsdk.WithInterceptors(tt.outer, inner), ktsdk.WithTransport(&requests))
+
+			resp, err := client.ListZones(true, "", "", "")
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ListZones() = %v, want %v", err, tt.wantErr)
+			}
+			if innerCalled || requests.count() != 0 {
+				t.Errorf("the call went on past the interceptor : inner called %v, %d requests sent", innerCalled, requests.count())
+			}
+			if int(resp.Listzonesresponse.Count) != tt.wantCount {
+				t.Errorf("ListZones() = %+v, want %d zones from the interceptor", resp.Listzonesresponse, tt.wantCount)
+			}
+		})
+	}
+}
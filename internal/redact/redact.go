@@ -0,0 +1,59 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+// Package redact masks the secrets of KT Cloud API requests and responses,
+// for the debug log of the SDK and the cassettes of the recorder.
+package redact
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Parameters and response fields whose values are always masked.
+var Keys = []string{"apikey", "signature", "password", "privatekey", "secretkey"}
+
+// Value replaces the masked values.
+const Value = "****"
+
+// Matches '"<masked key>": "<value>"' in a JSON body.
+var fieldRegexp = regexp.MustCompile(`(?i)"(` + strings.Join(Keys, "|") + `)"\s*:\s*"(?:[^"\\]|\\.)*"`)
+
+// IsSecret checks whether the values of a parameter or field are masked.
+func IsSecret(key string) bool {
+	for _, secret := range Keys {
+		if strings.EqualFold(key, secret) {
+			return true
+		}
+	}
+	return false
+}
+
+// Params masks the secret values of params in place.
+func Params(params url.Values) {
+	for key := range params {
+		if IsSecret(key) {
+			params.Set(key, Value)
+		}
+	}
+}
+
+// Query masks the secret values of an encoded query string.
+func Query(query string) string {
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return "(unparsable)"
+	}
+	Params(values)
+	return values.Encode()
+}
+
+// JSON masks the secret fields of a JSON body.
+func JSON(body []byte) []byte {
+	return fieldRegexp.ReplaceAll(body, []byte(`"$1":"`+Value+`"`))
+}
@@ -0,0 +1,29 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+// Package ktcloudmock provides KtCloudAPIMock, a mock of ktcloudsdk.KtCloudAPI generated by moq.
+// Set the ...Func fields a test needs; calling a method whose field is unset panics.
+// Each call is recorded, and can be inspected with the matching ...Calls() method.
+//
+//	mock := &ktcloudmock.KtCloudAPIMock{
+//		ListZonesFunc: func(isAvailable bool, domainId string, zoneId string, keyword string) (ktsdk.ListZonesResponse, error) {
+//			return ktsdk.ListZonesResponse{}, nil
+//		},
+//	}
+//
+// Regenerate it with 'go generate ./ktcloudmock' in the SDK root after changing the interface.
+// moq is installed with 'go install github.com/matryer/moq@latest'.
+package ktcloudmock
+
+import (
+	"github.com/cloud-barista/ktcloud-sdk-go"
+)
+
+//go:generate moq -out ktcloud_api_mock.go -pkg ktcloudmock .. KtCloudAPI
+
+// The mock must keep up with the interface; this fails to build otherwise.
+var _ ktcloudsdk.KtCloudAPI = (*KtCloudAPIMock)(nil)
@@ -0,0 +1,5073 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package ktcloudmock
+
+import (
+	"context"
+	"github.com/cloud-barista/ktcloud-sdk-go"
+	"iter"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// Ensure, that KtCloudAPIMock does implement ktcloudsdk.KtCloudAPI.
+// If this is not the case, regenerate this file with moq.
+var _ ktcloudsdk.KtCloudAPI = &KtCloudAPIMock{}
+
+// KtCloudAPIMock is a mock implementation of ktcloudsdk.KtCloudAPI.
+//
+//	func TestSomethingThatUsesKtCloudAPI(t *testing.T) {
+//
+//		// make and configure a mocked ktcloudsdk.KtCloudAPI
+//		mockedKtCloudAPI := &KtCloudAPIMock{
+//			AddNLBVMFunc: func(req ktcloudsdk.AddNLBVMReqInfo) (ktcloudsdk.AddNLBVMResponse, error) {
+//				panic("mock out the AddNLBVM method")
+//			},
+//			AddNLBVMWithContextFunc: func(ctx context.Context, req ktcloudsdk.AddNLBVMReqInfo) (ktcloudsdk.AddNLBVMResponse, error) {
+//				panic("mock out the AddNLBVMWithContext method")
+//			},
+//			AllFirewallRulesFunc: func(ctx context.Context, req ktcloudsdk.ListFirewallRulesReqInfo) iter.Seq2[ktcloudsdk.FirewallRule, error] {
+//				panic("mock out the AllFirewallRules method")
+//			},
+//			AllPortForwardingRulesFunc: func(ctx context.Context, req ktcloudsdk.ListPortForwardingRulesReqInfo) iter.Seq2[ktcloudsdk.PortForwardingRule, error] {
+//				panic("mock out the AllPortForwardingRules method")
+//			},
+//			AllPublicIpAddressesFunc: func(ctx context.Context, req ktcloudsdk.ListPublicIpReqInfo) iter.Seq2[ktcloudsdk.PublicIpAddress, error] {
+//				panic("mock out the AllPublicIpAddresses method")
+//			},
+//			AllTemplatesFunc: func(ctx context.Context, req *ktcloudsdk.ListTemplateReqInfo) iter.Seq2[ktcloudsdk.Template, error] {
+//				panic("mock out the AllTemplates method")
+//			},
+//			AllVirtualMachinesFunc: func(ctx context.Context, vmListReqInfo ktcloudsdk.ListVMReqInfo) iter.Seq2[ktcloudsdk.Virtualmachine, error] {
+//				panic("mock out the AllVirtualMachines method")
+//			},
+//			AllVolumesFunc: func(ctx context.Context, req ktcloudsdk.ListVolumeReqInfo) iter.Seq2[ktcloudsdk.Volume, error] {
+//				panic("mock out the AllVolumes method")
+//			},
+//			AssociateIpAddressFunc: func(ipReqInfo ktcloudsdk.AssociatePublicIpReqInfo) (ktcloudsdk.AssociateIpAddressResponse, error) {
+//				panic("mock out the AssociateIpAddress method")
+//			},
+//			AssociateIpAddressWithContextFunc: func(ctx context.Context, ipReqInfo ktcloudsdk.AssociatePublicIpReqInfo) (ktcloudsdk.AssociateIpAddressResponse, error) {
+//				panic("mock out the AssociateIpAddressWithContext method")
+//			},
+//			AttachVolumeFunc: func(req ktcloudsdk.AttachVolumeReqInfo) (ktcloudsdk.AttachVolumeResponse, error) {
+//				panic("mock out the AttachVolume method")
+//			},
+//			AttachVolumeWithContextFunc: func(ctx context.Context, req ktcloudsdk.AttachVolumeReqInfo) (ktcloudsdk.AttachVolumeResponse, error) {
+//				panic("mock out the AttachVolumeWithContext method")
+//			},
+//			CreateFirewallRuleFunc: func(filewallRuleCreateReqInfo ktcloudsdk.CreateFirewallRuleReqInfo) (ktcloudsdk.CreateFirewallRuleResponse, error) {
+//				panic("mock out the CreateFirewallRule method")
+//			},
+//			CreateFirewallRuleWithContextFunc: func(ctx context.Context, filewallRuleCreateReqInfo ktcloudsdk.CreateFirewallRuleReqInfo) (ktcloudsdk.CreateFirewallRuleResponse, error) {
+//				panic("mock out the CreateFirewallRuleWithContext method")
+//			},
+//			CreateNLBFunc: func(req ktcloudsdk.CreateNLBReqInfo) (ktcloudsdk.CreateNLBResponse, error) {
+//				panic("mock out the CreateNLB method")
+//			},
+//			CreateNLBWithContextFunc: func(ctx context.Context, req ktcloudsdk.CreateNLBReqInfo) (ktcloudsdk.CreateNLBResponse, error) {
+//				panic("mock out the CreateNLBWithContext method")
+//			},
+//			CreatePortForwardingRuleFunc: func(portForwardingRuleCreateReqInfo ktcloudsdk.CreatePortForwardingRuleReqInfo) (ktcloudsdk.CreatePortForwardingRuleResponse, error) {
+//				panic("mock out the CreatePortForwardingRule method")
+//			},
+//			CreatePortForwardingRuleWithContextFunc: func(ctx context.Context, portForwardingRuleCreateReqInfo ktcloudsdk.CreatePortForwardingRuleReqInfo) (ktcloudsdk.CreatePortForwardingRuleResponse, error) {
+//				panic("mock out the CreatePortForwardingRuleWithContext method")
+//			},
+//			CreateSSHKeyPairFunc: func(name string) (ktcloudsdk.CreateSshKeyPairResponse, error) {
+//				panic("mock out the CreateSSHKeyPair method")
+//			},
+//			CreateSSHKeyPairWithContextFunc: func(ctx context.Context, name string) (ktcloudsdk.CreateSshKeyPairResponse, error) {
+//				panic("mock out the CreateSSHKeyPairWithContext method")
+//			},
+//			CreateTagsFunc: func(options *ktcloudsdk.CreateTagsReqInfo) (ktcloudsdk.CreateTagsResponse, error) {
+//				panic("mock out the CreateTags method")
+//			},
+//			CreateTagsWithContextFunc: func(ctx context.Context, options *ktcloudsdk.CreateTagsReqInfo) (ktcloudsdk.CreateTagsResponse, error) {
+//				panic("mock out the CreateTagsWithContext method")
+//			},
+//			CreateTemplateFunc: func(req *ktcloudsdk.CreateTemplateReqInfo) (ktcloudsdk.CreateTemplateResponse, error) {
+//				panic("mock out the CreateTemplate method")
+//			},
+//			CreateTemplateWithContextFunc: func(ctx context.Context, req *ktcloudsdk.CreateTemplateReqInfo) (ktcloudsdk.CreateTemplateResponse, error) {
+//				panic("mock out the CreateTemplateWithContext method")
+//			},
+//			CreateVolumeFunc: func(req ktcloudsdk.CreateVolumeReqInfo) (ktcloudsdk.CreateVolumeResponse, error) {
+//				panic("mock out the CreateVolume method")
+//			},
+//			CreateVolumeWithContextFunc: func(ctx context.Context, req ktcloudsdk.CreateVolumeReqInfo) (ktcloudsdk.CreateVolumeResponse, error) {
+//				panic("mock out the CreateVolumeWithContext method")
+//			},
+//			DeleteFirewallRuleFunc: func(ruleId string) (ktcloudsdk.DeleteFirewallRuleResponse, error) {
+//				panic("mock out the DeleteFirewallRule method")
+//			},
+//			DeleteFirewallRuleWithContextFunc: func(ctx context.Context, ruleId string) (ktcloudsdk.DeleteFirewallRuleResponse, error) {
+//				panic("mock out the DeleteFirewallRuleWithContext method")
+//			},
+//			DeleteNLBFunc: func(nlbId string) (ktcloudsdk.DeleteNLBResponse, error) {
+//				panic("mock out the DeleteNLB method")
+//			},
+//			DeleteNLBWithContextFunc: func(ctx context.Context, nlbId string) (ktcloudsdk.DeleteNLBResponse, error) {
+//				panic("mock out the DeleteNLBWithContext method")
+//			},
+//			DeletePortForwardingRuleFunc: func(ruleId string) (ktcloudsdk.DeletePortForwardingRuleResponse, error) {
+//				panic("mock out the DeletePortForwardingRule method")
+//			},
+//			DeletePortForwardingRuleWithContextFunc: func(ctx context.Context, ruleId string) (ktcloudsdk.DeletePortForwardingRuleResponse, error) {
+//				panic("mock out the DeletePortForwardingRuleWithContext method")
+//			},
+//			DeleteSSHKeyPairFunc: func(name string) (ktcloudsdk.DeleteSshKeyPairResponse, error) {
+//				panic("mock out the DeleteSSHKeyPair method")
+//			},
+//			DeleteSSHKeyPairWithContextFunc: func(ctx context.Context, name string) (ktcloudsdk.DeleteSshKeyPairResponse, error) {
+//				panic("mock out the DeleteSSHKeyPairWithContext method")
+//			},
+//			DeleteTagsFunc: func(options *ktcloudsdk.DeleteTagsReqInfo) (ktcloudsdk.DeleteTagsResponse, error) {
+//				panic("mock out the DeleteTags method")
+//			},
+//			DeleteTagsWithContextFunc: func(ctx context.Context, options *ktcloudsdk.DeleteTagsReqInfo) (ktcloudsdk.DeleteTagsResponse, error) {
+//				panic("mock out the DeleteTagsWithContext method")
+//			},
+//			DeleteTemplateFunc: func(id string, zoneId string) (ktcloudsdk.DeleteTemplateResponse, error) {
+//				panic("mock out the DeleteTemplate method")
+//			},
+//			DeleteTemplateWithContextFunc: func(ctx context.Context, id string, zoneId string) (ktcloudsdk.DeleteTemplateResponse, error) {
+//				panic("mock out the DeleteTemplateWithContext method")
+//			},
+//			DeleteVolumeFunc: func(id string) (ktcloudsdk.DeleteVolumeResponse, error) {
+//				panic("mock out the DeleteVolume method")
+//			},
+//			DeleteVolumeWithContextFunc: func(ctx context.Context, id string) (ktcloudsdk.DeleteVolumeResponse, error) {
+//				panic("mock out the DeleteVolumeWithContext method")
+//			},
+//			DeployVirtualMachineFunc: func(vmReqInfo ktcloudsdk.DeployVMReqInfo) (ktcloudsdk.DeployVirtualMachineResponse, error) {
+//				panic("mock out the DeployVirtualMachine method")
+//			},
+//			DeployVirtualMachineWithContextFunc: func(ctx context.Context, vmReqInfo ktcloudsdk.DeployVMReqInfo) (ktcloudsdk.DeployVirtualMachineResponse, error) {
+//				panic("mock out the DeployVirtualMachineWithContext method")
+//			},
+//			DestroyVirtualMachineFunc: func(vmId string) (ktcloudsdk.DestroyVirtualMachineResponse, error) {
+//				panic("mock out the DestroyVirtualMachine method")
+//			},
+//			DestroyVirtualMachineWithContextFunc: func(ctx context.Context, vmId string) (ktcloudsdk.DestroyVirtualMachineResponse, error) {
+//				panic("mock out the DestroyVirtualMachineWithContext method")
+//			},
+//			DetachVolumeFunc: func(req ktcloudsdk.DetachVolumeReqInfo) (ktcloudsdk.DetachVolumeResponse, error) {
+//				panic("mock out the DetachVolume method")
+//			},
+//			DetachVolumeWithContextFunc: func(ctx context.Context, req ktcloudsdk.DetachVolumeReqInfo) (ktcloudsdk.DetachVolumeResponse, error) {
+//				panic("mock out the DetachVolumeWithContext method")
+//			},
+//			DisassociateIpAddressFunc: func(publicIpId string) (ktcloudsdk.DisassociateIpAddressResponse, error) {
+//				panic("mock out the DisassociateIpAddress method")
+//			},
+//			DisassociateIpAddressWithContextFunc: func(ctx context.Context, publicIpId string) (ktcloudsdk.DisassociateIpAddressResponse, error) {
+//				panic("mock out the DisassociateIpAddressWithContext method")
+//			},
+//			DoFunc: func(ctx context.Context, command string, params url.Values, out interface{}) error {
+//				panic("mock out the Do method")
+//			},
+//			InvalidateCacheFunc: func(commands ...string)  {
+//				panic("mock out the InvalidateCache method")
+//			},
+//			ListAllFirewallRulesFunc: func(req ktcloudsdk.ListFirewallRulesReqInfo) ([]ktcloudsdk.FirewallRule, error) {
+//				panic("mock out the ListAllFirewallRules method")
+//			},
+//			ListAllFirewallRulesWithContextFunc: func(ctx context.Context, req ktcloudsdk.ListFirewallRulesReqInfo) ([]ktcloudsdk.FirewallRule, error) {
+//				panic("mock out the ListAllFirewallRulesWithContext method")
+//			},
+//			ListAllPortForwardingRulesFunc: func(req ktcloudsdk.ListPortForwardingRulesReqInfo) ([]ktcloudsdk.PortForwardingRule, error) {
+//				panic("mock out the ListAllPortForwardingRules method")
+//			},
+//			ListAllPortForwardingRulesWithContextFunc: func(ctx context.Context, req ktcloudsdk.ListPortForwardingRulesReqInfo) ([]ktcloudsdk.PortForwardingRule, error) {
+//				panic("mock out the ListAllPortForwardingRulesWithContext method")
+//			},
+//			ListAllPublicIpAddressesFunc: func(req ktcloudsdk.ListPublicIpReqInfo) ([]ktcloudsdk.PublicIpAddress, error) {
+//				panic("mock out the ListAllPublicIpAddresses method")
+//			},
+//			ListAllPublicIpAddressesWithContextFunc: func(ctx context.Context, req ktcloudsdk.ListPublicIpReqInfo) ([]ktcloudsdk.PublicIpAddress, error) {
+//				panic("mock out the ListAllPublicIpAddressesWithContext method")
+//			},
+//			ListAllTemplatesFunc: func(req *ktcloudsdk.ListTemplateReqInfo) ([]ktcloudsdk.Template, error) {
+//				panic("mock out the ListAllTemplates method")
+//			},
+//			ListAllTemplatesWithContextFunc: func(ctx context.Context, req *ktcloudsdk.ListTemplateReqInfo) ([]ktcloudsdk.Template, error) {
+//				panic("mock out the ListAllTemplatesWithContext method")
+//			},
+//			ListAllVirtualMachinesFunc: func(vmListReqInfo ktcloudsdk.ListVMReqInfo) ([]ktcloudsdk.Virtualmachine, error) {
+//				panic("mock out the ListAllVirtualMachines method")
+//			},
+//			ListAllVirtualMachinesWithContextFunc: func(ctx context.Context, vmListReqInfo ktcloudsdk.ListVMReqInfo) ([]ktcloudsdk.Virtualmachine, error) {
+//				panic("mock out the ListAllVirtualMachinesWithContext method")
+//			},
+//			ListAllVolumesFunc: func(req ktcloudsdk.ListVolumeReqInfo) ([]ktcloudsdk.Volume, error) {
+//				panic("mock out the ListAllVolumes method")
+//			},
+//			ListAllVolumesWithContextFunc: func(ctx context.Context, req ktcloudsdk.ListVolumeReqInfo) ([]ktcloudsdk.Volume, error) {
+//				panic("mock out the ListAllVolumesWithContext method")
+//			},
+//			ListAvailableProductTypesFunc: func(zoneId string) (ktcloudsdk.ListAvailableProductTypesResponse, error) {
+//				panic("mock out the ListAvailableProductTypes method")
+//			},
+//			ListAvailableProductTypesWithContextFunc: func(ctx context.Context, zoneId string) (ktcloudsdk.ListAvailableProductTypesResponse, error) {
+//				panic("mock out the ListAvailableProductTypesWithContext method")
+//			},
+//			ListFirewallRulesFunc: func(filewallRuleListReqInfo ktcloudsdk.ListFirewallRulesReqInfo) (ktcloudsdk.ListFirewallRulesResponse, error) {
+//				panic("mock out the ListFirewallRules method")
+//			},
+//			ListFirewallRulesWithContextFunc: func(ctx context.Context, filewallRuleListReqInfo ktcloudsdk.ListFirewallRulesReqInfo) (ktcloudsdk.ListFirewallRulesResponse, error) {
+//				panic("mock out the ListFirewallRulesWithContext method")
+//			},
+//			ListNLBVMsFunc: func(nlbId string) (ktcloudsdk.ListNLBVMsResponse, error) {
+//				panic("mock out the ListNLBVMs method")
+//			},
+//			ListNLBVMsWithContextFunc: func(ctx context.Context, nlbId string) (ktcloudsdk.ListNLBVMsResponse, error) {
+//				panic("mock out the ListNLBVMsWithContext method")
+//			},
+//			ListNLBsFunc: func(req ktcloudsdk.ListNLBsReqInfo) (ktcloudsdk.ListNLBsResponse, error) {
+//				panic("mock out the ListNLBs method")
+//			},
+//			ListNLBsWithContextFunc: func(ctx context.Context, req ktcloudsdk.ListNLBsReqInfo) (ktcloudsdk.ListNLBsResponse, error) {
+//				panic("mock out the ListNLBsWithContext method")
+//			},
+//			ListPortForwardingRulesFunc: func(portForwardingRulesListReqInfo ktcloudsdk.ListPortForwardingRulesReqInfo) (ktcloudsdk.ListPortForwardingRulesResponse, error) {
+//				panic("mock out the ListPortForwardingRules method")
+//			},
+//			ListPortForwardingRulesWithContextFunc: func(ctx context.Context, portForwardingRulesListReqInfo ktcloudsdk.ListPortForwardingRulesReqInfo) (ktcloudsdk.ListPortForwardingRulesResponse, error) {
+//				panic("mock out the ListPortForwardingRulesWithContext method")
+//			},
+//			ListPublicIpAddressesFunc: func(ipListReqInfo ktcloudsdk.ListPublicIpReqInfo) (ktcloudsdk.ListPublicIpAddressesResponse, error) {
+//				panic("mock out the ListPublicIpAddresses method")
+//			},
+//			ListPublicIpAddressesWithContextFunc: func(ctx context.Context, ipListReqInfo ktcloudsdk.ListPublicIpReqInfo) (ktcloudsdk.ListPublicIpAddressesResponse, error) {
+//				panic("mock out the ListPublicIpAddressesWithContext method")
+//			},
+//			ListSSHKeyPairsFunc: func(name string) (ktcloudsdk.ListSshKeyPairsResponse, error) {
+//				panic("mock out the ListSSHKeyPairs method")
+//			},
+//			ListSSHKeyPairsWithContextFunc: func(ctx context.Context, name string) (ktcloudsdk.ListSshKeyPairsResponse, error) {
+//				panic("mock out the ListSSHKeyPairsWithContext method")
+//			},
+//			ListTagsFunc: func(options *ktcloudsdk.ListTagsReqInfo) (ktcloudsdk.ListTagsResponse, error) {
+//				panic("mock out the ListTags method")
+//			},
+//			ListTagsWithContextFunc: func(ctx context.Context, options *ktcloudsdk.ListTagsReqInfo) (ktcloudsdk.ListTagsResponse, error) {
+//				panic("mock out the ListTagsWithContext method")
+//			},
+//			ListTemplatesFunc: func(req *ktcloudsdk.ListTemplateReqInfo) (ktcloudsdk.ListTemplatesResponse, error) {
+//				panic("mock out the ListTemplates method")
+//			},
+//			ListTemplatesWithContextFunc: func(ctx context.Context, req *ktcloudsdk.ListTemplateReqInfo) (ktcloudsdk.ListTemplatesResponse, error) {
+//				panic("mock out the ListTemplatesWithContext method")
+//			},
+//			ListVirtualMachinesFunc: func(vmListReqInfo ktcloudsdk.ListVMReqInfo) (ktcloudsdk.ListVirtualMachinesResponse, error) {
+//				panic("mock out the ListVirtualMachines method")
+//			},
+//			ListVirtualMachinesWithContextFunc: func(ctx context.Context, vmListReqInfo ktcloudsdk.ListVMReqInfo) (ktcloudsdk.ListVirtualMachinesResponse, error) {
+//				panic("mock out the ListVirtualMachinesWithContext method")
+//			},
+//			ListVolumesFunc: func(req ktcloudsdk.ListVolumeReqInfo) (ktcloudsdk.ListVolumesResponse, error) {
+//				panic("mock out the ListVolumes method")
+//			},
+//			ListVolumesWithContextFunc: func(ctx context.Context, req ktcloudsdk.ListVolumeReqInfo) (ktcloudsdk.ListVolumesResponse, error) {
+//				panic("mock out the ListVolumesWithContext method")
+//			},
+//			ListZonesFunc: func(isAvailable bool, domainId string, zoneId string, keyword string) (ktcloudsdk.ListZonesResponse, error) {
+//				panic("mock out the ListZones method")
+//			},
+//			ListZonesWithContextFunc: func(ctx context.Context, isAvailable bool, domainId string, zoneId string, keyword string) (ktcloudsdk.ListZonesResponse, error) {
+//				panic("mock out the ListZonesWithContext method")
+//			},
+//			QueryAsyncJobResultFunc: func(jobId string) (ktcloudsdk.QueryAsyncJobResultResponse, error) {
+//				panic("mock out the QueryAsyncJobResult method")
+//			},
+//			QueryAsyncJobResultWithContextFunc: func(ctx context.Context, jobId string) (ktcloudsdk.QueryAsyncJobResultResponse, error) {
+//				panic("mock out the QueryAsyncJobResultWithContext method")
+//			},
+//			RebootVirtualMachineFunc: func(vmId string) (ktcloudsdk.RebootVirtualMachineResponse, error) {
+//				panic("mock out the RebootVirtualMachine method")
+//			},
+//			RebootVirtualMachineWithContextFunc: func(ctx context.Context, vmId string) (ktcloudsdk.RebootVirtualMachineResponse, error) {
+//				panic("mock out the RebootVirtualMachineWithContext method")
+//			},
+//			RemoveNLBVMFunc: func(serviceId string) (ktcloudsdk.RemoveNLBVMResponse, error) {
+//				panic("mock out the RemoveNLBVM method")
+//			},
+//			RemoveNLBVMWithContextFunc: func(ctx context.Context, serviceId string) (ktcloudsdk.RemoveNLBVMResponse, error) {
+//				panic("mock out the RemoveNLBVMWithContext method")
+//			},
+//			ResizeVolumeFunc: func(req ktcloudsdk.ResizeVolumeReqInfo) (ktcloudsdk.ResizeVolumeResponse, error) {
+//				panic("mock out the ResizeVolume method")
+//			},
+//			ResizeVolumeWithContextFunc: func(ctx context.Context, req ktcloudsdk.ResizeVolumeReqInfo) (ktcloudsdk.ResizeVolumeResponse, error) {
+//				panic("mock out the ResizeVolumeWithContext method")
+//			},
+//			StartVirtualMachineFunc: func(vmId string) (ktcloudsdk.StartVirtualMachineResponse, error) {
+//				panic("mock out the StartVirtualMachine method")
+//			},
+//			StartVirtualMachineWithContextFunc: func(ctx context.Context, vmId string) (ktcloudsdk.StartVirtualMachineResponse, error) {
+//				panic("mock out the StartVirtualMachineWithContext method")
+//			},
+//			StopVirtualMachineFunc: func(vmId string) (ktcloudsdk.StopVirtualMachineResponse, error) {
+//				panic("mock out the StopVirtualMachine method")
+//			},
+//			StopVirtualMachineWithContextFunc: func(ctx context.Context, vmId string) (ktcloudsdk.StopVirtualMachineResponse, error) {
+//				panic("mock out the StopVirtualMachineWithContext method")
+//			},
+//			UpdateVirtualMachineFunc: func(vmId string, displayname string, haenable string) (ktcloudsdk.UpdateVirtualMachineResponse, error) {
+//				panic("mock out the UpdateVirtualMachine method")
+//			},
+//			UpdateVirtualMachineWithContextFunc: func(ctx context.Context, vmId string, displayname string, haenable string) (ktcloudsdk.UpdateVirtualMachineResponse, error) {
+//				panic("mock out the UpdateVirtualMachineWithContext method")
+//			},
+//			WaitForAsyncJobFunc: func(jobId string, timeOut time.Duration) error {
+//				panic("mock out the WaitForAsyncJob method")
+//			},
+//			WaitForAsyncJobWithContextFunc: func(ctx context.Context, jobId string, timeOut time.Duration) error {
+//				panic("mock out the WaitForAsyncJobWithContext method")
+//			},
+//			WaitForVirtualMachineStateFunc: func(zoneId string, vmId string, wantedState string, timeOut time.Duration) error {
+//				panic("mock out the WaitForVirtualMachineState method")
+//			},
+//			WaitForVirtualMachineStateWithContextFunc: func(ctx context.Context, zoneId string, vmId string, wantedState string, timeOut time.Duration) error {
+//				panic("mock out the WaitForVirtualMachineStateWithContext method")
+//			},
+//		}
+//
+//		// use mockedKtCloudAPI in code that requires ktcloudsdk.KtCloudAPI
+//		// and then make assertions.
+//
+//	}
+type KtCloudAPIMock struct {
+	// AddNLBVMFunc mocks the AddNLBVM method.
+	AddNLBVMFunc func(req ktcloudsdk.AddNLBVMReqInfo) (ktcloudsdk.AddNLBVMResponse, error)
+
+	// AddNLBVMWithContextFunc mocks the AddNLBVMWithContext method.
+	AddNLBVMWithContextFunc func(ctx context.Context, req ktcloudsdk.AddNLBVMReqInfo) (ktcloudsdk.AddNLBVMResponse, error)
+
+	// AllFirewallRulesFunc mocks the AllFirewallRules method.
+	AllFirewallRulesFunc func(ctx context.Context, req ktcloudsdk.ListFirewallRulesReqInfo) iter.Seq2[ktcloudsdk.FirewallRule, error]
+
+	// AllPortForwardingRulesFunc mocks the AllPortForwardingRules method.
+	AllPortForwardingRulesFunc func(ctx context.Context, req ktcloudsdk.ListPortForwardingRulesReqInfo) iter.Seq2[ktcloudsdk.PortForwardingRule, error]
+
+	// AllPublicIpAddressesFunc mocks the AllPublicIpAddresses method.
+	AllPublicIpAddressesFunc func(ctx context.Context, req ktcloudsdk.ListPublicIpReqInfo) iter.Seq2[ktcloudsdk.PublicIpAddress, error]
+
+	// AllTemplatesFunc mocks the AllTemplates method.
+	AllTemplatesFunc func(ctx context.Context, req *ktcloudsdk.ListTemplateReqInfo) iter.Seq2[ktcloudsdk.Template, error]
+
+	// AllVirtualMachinesFunc mocks the AllVirtualMachines method.
+	AllVirtualMachinesFunc func(ctx context.Context, vmListReqInfo ktcloudsdk.ListVMReqInfo) iter.Seq2[ktcloudsdk.Virtualmachine, error]
+
+	// AllVolumesFunc mocks the AllVolumes method.
+	AllVolumesFunc func(ctx context.Context, req ktcloudsdk.ListVolumeReqInfo) iter.Seq2[ktcloudsdk.Volume, error]
+
+	// AssociateIpAddressFunc mocks the AssociateIpAddress method.
+	AssociateIpAddressFunc func(ipReqInfo ktcloudsdk.AssociatePublicIpReqInfo) (ktcloudsdk.AssociateIpAddressResponse, error)
+
+	// AssociateIpAddressWithContextFunc mocks the AssociateIpAddressWithContext method.
+	AssociateIpAddressWithContextFunc func(ctx context.Context, ipReqInfo ktcloudsdk.AssociatePublicIpReqInfo) (ktcloudsdk.AssociateIpAddressResponse, error)
+
+	// AttachVolumeFunc mocks the AttachVolume method.
+	AttachVolumeFunc func(req ktcloudsdk.AttachVolumeReqInfo) (ktcloudsdk.AttachVolumeResponse, error)
+
+	// AttachVolumeWithContextFunc mocks the AttachVolumeWithContext method.
+	AttachVolumeWithContextFunc func(ctx context.Context, req ktcloudsdk.AttachVolumeReqInfo) (ktcloudsdk.AttachVolumeResponse, error)
+
+	// CreateFirewallRuleFunc mocks the CreateFirewallRule method.
+	CreateFirewallRuleFunc func(filewallRuleCreateReqInfo ktcloudsdk.CreateFirewallRuleReqInfo) (ktcloudsdk.CreateFirewallRuleResponse, error)
+
+	// CreateFirewallRuleWithContextFunc mocks the CreateFirewallRuleWithContext method.
+	CreateFirewallRuleWithContextFunc func(ctx context.Context, filewallRuleCreateReqInfo ktcloudsdk.CreateFirewallRuleReqInfo) (ktcloudsdk.CreateFirewallRuleResponse, error)
+
+	// CreateNLBFunc mocks the CreateNLB method.
+	CreateNLBFunc func(req ktcloudsdk.CreateNLBReqInfo) (ktcloudsdk.CreateNLBResponse, error)
+
+	// CreateNLBWithContextFunc mocks the CreateNLBWithContext method.
+	CreateNLBWithContextFunc func(ctx context.Context, req ktcloudsdk.CreateNLBReqInfo) (ktcloudsdk.CreateNLBResponse, error)
+
+	// CreatePortForwardingRuleFunc mocks the CreatePortForwardingRule method.
+	CreatePortForwardingRuleFunc func(portForwardingRuleCreateReqInfo ktcloudsdk.CreatePortForwardingRuleReqInfo) (ktcloudsdk.CreatePortForwardingRuleResponse, error)
+
+	// CreatePortForwardingRuleWithContextFunc mocks the CreatePortForwardingRuleWithContext method.
+	CreatePortForwardingRuleWithContextFunc func(ctx context.Context, portForwardingRuleCreateReqInfo ktcloudsdk.CreatePortForwardingRuleReqInfo) (ktcloudsdk.CreatePortForwardingRuleResponse, error)
+
+	// CreateSSHKeyPairFunc mocks the CreateSSHKeyPair method.
+	CreateSSHKeyPairFunc func(name string) (ktcloudsdk.CreateSshKeyPairResponse, error)
+
+	// CreateSSHKeyPairWithContextFunc mocks the CreateSSHKeyPairWithContext method.
+	CreateSSHKeyPairWithContextFunc func(ctx context.Context, name string) (ktcloudsdk.CreateSshKeyPairResponse, error)
+
+	// CreateTagsFunc mocks the CreateTags method.
+	CreateTagsFunc func(options *ktcloudsdk.CreateTagsReqInfo) (ktcloudsdk.CreateTagsResponse, error)
+
+	// CreateTagsWithContextFunc mocks the CreateTagsWithContext method.
+	CreateTagsWithContextFunc func(ctx context.Context, options *ktcloudsdk.CreateTagsReqInfo) (ktcloudsdk.CreateTagsResponse, error)
+
+	// CreateTemplateFunc mocks the CreateTemplate method.
+	CreateTemplateFunc func(req *ktcloudsdk.CreateTemplateReqInfo) (ktcloudsdk.CreateTemplateResponse, error)
+
+	// CreateTemplateWithContextFunc mocks the CreateTemplateWithContext method.
+	CreateTemplateWithContextFunc func(ctx context.Context, req *ktcloudsdk.CreateTemplateReqInfo) (ktcloudsdk.CreateTemplateResponse, error)
+
+	// CreateVolumeFunc mocks the CreateVolume method.
+	CreateVolumeFunc func(req ktcloudsdk.CreateVolumeReqInfo) (ktcloudsdk.CreateVolumeResponse, error)
+
+	// CreateVolumeWithContextFunc mocks the CreateVolumeWithContext method.
+	CreateVolumeWithContextFunc func(ctx context.Context, req ktcloudsdk.CreateVolumeReqInfo) (ktcloudsdk.CreateVolumeResponse, error)
+
+	// DeleteFirewallRuleFunc mocks the DeleteFirewallRule method.
+	DeleteFirewallRuleFunc func(ruleId string) (ktcloudsdk.DeleteFirewallRuleResponse, error)
+
+	// DeleteFirewallRuleWithContextFunc mocks the DeleteFirewallRuleWithContext method.
+	DeleteFirewallRuleWithContextFunc func(ctx context.Context, ruleId string) (ktcloudsdk.DeleteFirewallRuleResponse, error)
+
+	// DeleteNLBFunc mocks the DeleteNLB method.
+	DeleteNLBFunc func(nlbId string) (ktcloudsdk.DeleteNLBResponse, error)
+
+	// DeleteNLBWithContextFunc mocks the DeleteNLBWithContext method.
+	DeleteNLBWithContextFunc func(ctx context.Context, nlbId string) (ktcloudsdk.DeleteNLBResponse, error)
+
+	// DeletePortForwardingRuleFunc mocks the DeletePortForwardingRule method.
+	DeletePortForwardingRuleFunc func(ruleId string) (ktcloudsdk.DeletePortForwardingRuleResponse, error)
+
+	// DeletePortForwardingRuleWithContextFunc mocks the DeletePortForwardingRuleWithContext method.
+	DeletePortForwardingRuleWithContextFunc func(ctx context.Context, ruleId string) (ktcloudsdk.DeletePortForwardingRuleResponse, error)
+
+	// DeleteSSHKeyPairFunc mocks the DeleteSSHKeyPair method.
+	DeleteSSHKeyPairFunc func(name string) (ktcloudsdk.DeleteSshKeyPairResponse, error)
+
+	// DeleteSSHKeyPairWithContextFunc mocks the DeleteSSHKeyPairWithContext method.
+	DeleteSSHKeyPairWithContextFunc func(ctx context.Context, name string) (ktcloudsdk.DeleteSshKeyPairResponse, error)
+
+	// DeleteTagsFunc mocks the DeleteTags method.
+	DeleteTagsFunc func(options *ktcloudsdk.DeleteTagsReqInfo) (ktcloudsdk.DeleteTagsResponse, error)
+
+	// DeleteTagsWithContextFunc mocks the DeleteTagsWithContext method.
+	DeleteTagsWithContextFunc func(ctx context.Context, options *ktcloudsdk.DeleteTagsReqInfo) (ktcloudsdk.DeleteTagsResponse, error)
+
+	// DeleteTemplateFunc mocks the DeleteTemplate method.
+	DeleteTemplateFunc func(id string, zoneId string) (ktcloudsdk.DeleteTemplateResponse, error)
+
+	// DeleteTemplateWithContextFunc mocks the DeleteTemplateWithContext method.
+	DeleteTemplateWithContextFunc func(ctx context.Context, id string, zoneId string) (ktcloudsdk.DeleteTemplateResponse, error)
+
+	// DeleteVolumeFunc mocks the DeleteVolume method.
+	DeleteVolumeFunc func(id string) (ktcloudsdk.DeleteVolumeResponse, error)
+
+	// DeleteVolumeWithContextFunc mocks the DeleteVolumeWithContext method.
+	DeleteVolumeWithContextFunc func(ctx context.Context, id string) (ktcloudsdk.DeleteVolumeResponse, error)
+
+	// DeployVirtualMachineFunc mocks the DeployVirtualMachine method.
+	DeployVirtualMachineFunc func(vmReqInfo ktcloudsdk.DeployVMReqInfo) (ktcloudsdk.DeployVirtualMachineResponse, error)
+
+	// DeployVirtualMachineWithContextFunc mocks the DeployVirtualMachineWithContext method.
+	DeployVirtualMachineWithContextFunc func(ctx context.Context, vmReqInfo ktcloudsdk.DeployVMReqInfo) (ktcloudsdk.DeployVirtualMachineResponse, error)
+
+	// DestroyVirtualMachineFunc mocks the DestroyVirtualMachine method.
+	DestroyVirtualMachineFunc func(vmId string) (ktcloudsdk.DestroyVirtualMachineResponse, error)
+
+	// DestroyVirtualMachineWithContextFunc mocks the DestroyVirtualMachineWithContext method.
+	DestroyVirtualMachineWithContextFunc func(ctx context.Context, vmId string) (ktcloudsdk.DestroyVirtualMachineResponse, error)
+
+	// DetachVolumeFunc mocks the DetachVolume method.
+	DetachVolumeFunc func(req ktcloudsdk.DetachVolumeReqInfo) (ktcloudsdk.DetachVolumeResponse, error)
+
+	// DetachVolumeWithContextFunc mocks the DetachVolumeWithContext method.
+	DetachVolumeWithContextFunc func(ctx context.Context, req ktcloudsdk.DetachVolumeReqInfo) (ktcloudsdk.DetachVolumeResponse, error)
+
+	// DisassociateIpAddressFunc mocks the DisassociateIpAddress method.
+	DisassociateIpAddressFunc func(publicIpId string) (ktcloudsdk.DisassociateIpAddressResponse, error)
+
+	// DisassociateIpAddressWithContextFunc mocks the DisassociateIpAddressWithContext method.
+	DisassociateIpAddressWithContextFunc func(ctx context.Context, publicIpId string) (ktcloudsdk.DisassociateIpAddressResponse, error)
+
+	// DoFunc mocks the Do method.
+	DoFunc func(ctx context.Context, command string, params url.Values, out interface{}) error
+
+	// InvalidateCacheFunc mocks the InvalidateCache method.
+	InvalidateCacheFunc func(commands ...string)
+
+	// ListAllFirewallRulesFunc mocks the ListAllFirewallRules method.
+	ListAllFirewallRulesFunc func(req ktcloudsdk.ListFirewallRulesReqInfo) ([]ktcloudsdk.FirewallRule, error)
+
+	// ListAllFirewallRulesWithContextFunc mocks the ListAllFirewallRulesWithContext method.
+	ListAllFirewallRulesWithContextFunc func(ctx context.Context, req ktcloudsdk.ListFirewallRulesReqInfo) ([]ktcloudsdk.FirewallRule, error)
+
+	// ListAllPortForwardingRulesFunc mocks the ListAllPortForwardingRules method.
+	ListAllPortForwardingRulesFunc func(req ktcloudsdk.ListPortForwardingRulesReqInfo) ([]ktcloudsdk.PortForwardingRule, error)
+
+	// ListAllPortForwardingRulesWithContextFunc mocks the ListAllPortForwardingRulesWithContext method.
+	ListAllPortForwardingRulesWithContextFunc func(ctx context.Context, req ktcloudsdk.ListPortForwardingRulesReqInfo) ([]ktcloudsdk.PortForwardingRule, error)
+
+	// ListAllPublicIpAddressesFunc mocks the ListAllPublicIpAddresses method.
+	ListAllPublicIpAddressesFunc func(req ktcloudsdk.ListPublicIpReqInfo) ([]ktcloudsdk.PublicIpAddress, error)
+
+	// ListAllPublicIpAddressesWithContextFunc mocks the ListAllPublicIpAddressesWithContext method.
+	ListAllPublicIpAddressesWithContextFunc func(ctx context.Context, req ktcloudsdk.ListPublicIpReqInfo) ([]ktcloudsdk.PublicIpAddress, error)
+
+	// ListAllTemplatesFunc mocks the ListAllTemplates method.
+	ListAllTemplatesFunc func(req *ktcloudsdk.ListTemplateReqInfo) ([]ktcloudsdk.Template, error)
+
+	// ListAllTemplatesWithContextFunc mocks the ListAllTemplatesWithContext method.
+	ListAllTemplatesWithContextFunc func(ctx context.Context, req *ktcloudsdk.ListTemplateReqInfo) ([]ktcloudsdk.Template, error)
+
+	// ListAllVirtualMachinesFunc mocks the ListAllVirtualMachines method.
+	ListAllVirtualMachinesFunc func(vmListReqInfo ktcloudsdk.ListVMReqInfo) ([]ktcloudsdk.Virtualmachine, error)
+
+	// ListAllVirtualMachinesWithContextFunc mocks the ListAllVirtualMachinesWithContext method.
+	ListAllVirtualMachinesWithContextFunc func(ctx context.Context, vmListReqInfo ktcloudsdk.ListVMReqInfo) ([]ktcloudsdk.Virtualmachine, error)
+
+	// ListAllVolumesFunc mocks the ListAllVolumes method.
+	ListAllVolumesFunc func(req ktcloudsdk.ListVolumeReqInfo) ([]ktcloudsdk.Volume, error)
+
+	// ListAllVolumesWithContextFunc mocks the ListAllVolumesWithContext method.
+	ListAllVolumesWithContextFunc func(ctx context.Context, req ktcloudsdk.ListVolumeReqInfo) ([]ktcloudsdk.Volume, error)
+
+	// ListAvailableProductTypesFunc mocks the ListAvailableProductTypes method.
+	ListAvailableProductTypesFunc func(zoneId string) (ktcloudsdk.ListAvailableProductTypesResponse, error)
+
+	// ListAvailableProductTypesWithContextFunc mocks the ListAvailableProductTypesWithContext method.
+	ListAvailableProductTypesWithContextFunc func(ctx context.Context, zoneId string) (ktcloudsdk.ListAvailableProductTypesResponse, error)
+
+	// ListFirewallRulesFunc mocks the ListFirewallRules method.
+	ListFirewallRulesFunc func(filewallRuleListReqInfo ktcloudsdk.ListFirewallRulesReqInfo) (ktcloudsdk.ListFirewallRulesResponse, error)
+
+	// ListFirewallRulesWithContextFunc mocks the ListFirewallRulesWithContext method.
+	ListFirewallRulesWithContextFunc func(ctx context.Context, filewallRuleListReqInfo ktcloudsdk.ListFirewallRulesReqInfo) (ktcloudsdk.ListFirewallRulesResponse, error)
+
+	// ListNLBVMsFunc mocks the ListNLBVMs method.
+	ListNLBVMsFunc func(nlbId string) (ktcloudsdk.ListNLBVMsResponse, error)
+
+	// ListNLBVMsWithContextFunc mocks the ListNLBVMsWithContext method.
+	ListNLBVMsWithContextFunc func(ctx context.Context, nlbId string) (ktcloudsdk.ListNLBVMsResponse, error)
+
+	// ListNLBsFunc mocks the ListNLBs method.
+	ListNLBsFunc func(req ktcloudsdk.ListNLBsReqInfo) (ktcloudsdk.ListNLBsResponse, error)
+
+	// ListNLBsWithContextFunc mocks the ListNLBsWithContext method.
+	ListNLBsWithContextFunc func(ctx context.Context, req ktcloudsdk.ListNLBsReqInfo) (ktcloudsdk.ListNLBsResponse, error)
+
+	// ListPortForwardingRulesFunc mocks the ListPortForwardingRules method.
+	ListPortForwardingRulesFunc func(portForwardingRulesListReqInfo ktcloudsdk.ListPortForwardingRulesReqInfo) (ktcloudsdk.ListPortForwardingRulesResponse, error)
+
+	// ListPortForwardingRulesWithContextFunc mocks the ListPortForwardingRulesWithContext method.
+	ListPortForwardingRulesWithContextFunc func(ctx context.Context, portForwardingRulesListReqInfo ktcloudsdk.ListPortForwardingRulesReqInfo) (ktcloudsdk.ListPortForwardingRulesResponse, error)
+
+	// ListPublicIpAddressesFunc mocks the ListPublicIpAddresses method.
+	ListPublicIpAddressesFunc func(ipListReqInfo ktcloudsdk.ListPublicIpReqInfo) (ktcloudsdk.ListPublicIpAddressesResponse, error)
+
+	// ListPublicIpAddressesWithContextFunc mocks the ListPublicIpAddressesWithContext method.
+	ListPublicIpAddressesWithContextFunc func(ctx context.Context, ipListReqInfo ktcloudsdk.ListPublicIpReqInfo) (ktcloudsdk.ListPublicIpAddressesResponse, error)
+
+	// ListSSHKeyPairsFunc mocks the ListSSHKeyPairs method.
+	ListSSHKeyPairsFunc func(name string) (ktcloudsdk.ListSshKeyPairsResponse, error)
+
+	// ListSSHKeyPairsWithContextFunc mocks the ListSSHKeyPairsWithContext method.
+	ListSSHKeyPairsWithContextFunc func(ctx context.Context, name string) (ktcloudsdk.ListSshKeyPairsResponse, error)
+
+	// ListTagsFunc mocks the ListTags method.
+	ListTagsFunc func(options *ktcloudsdk.ListTagsReqInfo) (ktcloudsdk.ListTagsResponse, error)
+
+	// ListTagsWithContextFunc mocks the ListTagsWithContext method.
+	ListTagsWithContextFunc func(ctx context.Context, options *ktcloudsdk.ListTagsReqInfo) (ktcloudsdk.ListTagsResponse, error)
+
+	// ListTemplatesFunc mocks the ListTemplates method.
+	ListTemplatesFunc func(req *ktcloudsdk.ListTemplateReqInfo) (ktcloudsdk.ListTemplatesResponse, error)
+
+	// ListTemplatesWithContextFunc mocks the ListTemplatesWithContext method.
+	ListTemplatesWithContextFunc func(ctx context.Context, req *ktcloudsdk.ListTemplateReqInfo) (ktcloudsdk.ListTemplatesResponse, error)
+
+	// ListVirtualMachinesFunc mocks the ListVirtualMachines method.
+	ListVirtualMachinesFunc func(vmListReqInfo ktcloudsdk.ListVMReqInfo) (ktcloudsdk.ListVirtualMachinesResponse, error)
+
+	// ListVirtualMachinesWithContextFunc mocks the ListVirtualMachinesWithContext method.
+	ListVirtualMachinesWithContextFunc func(ctx context.Context, vmListReqInfo ktcloudsdk.ListVMReqInfo) (ktcloudsdk.ListVirtualMachinesResponse, error)
+
+	// ListVolumesFunc mocks the ListVolumes method.
+	ListVolumesFunc func(req ktcloudsdk.ListVolumeReqInfo) (ktcloudsdk.ListVolumesResponse, error)
+
+	// ListVolumesWithContextFunc mocks the ListVolumesWithContext method.
+	ListVolumesWithContextFunc func(ctx context.Context, req ktcloudsdk.ListVolumeReqInfo) (ktcloudsdk.ListVolumesResponse, error)
+
+	// ListZonesFunc mocks the ListZones method.
+	ListZonesFunc func(isAvailable bool, domainId string, zoneId string, keyword string) (ktcloudsdk.ListZonesResponse, error)
+
+	// ListZonesWithContextFunc mocks the ListZonesWithContext method.
+	ListZonesWithContextFunc func(ctx context.Context, isAvailable bool, domainId string, zoneId string, keyword string) (ktcloudsdk.ListZonesResponse, error)
+
+	// QueryAsyncJobResultFunc mocks the QueryAsyncJobResult method.
+	QueryAsyncJobResultFunc func(jobId string) (ktcloudsdk.QueryAsyncJobResultResponse, error)
+
+	// QueryAsyncJobResultWithContextFunc mocks the QueryAsyncJobResultWithContext method.
+	QueryAsyncJobResultWithContextFunc func(ctx context.Context, jobId string) (ktcloudsdk.QueryAsyncJobResultResponse, error)
+
+	// RebootVirtualMachineFunc mocks the RebootVirtualMachine method.
+	RebootVirtualMachineFunc func(vmId string) (ktcloudsdk.RebootVirtualMachineResponse, error)
+
+	// RebootVirtualMachineWithContextFunc mocks the RebootVirtualMachineWithContext method.
+	RebootVirtualMachineWithContextFunc func(ctx context.Context, vmId string) (ktcloudsdk.RebootVirtualMachineResponse, error)
+
+	// RemoveNLBVMFunc mocks the RemoveNLBVM method.
+	RemoveNLBVMFunc func(serviceId string) (ktcloudsdk.RemoveNLBVMResponse, error)
+
+	// RemoveNLBVMWithContextFunc mocks the RemoveNLBVMWithContext method.
+	RemoveNLBVMWithContextFunc func(ctx context.Context, serviceId string) (ktcloudsdk.RemoveNLBVMResponse, error)
+
+	// ResizeVolumeFunc mocks the ResizeVolume method.
+	ResizeVolumeFunc func(req ktcloudsdk.ResizeVolumeReqInfo) (ktcloudsdk.ResizeVolumeResponse, error)
+
+	// ResizeVolumeWithContextFunc mocks the ResizeVolumeWithContext method.
+	ResizeVolumeWithContextFunc func(ctx context.Context, req ktcloudsdk.ResizeVolumeReqInfo) (ktcloudsdk.ResizeVolumeResponse, error)
+
+	// StartVirtualMachineFunc mocks the StartVirtualMachine method.
+	StartVirtualMachineFunc func(vmId string) (ktcloudsdk.StartVirtualMachineResponse, error)
+
+	// StartVirtualMachineWithContextFunc mocks the StartVirtualMachineWithContext method.
+	StartVirtualMachineWithContextFunc func(ctx context.Context, vmId string) (ktcloudsdk.StartVirtualMachineResponse, error)
+
+	// StopVirtualMachineFunc mocks the StopVirtualMachine method.
+	StopVirtualMachineFunc func(vmId string) (ktcloudsdk.StopVirtualMachineResponse, error)
+
+	// StopVirtualMachineWithContextFunc mocks the StopVirtualMachineWithContext method.
+	StopVirtualMachineWithContextFunc func(ctx context.Context, vmId string) (ktcloudsdk.StopVirtualMachineResponse, error)
+
+	// UpdateVirtualMachineFunc mocks the UpdateVirtualMachine method.
+	UpdateVirtualMachineFunc func(vmId string, displayname string, haenable string) (ktcloudsdk.UpdateVirtualMachineResponse, error)
+
+	// UpdateVirtualMachineWithContextFunc mocks the UpdateVirtualMachineWithContext method.
+	UpdateVirtualMachineWithContextFunc func(ctx context.Context, vmId string, displayname string, haenable string) (ktcloudsdk.UpdateVirtualMachineResponse, error)
+
+	// WaitForAsyncJobFunc mocks the WaitForAsyncJob method.
+	WaitForAsyncJobFunc func(jobId string, timeOut time.Duration) error
+
+	// WaitForAsyncJobWithContextFunc mocks the WaitForAsyncJobWithContext method.
+	WaitForAsyncJobWithContextFunc func(ctx context.Context, jobId string, timeOut time.Duration) error
+
+	// WaitForVirtualMachineStateFunc mocks the WaitForVirtualMachineState method.
+	WaitForVirtualMachineStateFunc func(zoneId string, vmId string, wantedState string, timeOut time.Duration) error
+
+	// WaitForVirtualMachineStateWithContextFunc mocks the WaitForVirtualMachineStateWithContext method.
+	WaitForVirtualMachineStateWithContextFunc func(ctx context.Context, zoneId string, vmId string, wantedState string, timeOut time.Duration) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddNLBVM holds details about calls to the AddNLBVM method.
+		AddNLBVM []struct {
+			// Req is the req argument value.
+			Req ktcloudsdk.AddNLBVMReqInfo
+		}
+		// AddNLBVMWithContext holds details about calls to the AddNLBVMWithContext method.
+		AddNLBVMWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req ktcloudsdk.AddNLBVMReqInfo
+		}
+		// AllFirewallRules holds details about calls to the AllFirewallRules method.
+		AllFirewallRules []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req ktcloudsdk.ListFirewallRulesReqInfo
+		}
+		// AllPortForwardingRules holds details about calls to the AllPortForwardingRules method.
+		AllPortForwardingRules []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req ktcloudsdk.ListPortForwardingRulesReqInfo
+		}
+		// AllPublicIpAddresses holds details about calls to the AllPublicIpAddresses method.
+		AllPublicIpAddresses []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req ktcloudsdk.ListPublicIpReqInfo
+		}
+		// AllTemplates holds details about calls to the AllTemplates method.
+		AllTemplates []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req *ktcloudsdk.ListTemplateReqInfo
+		}
+		// AllVirtualMachines holds details about calls to the AllVirtualMachines method.
+		AllVirtualMachines []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// VmListReqInfo is the vmListReqInfo argument value.
+			VmListReqInfo ktcloudsdk.ListVMReqInfo
+		}
+		// AllVolumes holds details about calls to the AllVolumes method.
+		AllVolumes []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req ktcloudsdk.ListVolumeReqInfo
+		}
+		// AssociateIpAddress holds details about calls to the AssociateIpAddress method.
+		AssociateIpAddress []struct {
+			// IpReqInfo is the ipReqInfo argument value.
+			IpReqInfo ktcloudsdk.AssociatePublicIpReqInfo
+		}
+		// AssociateIpAddressWithContext holds details about calls to the AssociateIpAddressWithContext method.
+		AssociateIpAddressWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// IpReqInfo is the ipReqInfo argument value.
+			IpReqInfo ktcloudsdk.AssociatePublicIpReqInfo
+		}
+		// AttachVolume holds details about calls to the AttachVolume method.
+		AttachVolume []struct {
+			// Req is the req argument value.
+			Req ktcloudsdk.AttachVolumeReqInfo
+		}
+		// AttachVolumeWithContext holds details about calls to the AttachVolumeWithContext method.
+		AttachVolumeWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req ktcloudsdk.AttachVolumeReqInfo
+		}
+		// CreateFirewallRule holds details about calls to the CreateFirewallRule method.
+		CreateFirewallRule []struct {
+			// FilewallRuleCreateReqInfo is the filewallRuleCreateReqInfo argument value.
+			FilewallRuleCreateReqInfo ktcloudsdk.CreateFirewallRuleReqInfo
+		}
+		// CreateFirewallRuleWithContext holds details about calls to the CreateFirewallRuleWithContext method.
+		CreateFirewallRuleWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FilewallRuleCreateReqInfo is the filewallRuleCreateReqInfo argument value.
+			FilewallRuleCreateReqInfo ktcloudsdk.CreateFirewallRuleReqInfo
+		}
+		// CreateNLB holds details about calls to the CreateNLB method.
+		CreateNLB []struct {
+			// Req is the req argument value.
+			Req ktcloudsdk.CreateNLBReqInfo
+		}
+		// CreateNLBWithContext holds details about calls to the CreateNLBWithContext method.
+		CreateNLBWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req ktcloudsdk.CreateNLBReqInfo
+		}
+		// CreatePortForwardingRule holds details about calls to the CreatePortForwardingRule method.
+		CreatePortForwardingRule []struct {
+			// PortForwardingRuleCreateReqInfo is the portForwardingRuleCreateReqInfo argument value.
+			PortForwardingRuleCreateReqInfo ktcloudsdk.CreatePortForwardingRuleReqInfo
+		}
+		// CreatePortForwardingRuleWithContext holds details about calls to the CreatePortForwardingRuleWithContext method.
+		CreatePortForwardingRuleWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PortForwardingRuleCreateReqInfo is the portForwardingRuleCreateReqInfo argument value.
+			PortForwardingRuleCreateReqInfo ktcloudsdk.CreatePortForwardingRuleReqInfo
+		}
+		// CreateSSHKeyPair holds details about calls to the CreateSSHKeyPair method.
+		CreateSSHKeyPair []struct {
+			// Name is the name argument value.
+			Name string
+		}
+		// CreateSSHKeyPairWithContext holds details about calls to the CreateSSHKeyPairWithContext method.
+		CreateSSHKeyPairWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+		}
+		// CreateTags holds details about calls to the CreateTags method.
+		CreateTags []struct {
+			// Options is the options argument value.
+			Options *ktcloudsdk.CreateTagsReqInfo
+		}
+		// CreateTagsWithContext holds details about calls to the CreateTagsWithContext method.
+		CreateTagsWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Options is the options argument value.
+			Options *ktcloudsdk.CreateTagsReqInfo
+		}
+		// CreateTemplate holds details about calls to the CreateTemplate method.
+		CreateTemplate []struct {
+			// Req is the req argument value.
+			Req *ktcloudsdk.CreateTemplateReqInfo
+		}
+		// CreateTemplateWithContext holds details about calls to the CreateTemplateWithContext method.
+		CreateTemplateWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req *ktcloudsdk.CreateTemplateReqInfo
+		}
+		// CreateVolume holds details about calls to the CreateVolume method.
+		CreateVolume []struct {
+			// Req is the req argument value.
+			Req ktcloudsdk.CreateVolumeReqInfo
+		}
+		// CreateVolumeWithContext holds details about calls to the CreateVolumeWithContext method.
+		CreateVolumeWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req ktcloudsdk.CreateVolumeReqInfo
+		}
+		// DeleteFirewallRule holds details about calls to the DeleteFirewallRule method.
+		DeleteFirewallRule []struct {
+			// RuleId is the ruleId argument value.
+			RuleId string
+		}
+		// DeleteFirewallRuleWithContext holds details about calls to the DeleteFirewallRuleWithContext method.
+		DeleteFirewallRuleWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RuleId is the ruleId argument value.
+			RuleId string
+		}
+		// DeleteNLB holds details about calls to the DeleteNLB method.
+		DeleteNLB []struct {
+			// NlbId is the nlbId argument value.
+			NlbId string
+		}
+		// DeleteNLBWithContext holds details about calls to the DeleteNLBWithContext method.
+		DeleteNLBWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// NlbId is the nlbId argument value.
+			NlbId string
+		}
+		// DeletePortForwardingRule holds details about calls to the DeletePortForwardingRule method.
+		DeletePortForwardingRule []struct {
+			// RuleId is the ruleId argument value.
+			RuleId string
+		}
+		// DeletePortForwardingRuleWithContext holds details about calls to the DeletePortForwardingRuleWithContext method.
+		DeletePortForwardingRuleWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RuleId is the ruleId argument value.
+			RuleId string
+		}
+		// DeleteSSHKeyPair holds details about calls to the DeleteSSHKeyPair method.
+		DeleteSSHKeyPair []struct {
+			// Name is the name argument value.
+			Name string
+		}
+		// DeleteSSHKeyPairWithContext holds details about calls to the DeleteSSHKeyPairWithContext method.
+		DeleteSSHKeyPairWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+		}
+		// DeleteTags holds details about calls to the DeleteTags method.
+		DeleteTags []struct {
+			// Options is the options argument value.
+			Options *ktcloudsdk.DeleteTagsReqInfo
+		}
+		// DeleteTagsWithContext holds details about calls to the DeleteTagsWithContext method.
+		DeleteTagsWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Options is the options argument value.
+			Options *ktcloudsdk.DeleteTagsReqInfo
+		}
+		// DeleteTemplate holds details about calls to the DeleteTemplate method.
+		DeleteTemplate []struct {
+			// ID is the id argument value.
+			ID string
+			// ZoneId is the zoneId argument value.
+			ZoneId string
+		}
+		// DeleteTemplateWithContext holds details about calls to the DeleteTemplateWithContext method.
+		DeleteTemplateWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// ZoneId is the zoneId argument value.
+			ZoneId string
+		}
+		// DeleteVolume holds details about calls to the DeleteVolume method.
+		DeleteVolume []struct {
+			// ID is the id argument value.
+			ID string
+		}
+		// DeleteVolumeWithContext holds details about calls to the DeleteVolumeWithContext method.
+		DeleteVolumeWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// DeployVirtualMachine holds details about calls to the DeployVirtualMachine method.
+		DeployVirtualMachine []struct {
+			// VmReqInfo is the vmReqInfo argument value.
+			VmReqInfo ktcloudsdk.DeployVMReqInfo
+		}
+		// DeployVirtualMachineWithContext holds details about calls to the DeployVirtualMachineWithContext method.
+		DeployVirtualMachineWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// VmReqInfo is the vmReqInfo argument value.
+			VmReqInfo ktcloudsdk.DeployVMReqInfo
+		}
+		// DestroyVirtualMachine holds details about calls to the DestroyVirtualMachine method.
+		DestroyVirtualMachine []struct {
+			// VmId is the vmId argument value.
+			VmId string
+		}
+		// DestroyVirtualMachineWithContext holds details about calls to the DestroyVirtualMachineWithContext method.
+		DestroyVirtualMachineWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// VmId is the vmId argument value.
+			VmId string
+		}
+		// DetachVolume holds details about calls to the DetachVolume method.
+		DetachVolume []struct {
+			// Req is the req argument value.
+			Req ktcloudsdk.DetachVolumeReqInfo
+		}
+		// DetachVolumeWithContext holds details about calls to the DetachVolumeWithContext method.
+		DetachVolumeWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req ktcloudsdk.DetachVolumeReqInfo
+		}
+		// DisassociateIpAddress holds details about calls to the DisassociateIpAddress method.
+		DisassociateIpAddress []struct {
+			// PublicIpId is the publicIpId argument value.
+			PublicIpId string
+		}
+		// DisassociateIpAddressWithContext holds details about calls to the DisassociateIpAddressWithContext method.
+		DisassociateIpAddressWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PublicIpId is the publicIpId argument value.
+			PublicIpId string
+		}
+		// Do holds details about calls to the Do method.
+		Do []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Command is the command argument value.
+			Command string
+			// Params is the params argument value.
+			Params url.Values
+			// Out is the out argument value.
+			Out interface{}
+		}
+		// InvalidateCache holds details about calls to the InvalidateCache method.
+		InvalidateCache []struct {
+			// Commands is the commands argument value.
+			Commands []string
+		}
+		// ListAllFirewallRules holds details about calls to the ListAllFirewallRules method.
+		ListAllFirewallRules []struct {
+			// Req is the req argument value.
+			Req ktcloudsdk.ListFirewallRulesReqInfo
+		}
+		// ListAllFirewallRulesWithContext holds details about calls to the ListAllFirewallRulesWithContext method.
+		ListAllFirewallRulesWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req ktcloudsdk.ListFirewallRulesReqInfo
+		}
+		// ListAllPortForwardingRules holds details about calls to the ListAllPortForwardingRules method.
+		ListAllPortForwardingRules []struct {
+			// Req is the req argument value.
+			Req ktcloudsdk.ListPortForwardingRulesReqInfo
+		}
+		// ListAllPortForwardingRulesWithContext holds details about calls to the ListAllPortForwardingRulesWithContext method.
+		ListAllPortForwardingRulesWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req ktcloudsdk.ListPortForwardingRulesReqInfo
+		}
+		// ListAllPublicIpAddresses holds details about calls to the ListAllPublicIpAddresses method.
+		ListAllPublicIpAddresses []struct {
+			// Req is the req argument value.
+			Req ktcloudsdk.ListPublicIpReqInfo
+		}
+		// ListAllPublicIpAddressesWithContext holds details about calls to the ListAllPublicIpAddressesWithContext method.
+		ListAllPublicIpAddressesWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req ktcloudsdk.ListPublicIpReqInfo
+		}
+		// ListAllTemplates holds details about calls to the ListAllTemplates method.
+		ListAllTemplates []struct {
+			// Req is the req argument value.
+			Req *ktcloudsdk.ListTemplateReqInfo
+		}
+		// ListAllTemplatesWithContext holds details about calls to the ListAllTemplatesWithContext method.
+		ListAllTemplatesWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req *ktcloudsdk.ListTemplateReqInfo
+		}
+		// ListAllVirtualMachines holds details about calls to the ListAllVirtualMachines method.
+		ListAllVirtualMachines []struct {
+			// VmListReqInfo is the vmListReqInfo argument value.
+			VmListReqInfo ktcloudsdk.ListVMReqInfo
+		}
+		// ListAllVirtualMachinesWithContext holds details about calls to the ListAllVirtualMachinesWithContext method.
+		ListAllVirtualMachinesWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// VmListReqInfo is the vmListReqInfo argument value.
+			VmListReqInfo ktcloudsdk.ListVMReqInfo
+		}
+		// ListAllVolumes holds details about calls to the ListAllVolumes method.
+		ListAllVolumes []struct {
+			// Req is the req argument value.
+			Req ktcloudsdk.ListVolumeReqInfo
+		}
+		// ListAllVolumesWithContext holds details about calls to the ListAllVolumesWithContext method.
+		ListAllVolumesWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req ktcloudsdk.ListVolumeReqInfo
+		}
+		// ListAvailableProductTypes holds details about calls to the ListAvailableProductTypes method.
+		ListAvailableProductTypes []struct {
+			// ZoneId is the zoneId argument value.
+			ZoneId string
+		}
+		// ListAvailableProductTypesWithContext holds details about calls to the ListAvailableProductTypesWithContext method.
+		ListAvailableProductTypesWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ZoneId is the zoneId argument value.
+			ZoneId string
+		}
+		// ListFirewallRules holds details about calls to the ListFirewallRules method.
+		ListFirewallRules []struct {
+			// FilewallRuleListReqInfo is the filewallRuleListReqInfo argument value.
+			FilewallRuleListReqInfo ktcloudsdk.ListFirewallRulesReqInfo
+		}
+		// ListFirewallRulesWithContext holds details about calls to the ListFirewallRulesWithContext method.
+		ListFirewallRulesWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FilewallRuleListReqInfo is the filewallRuleListReqInfo argument value.
+			FilewallRuleListReqInfo ktcloudsdk.ListFirewallRulesReqInfo
+		}
+		// ListNLBVMs holds details about calls to the ListNLBVMs method.
+		ListNLBVMs []struct {
+			// NlbId is the nlbId argument value.
+			NlbId string
+		}
+		// ListNLBVMsWithContext holds details about calls to the ListNLBVMsWithContext method.
+		ListNLBVMsWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// NlbId is the nlbId argument value.
+			NlbId string
+		}
+		// ListNLBs holds details about calls to the ListNLBs method.
+		ListNLBs []struct {
+			// Req is the req argument value.
+			Req ktcloudsdk.ListNLBsReqInfo
+		}
+		// ListNLBsWithContext holds details about calls to the ListNLBsWithContext method.
+		ListNLBsWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req ktcloudsdk.ListNLBsReqInfo
+		}
+		// ListPortForwardingRules holds details about calls to the ListPortForwardingRules method.
+		ListPortForwardingRules []struct {
+			// PortForwardingRulesListReqInfo is the portForwardingRulesListReqInfo argument value.
+			PortForwardingRulesListReqInfo ktcloudsdk.ListPortForwardingRulesReqInfo
+		}
+		// ListPortForwardingRulesWithContext holds details about calls to the ListPortForwardingRulesWithContext method.
+		ListPortForwardingRulesWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PortForwardingRulesListReqInfo is the portForwardingRulesListReqInfo argument value.
+			PortForwardingRulesListReqInfo ktcloudsdk.ListPortForwardingRulesReqInfo
+		}
+		// ListPublicIpAddresses holds details about calls to the ListPublicIpAddresses method.
+		ListPublicIpAddresses []struct {
+			// IpListReqInfo is the ipListReqInfo argument value.
+			IpListReqInfo ktcloudsdk.ListPublicIpReqInfo
+		}
+		// ListPublicIpAddressesWithContext holds details about calls to the ListPublicIpAddressesWithContext method.
+		ListPublicIpAddressesWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// IpListReqInfo is the ipListReqInfo argument value.
+			IpListReqInfo ktcloudsdk.ListPublicIpReqInfo
+		}
+		// ListSSHKeyPairs holds details about calls to the ListSSHKeyPairs method.
+		ListSSHKeyPairs []struct {
+			// Name is the name argument value.
+			Name string
+		}
+		// ListSSHKeyPairsWithContext holds details about calls to the ListSSHKeyPairsWithContext method.
+		ListSSHKeyPairsWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+		}
+		// ListTags holds details about calls to the ListTags method.
+		ListTags []struct {
+			// Options is the options argument value.
+			Options *ktcloudsdk.ListTagsReqInfo
+		}
+		// ListTagsWithContext holds details about calls to the ListTagsWithContext method.
+		ListTagsWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Options is the options argument value.
+			Options *ktcloudsdk.ListTagsReqInfo
+		}
+		// ListTemplates holds details about calls to the ListTemplates method.
+		ListTemplates []struct {
+			// Req is the req argument value.
+			Req *ktcloudsdk.ListTemplateReqInfo
+		}
+		// ListTemplatesWithContext holds details about calls to the ListTemplatesWithContext method.
+		ListTemplatesWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req *ktcloudsdk.ListTemplateReqInfo
+		}
+		// ListVirtualMachines holds details about calls to the ListVirtualMachines method.
+		ListVirtualMachines []struct {
+			// VmListReqInfo is the vmListReqInfo argument value.
+			VmListReqInfo ktcloudsdk.ListVMReqInfo
+		}
+		// ListVirtualMachinesWithContext holds details about calls to the ListVirtualMachinesWithContext method.
+		ListVirtualMachinesWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// VmListReqInfo is the vmListReqInfo argument value.
+			VmListReqInfo ktcloudsdk.ListVMReqInfo
+		}
+		// ListVolumes holds details about calls to the ListVolumes method.
+		ListVolumes []struct {
+			// Req is the req argument value.
+			Req ktcloudsdk.ListVolumeReqInfo
+		}
+		// ListVolumesWithContext holds details about calls to the ListVolumesWithContext method.
+		ListVolumesWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req ktcloudsdk.ListVolumeReqInfo
+		}
+		// ListZones holds details about calls to the ListZones method.
+		ListZones []struct {
+			// IsAvailable is the isAvailable argument value.
+			IsAvailable bool
+			// DomainId is the domainId argument value.
+			DomainId string
+			// ZoneId is the zoneId argument value.
+			ZoneId string
+			// Keyword is the keyword argument value.
+			Keyword string
+		}
+		// ListZonesWithContext holds details about calls to the ListZonesWithContext method.
+		ListZonesWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// IsAvailable is the isAvailable argument value.
+			IsAvailable bool
+			// DomainId is the domainId argument value.
+			DomainId string
+			// ZoneId is the zoneId argument value.
+			ZoneId string
+			// Keyword is the keyword argument value.
+			Keyword string
+		}
+		// QueryAsyncJobResult holds details about calls to the QueryAsyncJobResult method.
+		QueryAsyncJobResult []struct {
+			// JobId is the jobId argument value.
+			JobId string
+		}
+		// QueryAsyncJobResultWithContext holds details about calls to the QueryAsyncJobResultWithContext method.
+		QueryAsyncJobResultWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// JobId is the jobId argument value.
+			JobId string
+		}
+		// RebootVirtualMachine holds details about calls to the RebootVirtualMachine method.
+		RebootVirtualMachine []struct {
+			// VmId is the vmId argument value.
+			VmId string
+		}
+		// RebootVirtualMachineWithContext holds details about calls to the RebootVirtualMachineWithContext method.
+		RebootVirtualMachineWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// VmId is the vmId argument value.
+			VmId string
+		}
+		// RemoveNLBVM holds details about calls to the RemoveNLBVM method.
+		RemoveNLBVM []struct {
+			// ServiceId is the serviceId argument value.
+			ServiceId string
+		}
+		// RemoveNLBVMWithContext holds details about calls to the RemoveNLBVMWithContext method.
+		RemoveNLBVMWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ServiceId is the serviceId argument value.
+			ServiceId string
+		}
+		// ResizeVolume holds details about calls to the ResizeVolume method.
+		ResizeVolume []struct {
+			// Req is the req argument value.
+			Req ktcloudsdk.ResizeVolumeReqInfo
+		}
+		// ResizeVolumeWithContext holds details about calls to the ResizeVolumeWithContext method.
+		ResizeVolumeWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req ktcloudsdk.ResizeVolumeReqInfo
+		}
+		// StartVirtualMachine holds details about calls to the StartVirtualMachine method.
+		StartVirtualMachine []struct {
+			// VmId is the vmId argument value.
+			VmId string
+		}
+		// StartVirtualMachineWithContext holds details about calls to the StartVirtualMachineWithContext method.
+		StartVirtualMachineWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// VmId is the vmId argument value.
+			VmId string
+		}
+		// StopVirtualMachine holds details about calls to the StopVirtualMachine method.
+		StopVirtualMachine []struct {
+			// VmId is the vmId argument value.
+			VmId string
+		}
+		// StopVirtualMachineWithContext holds details about calls to the StopVirtualMachineWithContext method.
+		StopVirtualMachineWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// VmId is the vmId argument value.
+			VmId string
+		}
+		// UpdateVirtualMachine holds details about calls to the UpdateVirtualMachine method.
+		UpdateVirtualMachine []struct {
+			// VmId is the vmId argument value.
+			VmId string
+			// Displayname is the displayname argument value.
+			Displayname string
+			// Haenable is the haenable argument value.
+			Haenable string
+		}
+		// UpdateVirtualMachineWithContext holds details about calls to the UpdateVirtualMachineWithContext method.
+		UpdateVirtualMachineWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// VmId is the vmId argument value.
+			VmId string
+			// Displayname is the displayname argument value.
+			Displayname string
+			// Haenable is the haenable argument value.
+			Haenable string
+		}
+		// WaitForAsyncJob holds details about calls to the WaitForAsyncJob method.
+		WaitForAsyncJob []struct {
+			// JobId is the jobId argument value.
+			JobId string
+			// TimeOut is the timeOut argument value.
+			TimeOut time.Duration
+		}
+		// WaitForAsyncJobWithContext holds details about calls to the WaitForAsyncJobWithContext method.
+		WaitForAsyncJobWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// JobId is the jobId argument value.
+			JobId string
+			// TimeOut is the timeOut argument value.
+			TimeOut time.Duration
+		}
+		// WaitForVirtualMachineState holds details about calls to the WaitForVirtualMachineState method.
+		WaitForVirtualMachineState []struct {
+			// ZoneId is the zoneId argument value.
+			ZoneId string
+			// VmId is the vmId argument value.
+			VmId string
+			// WantedState is the wantedState argument value.
+			WantedState string
+			// TimeOut is the timeOut argument value.
+			TimeOut time.Duration
+		}
+		// WaitForVirtualMachineStateWithContext holds details about calls to the WaitForVirtualMachineStateWithContext method.
+		WaitForVirtualMachineStateWithContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ZoneId is the zoneId argument value.
+			ZoneId string
+			// VmId is the vmId argument value.
+			VmId string
+			// WantedState is the wantedState argument value.
+			WantedState string
+			// TimeOut is the timeOut argument value.
+			TimeOut time.Duration
+		}
+	}
+	lockAddNLBVM                              sync.RWMutex
+	lockAddNLBVMWithContext                   sync.RWMutex
+	lockAllFirewallRules                      sync.RWMutex
+	lockAllPortForwardingRules                sync.RWMutex
+	lockAllPublicIpAddresses                  sync.RWMutex
+	lockAllTemplates                          sync.RWMutex
+	lockAllVirtualMachines                    sync.RWMutex
+	lockAllVolumes                            sync.RWMutex
+	lockAssociateIpAddress                    sync.RWMutex
+	lockAssociateIpAddressWithContext         sync.RWMutex
+	lockAttachVolume                          sync.RWMutex
+	lockAttachVolumeWithContext               sync.RWMutex
+	lockCreateFirewallRule                    sync.RWMutex
+	lockCreateFirewallRuleWithContext         sync.RWMutex
+	lockCreateNLB                             sync.RWMutex
+	lockCreateNLBWithContext                  sync.RWMutex
+	lockCreatePortForwardingRule              sync.RWMutex
+	lockCreatePortForwardingRuleWithContext   sync.RWMutex
+	lockCreateSSHKeyPair                      sync.RWMutex
+	lockCreateSSHKeyPairWithContext           sync.RWMutex
+	lockCreateTags                            sync.RWMutex
+	lockCreateTagsWithContext                 sync.RWMutex
+	lockCreateTemplate                        sync.RWMutex
+	lockCreateTemplateWithContext             sync.RWMutex
+	lockCreateVolume                          sync.RWMutex
+	lockCreateVolumeWithContext               sync.RWMutex
+	lockDeleteFirewallRule                    sync.RWMutex
+	lockDeleteFirewallRuleWithContext         sync.RWMutex
+	lockDeleteNLB                             sync.RWMutex
+	lockDeleteNLBWithContext                  sync.RWMutex
+	lockDeletePortForwardingRule              sync.RWMutex
+	lockDeletePortForwardingRuleWithContext   sync.RWMutex
+	lockDeleteSSHKeyPair                      sync.RWMutex
+	lockDeleteSSHKeyPairWithContext           sync.RWMutex
+	lockDeleteTags                            sync.RWMutex
+	lockDeleteTagsWithContext                 sync.RWMutex
+	lockDeleteTemplate                        sync.RWMutex
+	lockDeleteTemplateWithContext             sync.RWMutex
+	lockDeleteVolume                          sync.RWMutex
+	lockDeleteVolumeWithContext               sync.RWMutex
+	lockDeployVirtualMachine                  sync.RWMutex
+	lockDeployVirtualMachineWithContext       sync.RWMutex
+	lockDestroyVirtualMachine                 sync.RWMutex
+	lockDestroyVirtualMachineWithContext      sync.RWMutex
+	lockDetachVolume                          sync.RWMutex
+	lockDetachVolumeWithContext               sync.RWMutex
+	lockDisassociateIpAddress                 sync.RWMutex
+	lockDisassociateIpAddressWithContext      sync.RWMutex
+	lockDo                                    sync.RWMutex
+	lockInvalidateCache                       sync.RWMutex
+	lockListAllFirewallRules                  sync.RWMutex
+	lockListAllFirewallRulesWithContext       sync.RWMutex
+	lockListAllPortForwardingRules            sync.RWMutex
+	lockListAllPortForwardingRulesWithContext sync.RWMutex
+	lockListAllPublicIpAddresses              sync.RWMutex
+	lockListAllPublicIpAddressesWithContext   sync.RWMutex
+	lockListAllTemplates                      sync.RWMutex
+	lockListAllTemplatesWithContext           sync.RWMutex
+	lockListAllVirtualMachines                sync.RWMutex
+	lockListAllVirtualMachinesWithContext     sync.RWMutex
+	lockListAllVolumes                        sync.RWMutex
+	lockListAllVolumesWithContext             sync.RWMutex
+	lockListAvailableProductTypes             sync.RWMutex
+	lockListAvailableProductTypesWithContext  sync.RWMutex
+	lockListFirewallRules                     sync.RWMutex
+	lockListFirewallRulesWithContext          sync.RWMutex
+	lockListNLBVMs                            sync.RWMutex
+	lockListNLBVMsWithContext                 sync.RWMutex
+	lockListNLBs                              sync.RWMutex
+	lockListNLBsWithContext                   sync.RWMutex
+	lockListPortForwardingRules               sync.RWMutex
+	lockListPortForwardingRulesWithContext    sync.RWMutex
+	lockListPublicIpAddresses                 sync.RWMutex
+	lockListPublicIpAddressesWithContext      sync.RWMutex
+	lockListSSHKeyPairs                       sync.RWMutex
+	lockListSSHKeyPairsWithContext            sync.RWMutex
+	lockListTags                              sync.RWMutex
+	lockListTagsWithContext                   sync.RWMutex
+	lockListTemplates                         sync.RWMutex
+	lockListTemplatesWithContext              sync.RWMutex
+	lockListVirtualMachines                   sync.RWMutex
+	lockListVirtualMachinesWithContext        sync.RWMutex
+	lockListVolumes                           sync.RWMutex
+	lockListVolumesWithContext                sync.RWMutex
+	lockListZones                             sync.RWMutex
+	lockListZonesWithContext                  sync.RWMutex
+	lockQueryAsyncJobResult                   sync.RWMutex
+	lockQueryAsyncJobResultWithContext        sync.RWMutex
+	lockRebootVirtualMachine                  sync.RWMutex
+	lockRebootVirtualMachineWithContext       sync.RWMutex
+	lockRemoveNLBVM                           sync.RWMutex
+	lockRemoveNLBVMWithContext                sync.RWMutex
+	lockResizeVolume                          sync.RWMutex
+	lockResizeVolumeWithContext               sync.RWMutex
+	lockStartVirtualMachine                   sync.RWMutex
+	lockStartVirtualMachineWithContext        sync.RWMutex
+	lockStopVirtualMachine                    sync.RWMutex
+	lockStopVirtualMachineWithContext         sync.RWMutex
+	lockUpdateVirtualMachine                  sync.RWMutex
+	lockUpdateVirtualMachineWithContext       sync.RWMutex
+	lockWaitForAsyncJob                       sync.RWMutex
+	lockWaitForAsyncJobWithContext            sync.RWMutex
+	lockWaitForVirtualMachineState            sync.RWMutex
+	lockWaitForVirtualMachineStateWithContext sync.RWMutex
+}
+
+// AddNLBVM calls AddNLBVMFunc.
+func (mock *KtCloudAPIMock) AddNLBVM(req ktcloudsdk.AddNLBVMReqInfo) (ktcloudsdk.AddNLBVMResponse, error) {
+	if mock.AddNLBVMFunc == nil {
+		panic("KtCloudAPIMock.AddNLBVMFunc: method is nil but KtCloudAPI.AddNLBVM was just called")
+	}
+	callInfo := struct {
+		Req ktcloudsdk.AddNLBVMReqInfo
+	}{
+		Req: req,
+	}
+	mock.lockAddNLBVM.Lock()
+	mock.calls.AddNLBVM = append(mock.calls.AddNLBVM, callInfo)
+	mock.lockAddNLBVM.Unlock()
+	return mock.AddNLBVMFunc(req)
+}
+
+// AddNLBVMCalls gets all the calls that were made to AddNLBVM.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.AddNLBVMCalls())
+func (mock *KtCloudAPIMock) AddNLBVMCalls() []struct {
+	Req ktcloudsdk.AddNLBVMReqInfo
+} {
+	var calls []struct {
+		Req ktcloudsdk.AddNLBVMReqInfo
+	}
+	mock.lockAddNLBVM.RLock()
+	calls = mock.calls.AddNLBVM
+	mock.lockAddNLBVM.RUnlock()
+	return calls
+}
+
+// AddNLBVMWithContext calls AddNLBVMWithContextFunc.
+func (mock *KtCloudAPIMock) AddNLBVMWithContext(ctx context.Context, req ktcloudsdk.AddNLBVMReqInfo) (ktcloudsdk.AddNLBVMResponse, error) {
+	if mock.AddNLBVMWithContextFunc == nil {
+		panic("KtCloudAPIMock.AddNLBVMWithContextFunc: method is nil but KtCloudAPI.AddNLBVMWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req ktcloudsdk.AddNLBVMReqInfo
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockAddNLBVMWithContext.Lock()
+	mock.calls.AddNLBVMWithContext = append(mock.calls.AddNLBVMWithContext, callInfo)
+	mock.lockAddNLBVMWithContext.Unlock()
+	return mock.AddNLBVMWithContextFunc(ctx, req)
+}
+
+// AddNLBVMWithContextCalls gets all the calls that were made to AddNLBVMWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.AddNLBVMWithContextCalls())
+func (mock *KtCloudAPIMock) AddNLBVMWithContextCalls() []struct {
+	Ctx context.Context
+	Req ktcloudsdk.AddNLBVMReqInfo
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req ktcloudsdk.AddNLBVMReqInfo
+	}
+	mock.lockAddNLBVMWithContext.RLock()
+	calls = mock.calls.AddNLBVMWithContext
+	mock.lockAddNLBVMWithContext.RUnlock()
+	return calls
+}
+
+// AllFirewallRules calls AllFirewallRulesFunc.
+func (mock *KtCloudAPIMock) AllFirewallRules(ctx context.Context, req ktcloudsdk.ListFirewallRulesReqInfo) iter.Seq2[ktcloudsdk.FirewallRule, error] {
+	if mock.AllFirewallRulesFunc == nil {
+		panic("KtCloudAPIMock.AllFirewallRulesFunc: method is nil but KtCloudAPI.AllFirewallRules was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req ktcloudsdk.ListFirewallRulesReqInfo
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockAllFirewallRules.Lock()
+	mock.calls.AllFirewallRules = append(mock.calls.AllFirewallRules, callInfo)
+	mock.lockAllFirewallRules.Unlock()
+	return mock.AllFirewallRulesFunc(ctx, req)
+}
+
+// AllFirewallRulesCalls gets all the calls that were made to AllFirewallRules.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.AllFirewallRulesCalls())
+func (mock *KtCloudAPIMock) AllFirewallRulesCalls() []struct {
+	Ctx context.Context
+	Req ktcloudsdk.ListFirewallRulesReqInfo
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req ktcloudsdk.ListFirewallRulesReqInfo
+	}
+	mock.lockAllFirewallRules.RLock()
+	calls = mock.calls.AllFirewallRules
+	mock.lockAllFirewallRules.RUnlock()
+	return calls
+}
+
+// AllPortForwardingRules calls AllPortForwardingRulesFunc.
+func (mock *KtCloudAPIMock) AllPortForwardingRules(ctx context.Context, req ktcloudsdk.ListPortForwardingRulesReqInfo) iter.Seq2[ktcloudsdk.PortForwardingRule, error] {
+	if mock.AllPortForwardingRulesFunc == nil {
+		panic("KtCloudAPIMock.AllPortForwardingRulesFunc: method is nil but KtCloudAPI.AllPortForwardingRules was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req ktcloudsdk.ListPortForwardingRulesReqInfo
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockAllPortForwardingRules.Lock()
+	mock.calls.AllPortForwardingRules = append(mock.calls.AllPortForwardingRules, callInfo)
+	mock.lockAllPortForwardingRules.Unlock()
+	return mock.AllPortForwardingRulesFunc(ctx, req)
+}
+
+// AllPortForwardingRulesCalls gets all the calls that were made to AllPortForwardingRules.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.AllPortForwardingRulesCalls())
+func (mock *KtCloudAPIMock) AllPortForwardingRulesCalls() []struct {
+	Ctx context.Context
+	Req ktcloudsdk.ListPortForwardingRulesReqInfo
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req ktcloudsdk.ListPortForwardingRulesReqInfo
+	}
+	mock.lockAllPortForwardingRules.RLock()
+	calls = mock.calls.AllPortForwardingRules
+	mock.lockAllPortForwardingRules.RUnlock()
+	return calls
+}
+
+// AllPublicIpAddresses calls AllPublicIpAddressesFunc.
+func (mock *KtCloudAPIMock) AllPublicIpAddresses(ctx context.Context, req ktcloudsdk.ListPublicIpReqInfo) iter.Seq2[ktcloudsdk.PublicIpAddress, error] {
+	if mock.AllPublicIpAddressesFunc == nil {
+		panic("KtCloudAPIMock.AllPublicIpAddressesFunc: method is nil but KtCloudAPI.AllPublicIpAddresses was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req ktcloudsdk.ListPublicIpReqInfo
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockAllPublicIpAddresses.Lock()
+	mock.calls.AllPublicIpAddresses = append(mock.calls.AllPublicIpAddresses, callInfo)
+	mock.lockAllPublicIpAddresses.Unlock()
+	return mock.AllPublicIpAddressesFunc(ctx, req)
+}
+
+// AllPublicIpAddressesCalls gets all the calls that were made to AllPublicIpAddresses.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.AllPublicIpAddressesCalls())
+func (mock *KtCloudAPIMock) AllPublicIpAddressesCalls() []struct {
+	Ctx context.Context
+	Req ktcloudsdk.ListPublicIpReqInfo
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req ktcloudsdk.ListPublicIpReqInfo
+	}
+	mock.lockAllPublicIpAddresses.RLock()
+	calls = mock.calls.AllPublicIpAddresses
+	mock.lockAllPublicIpAddresses.RUnlock()
+	return calls
+}
+
+// AllTemplates calls AllTemplatesFunc.
+func (mock *KtCloudAPIMock) AllTemplates(ctx context.Context, req *ktcloudsdk.ListTemplateReqInfo) iter.Seq2[ktcloudsdk.Template, error] {
+	if mock.AllTemplatesFunc == nil {
+		panic("KtCloudAPIMock.AllTemplatesFunc: method is nil but KtCloudAPI.AllTemplates was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req *ktcloudsdk.ListTemplateReqInfo
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockAllTemplates.Lock()
+	mock.calls.AllTemplates = append(mock.calls.AllTemplates, callInfo)
+	mock.lockAllTemplates.Unlock()
+	return mock.AllTemplatesFunc(ctx, req)
+}
+
+// AllTemplatesCalls gets all the calls that were made to AllTemplates.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.AllTemplatesCalls())
+func (mock *KtCloudAPIMock) AllTemplatesCalls() []struct {
+	Ctx context.Context
+	Req *ktcloudsdk.ListTemplateReqInfo
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req *ktcloudsdk.ListTemplateReqInfo
+	}
+	mock.lockAllTemplates.RLock()
+	calls = mock.calls.AllTemplates
+	mock.lockAllTemplates.RUnlock()
+	return calls
+}
+
+// AllVirtualMachines calls AllVirtualMachinesFunc.
+func (mock *KtCloudAPIMock) AllVirtualMachines(ctx context.Context, vmListReqInfo ktcloudsdk.ListVMReqInfo) iter.Seq2[ktcloudsdk.Virtualmachine, error] {
+	if mock.AllVirtualMachinesFunc == nil {
+		panic("KtCloudAPIMock.AllVirtualMachinesFunc: method is nil but KtCloudAPI.AllVirtualMachines was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		VmListReqInfo ktcloudsdk.ListVMReqInfo
+	}{
+		Ctx:           ctx,
+		VmListReqInfo: vmListReqInfo,
+	}
+	mock.lockAllVirtualMachines.Lock()
+	mock.calls.AllVirtualMachines = append(mock.calls.AllVirtualMachines, callInfo)
+	mock.lockAllVirtualMachines.Unlock()
+	return mock.AllVirtualMachinesFunc(ctx, vmListReqInfo)
+}
+
+// AllVirtualMachinesCalls gets all the calls that were made to AllVirtualMachines.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.AllVirtualMachinesCalls())
+func (mock *KtCloudAPIMock) AllVirtualMachinesCalls() []struct {
+	Ctx           context.Context
+	VmListReqInfo ktcloudsdk.ListVMReqInfo
+} {
+	var calls []struct {
+		Ctx           context.Context
+		VmListReqInfo ktcloudsdk.ListVMReqInfo
+	}
+	mock.lockAllVirtualMachines.RLock()
+	calls = mock.calls.AllVirtualMachines
+	mock.lockAllVirtualMachines.RUnlock()
+	return calls
+}
+
+// AllVolumes calls AllVolumesFunc.
+func (mock *KtCloudAPIMock) AllVolumes(ctx context.Context, req ktcloudsdk.ListVolumeReqInfo) iter.Seq2[ktcloudsdk.Volume, error] {
+	if mock.AllVolumesFunc == nil {
+		panic("KtCloudAPIMock.AllVolumesFunc: method is nil but KtCloudAPI.AllVolumes was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req ktcloudsdk.ListVolumeReqInfo
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockAllVolumes.Lock()
+	mock.calls.AllVolumes = append(mock.calls.AllVolumes, callInfo)
+	mock.lockAllVolumes.Unlock()
+	return mock.AllVolumesFunc(ctx, req)
+}
+
+// AllVolumesCalls gets all the calls that were made to AllVolumes.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.AllVolumesCalls())
+func (mock *KtCloudAPIMock) AllVolumesCalls() []struct {
+	Ctx context.Context
+	Req ktcloudsdk.ListVolumeReqInfo
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req ktcloudsdk.ListVolumeReqInfo
+	}
+	mock.lockAllVolumes.RLock()
+	calls = mock.calls.AllVolumes
+	mock.lockAllVolumes.RUnlock()
+	return calls
+}
+
+// AssociateIpAddress calls AssociateIpAddressFunc.
+func (mock *KtCloudAPIMock) AssociateIpAddress(ipReqInfo ktcloudsdk.AssociatePublicIpReqInfo) (ktcloudsdk.AssociateIpAddressResponse, error) {
+	if mock.AssociateIpAddressFunc == nil {
+		panic("KtCloudAPIMock.AssociateIpAddressFunc: method is nil but KtCloudAPI.AssociateIpAddress was just called")
+	}
+	callInfo := struct {
+		IpReqInfo ktcloudsdk.AssociatePublicIpReqInfo
+	}{
+		IpReqInfo: ipReqInfo,
+	}
+	mock.lockAssociateIpAddress.Lock()
+	mock.calls.AssociateIpAddress = append(mock.calls.AssociateIpAddress, callInfo)
+	mock.lockAssociateIpAddress.Unlock()
+	return mock.AssociateIpAddressFunc(ipReqInfo)
+}
+
+// AssociateIpAddressCalls gets all the calls that were made to AssociateIpAddress.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.AssociateIpAddressCalls())
+func (mock *KtCloudAPIMock) AssociateIpAddressCalls() []struct {
+	IpReqInfo ktcloudsdk.AssociatePublicIpReqInfo
+} {
+	var calls []struct {
+		IpReqInfo ktcloudsdk.AssociatePublicIpReqInfo
+	}
+	mock.lockAssociateIpAddress.RLock()
+	calls = mock.calls.AssociateIpAddress
+	mock.lockAssociateIpAddress.RUnlock()
+	return calls
+}
+
+// AssociateIpAddressWithContext calls AssociateIpAddressWithContextFunc.
+func (mock *KtCloudAPIMock) AssociateIpAddressWithContext(ctx context.Context, ipReqInfo ktcloudsdk.AssociatePublicIpReqInfo) (ktcloudsdk.AssociateIpAddressResponse, error) {
+	if mock.AssociateIpAddressWithContextFunc == nil {
+		panic("KtCloudAPIMock.AssociateIpAddressWithContextFunc: method is nil but KtCloudAPI.AssociateIpAddressWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		IpReqInfo ktcloudsdk.AssociatePublicIpReqInfo
+	}{
+		Ctx:       ctx,
+		IpReqInfo: ipReqInfo,
+	}
+	mock.lockAssociateIpAddressWithContext.Lock()
+	mock.calls.AssociateIpAddressWithContext = append(mock.calls.AssociateIpAddressWithContext, callInfo)
+	mock.lockAssociateIpAddressWithContext.Unlock()
+	return mock.AssociateIpAddressWithContextFunc(ctx, ipReqInfo)
+}
+
+// AssociateIpAddressWithContextCalls gets all the calls that were made to AssociateIpAddressWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.AssociateIpAddressWithContextCalls())
+func (mock *KtCloudAPIMock) AssociateIpAddressWithContextCalls() []struct {
+	Ctx       context.Context
+	IpReqInfo ktcloudsdk.AssociatePublicIpReqInfo
+} {
+	var calls []struct {
+		Ctx       context.Context
+		IpReqInfo ktcloudsdk.AssociatePublicIpReqInfo
+	}
+	mock.lockAssociateIpAddressWithContext.RLock()
+	calls = mock.calls.AssociateIpAddressWithContext
+	mock.lockAssociateIpAddressWithContext.RUnlock()
+	return calls
+}
+
+// AttachVolume calls AttachVolumeFunc.
+func (mock *KtCloudAPIMock) AttachVolume(req ktcloudsdk.AttachVolumeReqInfo) (ktcloudsdk.AttachVolumeResponse, error) {
+	if mock.AttachVolumeFunc == nil {
+		panic("KtCloudAPIMock.AttachVolumeFunc: method is nil but KtCloudAPI.AttachVolume was just called")
+	}
+	callInfo := struct {
+		Req ktcloudsdk.AttachVolumeReqInfo
+	}{
+		Req: req,
+	}
+	mock.lockAttachVolume.Lock()
+	mock.calls.AttachVolume = append(mock.calls.AttachVolume, callInfo)
+	mock.lockAttachVolume.Unlock()
+	return mock.AttachVolumeFunc(req)
+}
+
+// AttachVolumeCalls gets all the calls that were made to AttachVolume.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.AttachVolumeCalls())
+func (mock *KtCloudAPIMock) AttachVolumeCalls() []struct {
+	Req ktcloudsdk.AttachVolumeReqInfo
+} {
+	var calls []struct {
+		Req ktcloudsdk.AttachVolumeReqInfo
+	}
+	mock.lockAttachVolume.RLock()
+	calls = mock.calls.AttachVolume
+	mock.lockAttachVolume.RUnlock()
+	return calls
+}
+
+// AttachVolumeWithContext calls AttachVolumeWithContextFunc.
+func (mock *KtCloudAPIMock) AttachVolumeWithContext(ctx context.Context, req ktcloudsdk.AttachVolumeReqInfo) (ktcloudsdk.AttachVolumeResponse, error) {
+	if mock.AttachVolumeWithContextFunc == nil {
+		panic("KtCloudAPIMock.AttachVolumeWithContextFunc: method is nil but KtCloudAPI.AttachVolumeWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req ktcloudsdk.AttachVolumeReqInfo
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockAttachVolumeWithContext.Lock()
+	mock.calls.AttachVolumeWithContext = append(mock.calls.AttachVolumeWithContext, callInfo)
+	mock.lockAttachVolumeWithContext.Unlock()
+	return mock.AttachVolumeWithContextFunc(ctx, req)
+}
+
+// AttachVolumeWithContextCalls gets all the calls that were made to AttachVolumeWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.AttachVolumeWithContextCalls())
+func (mock *KtCloudAPIMock) AttachVolumeWithContextCalls() []struct {
+	Ctx context.Context
+	Req ktcloudsdk.AttachVolumeReqInfo
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req ktcloudsdk.AttachVolumeReqInfo
+	}
+	mock.lockAttachVolumeWithContext.RLock()
+	calls = mock.calls.AttachVolumeWithContext
+	mock.lockAttachVolumeWithContext.RUnlock()
+	return calls
+}
+
+// CreateFirewallRule calls CreateFirewallRuleFunc.
+func (mock *KtCloudAPIMock) CreateFirewallRule(filewallRuleCreateReqInfo ktcloudsdk.CreateFirewallRuleReqInfo) (ktcloudsdk.CreateFirewallRuleResponse, error) {
+	if mock.CreateFirewallRuleFunc == nil {
+		panic("KtCloudAPIMock.CreateFirewallRuleFunc: method is nil but KtCloudAPI.CreateFirewallRule was just called")
+	}
+	callInfo := struct {
+		FilewallRuleCreateReqInfo ktcloudsdk.CreateFirewallRuleReqInfo
+	}{
+		FilewallRuleCreateReqInfo: filewallRuleCreateReqInfo,
+	}
+	mock.lockCreateFirewallRule.Lock()
+	mock.calls.CreateFirewallRule = append(mock.calls.CreateFirewallRule, callInfo)
+	mock.lockCreateFirewallRule.Unlock()
+	return mock.CreateFirewallRuleFunc(filewallRuleCreateReqInfo)
+}
+
+// CreateFirewallRuleCalls gets all the calls that were made to CreateFirewallRule.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.CreateFirewallRuleCalls())
+func (mock *KtCloudAPIMock) CreateFirewallRuleCalls() []struct {
+	FilewallRuleCreateReqInfo ktcloudsdk.CreateFirewallRuleReqInfo
+} {
+	var calls []struct {
+		FilewallRuleCreateReqInfo ktcloudsdk.CreateFirewallRuleReqInfo
+	}
+	mock.lockCreateFirewallRule.RLock()
+	calls = mock.calls.CreateFirewallRule
+	mock.lockCreateFirewallRule.RUnlock()
+	return calls
+}
+
+// CreateFirewallRuleWithContext calls CreateFirewallRuleWithContextFunc.
+func (mock *KtCloudAPIMock) CreateFirewallRuleWithContext(ctx context.Context, filewallRuleCreateReqInfo ktcloudsdk.CreateFirewallRuleReqInfo) (ktcloudsdk.CreateFirewallRuleResponse, error) {
+	if mock.CreateFirewallRuleWithContextFunc == nil {
+		panic("KtCloudAPIMock.CreateFirewallRuleWithContextFunc: method is nil but KtCloudAPI.CreateFirewallRuleWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx                       context.Context
+		FilewallRuleCreateReqInfo ktcloudsdk.CreateFirewallRuleReqInfo
+	}{
+		Ctx:                       ctx,
+		FilewallRuleCreateReqInfo: filewallRuleCreateReqInfo,
+	}
+	mock.lockCreateFirewallRuleWithContext.Lock()
+	mock.calls.CreateFirewallRuleWithContext = append(mock.calls.CreateFirewallRuleWithContext, callInfo)
+	mock.lockCreateFirewallRuleWithContext.Unlock()
+	return mock.CreateFirewallRuleWithContextFunc(ctx, filewallRuleCreateReqInfo)
+}
+
+// CreateFirewallRuleWithContextCalls gets all the calls that were made to CreateFirewallRuleWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.CreateFirewallRuleWithContextCalls())
+func (mock *KtCloudAPIMock) CreateFirewallRuleWithContextCalls() []struct {
+	Ctx                       context.Context
+	FilewallRuleCreateReqInfo ktcloudsdk.CreateFirewallRuleReqInfo
+} {
+	var calls []struct {
+		Ctx                       context.Context
+		FilewallRuleCreateReqInfo ktcloudsdk.CreateFirewallRuleReqInfo
+	}
+	mock.lockCreateFirewallRuleWithContext.RLock()
+	calls = mock.calls.CreateFirewallRuleWithContext
+	mock.lockCreateFirewallRuleWithContext.RUnlock()
+	return calls
+}
+
+// CreateNLB calls CreateNLBFunc.
+func (mock *KtCloudAPIMock) CreateNLB(req ktcloudsdk.CreateNLBReqInfo) (ktcloudsdk.CreateNLBResponse, error) {
+	if mock.CreateNLBFunc == nil {
+		panic("KtCloudAPIMock.CreateNLBFunc: method is nil but KtCloudAPI.CreateNLB was just called")
+	}
+	callInfo := struct {
+		Req ktcloudsdk.CreateNLBReqInfo
+	}{
+		Req: req,
+	}
+	mock.lockCreateNLB.Lock()
+	mock.calls.CreateNLB = append(mock.calls.CreateNLB, callInfo)
+	mock.lockCreateNLB.Unlock()
+	return mock.CreateNLBFunc(req)
+}
+
+// CreateNLBCalls gets all the calls that were made to CreateNLB.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.CreateNLBCalls())
+func (mock *KtCloudAPIMock) CreateNLBCalls() []struct {
+	Req ktcloudsdk.CreateNLBReqInfo
+} {
+	var calls []struct {
+		Req ktcloudsdk.CreateNLBReqInfo
+	}
+	mock.lockCreateNLB.RLock()
+	calls = mock.calls.CreateNLB
+	mock.lockCreateNLB.RUnlock()
+	return calls
+}
+
+// CreateNLBWithContext calls CreateNLBWithContextFunc.
+func (mock *KtCloudAPIMock) CreateNLBWithContext(ctx context.Context, req ktcloudsdk.CreateNLBReqInfo) (ktcloudsdk.CreateNLBResponse, error) {
+	if mock.CreateNLBWithContextFunc == nil {
+		panic("KtCloudAPIMock.CreateNLBWithContextFunc: method is nil but KtCloudAPI.CreateNLBWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req ktcloudsdk.CreateNLBReqInfo
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockCreateNLBWithContext.Lock()
+	mock.calls.CreateNLBWithContext = append(mock.calls.CreateNLBWithContext, callInfo)
+	mock.lockCreateNLBWithContext.Unlock()
+	return mock.CreateNLBWithContextFunc(ctx, req)
+}
+
+// CreateNLBWithContextCalls gets all the calls that were made to CreateNLBWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.CreateNLBWithContextCalls())
+func (mock *KtCloudAPIMock) CreateNLBWithContextCalls() []struct {
+	Ctx context.Context
+	Req ktcloudsdk.CreateNLBReqInfo
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req ktcloudsdk.CreateNLBReqInfo
+	}
+	mock.lockCreateNLBWithContext.RLock()
+	calls = mock.calls.CreateNLBWithContext
+	mock.lockCreateNLBWithContext.RUnlock()
+	return calls
+}
+
+// CreatePortForwardingRule calls CreatePortForwardingRuleFunc.
+func (mock *KtCloudAPIMock) CreatePortForwardingRule(portForwardingRuleCreateReqInfo ktcloudsdk.CreatePortForwardingRuleReqInfo) (ktcloudsdk.CreatePortForwardingRuleResponse, error) {
+	if mock.CreatePortForwardingRuleFunc == nil {
+		panic("KtCloudAPIMock.CreatePortForwardingRuleFunc: method is nil but KtCloudAPI.CreatePortForwardingRule was just called")
+	}
+	callInfo := struct {
+		PortForwardingRuleCreateReqInfo ktcloudsdk.CreatePortForwardingRuleReqInfo
+	}{
+		PortForwardingRuleCreateReqInfo: portForwardingRuleCreateReqInfo,
+	}
+	mock.lockCreatePortForwardingRule.Lock()
+	mock.calls.CreatePortForwardingRule = append(mock.calls.CreatePortForwardingRule, callInfo)
+	mock.lockCreatePortForwardingRule.Unlock()
+	return mock.CreatePortForwardingRuleFunc(portForwardingRuleCreateReqInfo)
+}
+
+// CreatePortForwardingRuleCalls gets all the calls that were made to CreatePortForwardingRule.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.CreatePortForwardingRuleCalls())
+func (mock *KtCloudAPIMock) CreatePortForwardingRuleCalls() []struct {
+	PortForwardingRuleCreateReqInfo ktcloudsdk.CreatePortForwardingRuleReqInfo
+} {
+	var calls []struct {
+		PortForwardingRuleCreateReqInfo ktcloudsdk.CreatePortForwardingRuleReqInfo
+	}
+	mock.lockCreatePortForwardingRule.RLock()
+	calls = mock.calls.CreatePortForwardingRule
+	mock.lockCreatePortForwardingRule.RUnlock()
+	return calls
+}
+
+// CreatePortForwardingRuleWithContext calls CreatePortForwardingRuleWithContextFunc.
+func (mock *KtCloudAPIMock) CreatePortForwardingRuleWithContext(ctx context.Context, portForwardingRuleCreateReqInfo ktcloudsdk.CreatePortForwardingRuleReqInfo) (ktcloudsdk.CreatePortForwardingRuleResponse, error) {
+	if mock.CreatePortForwardingRuleWithContextFunc == nil {
+		panic("KtCloudAPIMock.CreatePortForwardingRuleWithContextFunc: method is nil but KtCloudAPI.CreatePortForwardingRuleWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx                             context.Context
+		PortForwardingRuleCreateReqInfo ktcloudsdk.CreatePortForwardingRuleReqInfo
+	}{
+		Ctx:                             ctx,
+		PortForwardingRuleCreateReqInfo: portForwardingRuleCreateReqInfo,
+	}
+	mock.lockCreatePortForwardingRuleWithContext.Lock()
+	mock.calls.CreatePortForwardingRuleWithContext = append(mock.calls.CreatePortForwardingRuleWithContext, callInfo)
+	mock.lockCreatePortForwardingRuleWithContext.Unlock()
+	return mock.CreatePortForwardingRuleWithContextFunc(ctx, portForwardingRuleCreateReqInfo)
+}
+
+// CreatePortForwardingRuleWithContextCalls gets all the calls that were made to CreatePortForwardingRuleWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.CreatePortForwardingRuleWithContextCalls())
+func (mock *KtCloudAPIMock) CreatePortForwardingRuleWithContextCalls() []struct {
+	Ctx                             context.Context
+	PortForwardingRuleCreateReqInfo ktcloudsdk.CreatePortForwardingRuleReqInfo
+} {
+	var calls []struct {
+		Ctx                             context.Context
+		PortForwardingRuleCreateReqInfo ktcloudsdk.CreatePortForwardingRuleReqInfo
+	}
+	mock.lockCreatePortForwardingRuleWithContext.RLock()
+	calls = mock.calls.CreatePortForwardingRuleWithContext
+	mock.lockCreatePortForwardingRuleWithContext.RUnlock()
+	return calls
+}
+
+// CreateSSHKeyPair calls CreateSSHKeyPairFunc.
+func (mock *KtCloudAPIMock) CreateSSHKeyPair(name string) (ktcloudsdk.CreateSshKeyPairResponse, error) {
+	if mock.CreateSSHKeyPairFunc == nil {
+		panic("KtCloudAPIMock.CreateSSHKeyPairFunc: method is nil but KtCloudAPI.CreateSSHKeyPair was just called")
+	}
+	callInfo := struct {
+		Name string
+	}{
+		Name: name,
+	}
+	mock.lockCreateSSHKeyPair.Lock()
+	mock.calls.CreateSSHKeyPair = append(mock.calls.CreateSSHKeyPair, callInfo)
+	mock.lockCreateSSHKeyPair.Unlock()
+	return mock.CreateSSHKeyPairFunc(name)
+}
+
+// CreateSSHKeyPairCalls gets all the calls that were made to CreateSSHKeyPair.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.CreateSSHKeyPairCalls())
+func (mock *KtCloudAPIMock) CreateSSHKeyPairCalls() []struct {
+	Name string
+} {
+	var calls []struct {
+		Name string
+	}
+	mock.lockCreateSSHKeyPair.RLock()
+	calls = mock.calls.CreateSSHKeyPair
+	mock.lockCreateSSHKeyPair.RUnlock()
+	return calls
+}
+
+// CreateSSHKeyPairWithContext calls CreateSSHKeyPairWithContextFunc.
+func (mock *KtCloudAPIMock) CreateSSHKeyPairWithContext(ctx context.Context, name string) (ktcloudsdk.CreateSshKeyPairResponse, error) {
+	if mock.CreateSSHKeyPairWithContextFunc == nil {
+		panic("KtCloudAPIMock.CreateSSHKeyPairWithContextFunc: method is nil but KtCloudAPI.CreateSSHKeyPairWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+	}{
+		Ctx:  ctx,
+		Name: name,
+	}
+	mock.lockCreateSSHKeyPairWithContext.Lock()
+	mock.calls.CreateSSHKeyPairWithContext = append(mock.calls.CreateSSHKeyPairWithContext, callInfo)
+	mock.lockCreateSSHKeyPairWithContext.Unlock()
+	return mock.CreateSSHKeyPairWithContextFunc(ctx, name)
+}
+
+// CreateSSHKeyPairWithContextCalls gets all the calls that were made to CreateSSHKeyPairWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.CreateSSHKeyPairWithContextCalls())
+func (mock *KtCloudAPIMock) CreateSSHKeyPairWithContextCalls() []struct {
+	Ctx  context.Context
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+	}
+	mock.lockCreateSSHKeyPairWithContext.RLock()
+	calls = mock.calls.CreateSSHKeyPairWithContext
+	mock.lockCreateSSHKeyPairWithContext.RUnlock()
+	return calls
+}
+
+// CreateTags calls CreateTagsFunc.
+func (mock *KtCloudAPIMock) CreateTags(options *ktcloudsdk.CreateTagsReqInfo) (ktcloudsdk.CreateTagsResponse, error) {
+	if mock.CreateTagsFunc == nil {
+		panic("KtCloudAPIMock.CreateTagsFunc: method is nil but KtCloudAPI.CreateTags was just called")
+	}
+	callInfo := struct {
+		Options *ktcloudsdk.CreateTagsReqInfo
+	}{
+		Options: options,
+	}
+	mock.lockCreateTags.Lock()
+	mock.calls.CreateTags = append(mock.calls.CreateTags, callInfo)
+	mock.lockCreateTags.Unlock()
+	return mock.CreateTagsFunc(options)
+}
+
+// CreateTagsCalls gets all the calls that were made to CreateTags.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.CreateTagsCalls())
+func (mock *KtCloudAPIMock) CreateTagsCalls() []struct {
+	Options *ktcloudsdk.CreateTagsReqInfo
+} {
+	var calls []struct {
+		Options *ktcloudsdk.CreateTagsReqInfo
+	}
+	mock.lockCreateTags.RLock()
+	calls = mock.calls.CreateTags
+	mock.lockCreateTags.RUnlock()
+	return calls
+}
+
+// CreateTagsWithContext calls CreateTagsWithContextFunc.
+func (mock *KtCloudAPIMock) CreateTagsWithContext(ctx context.Context, options *ktcloudsdk.CreateTagsReqInfo) (ktcloudsdk.CreateTagsResponse, error) {
+	if mock.CreateTagsWithContextFunc == nil {
+		panic("KtCloudAPIMock.CreateTagsWithContextFunc: method is nil but KtCloudAPI.CreateTagsWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Options *ktcloudsdk.CreateTagsReqInfo
+	}{
+		Ctx:     ctx,
+		Options: options,
+	}
+	mock.lockCreateTagsWithContext.Lock()
+	mock.calls.CreateTagsWithContext = append(mock.calls.CreateTagsWithContext, callInfo)
+	mock.lockCreateTagsWithContext.Unlock()
+	return mock.CreateTagsWithContextFunc(ctx, options)
+}
+
+// CreateTagsWithContextCalls gets all the calls that were made to CreateTagsWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.CreateTagsWithContextCalls())
+func (mock *KtCloudAPIMock) CreateTagsWithContextCalls() []struct {
+	Ctx     context.Context
+	Options *ktcloudsdk.CreateTagsReqInfo
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Options *ktcloudsdk.CreateTagsReqInfo
+	}
+	mock.lockCreateTagsWithContext.RLock()
+	calls = mock.calls.CreateTagsWithContext
+	mock.lockCreateTagsWithContext.RUnlock()
+	return calls
+}
+
+// CreateTemplate calls CreateTemplateFunc.
+func (mock *KtCloudAPIMock) CreateTemplate(req *ktcloudsdk.CreateTemplateReqInfo) (ktcloudsdk.CreateTemplateResponse, error) {
+	if mock.CreateTemplateFunc == nil {
+		panic("KtCloudAPIMock.CreateTemplateFunc: method is nil but KtCloudAPI.CreateTemplate was just called")
+	}
+	callInfo := struct {
+		Req *ktcloudsdk.CreateTemplateReqInfo
+	}{
+		Req: req,
+	}
+	mock.lockCreateTemplate.Lock()
+	mock.calls.CreateTemplate = append(mock.calls.CreateTemplate, callInfo)
+	mock.lockCreateTemplate.Unlock()
+	return mock.CreateTemplateFunc(req)
+}
+
+// CreateTemplateCalls gets all the calls that were made to CreateTemplate.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.CreateTemplateCalls())
+func (mock *KtCloudAPIMock) CreateTemplateCalls() []struct {
+	Req *ktcloudsdk.CreateTemplateReqInfo
+} {
+	var calls []struct {
+		Req *ktcloudsdk.CreateTemplateReqInfo
+	}
+	mock.lockCreateTemplate.RLock()
+	calls = mock.calls.CreateTemplate
+	mock.lockCreateTemplate.RUnlock()
+	return calls
+}
+
+// CreateTemplateWithContext calls CreateTemplateWithContextFunc.
+func (mock *KtCloudAPIMock) CreateTemplateWithContext(ctx context.Context, req *ktcloudsdk.CreateTemplateReqInfo) (ktcloudsdk.CreateTemplateResponse, error) {
+	if mock.CreateTemplateWithContextFunc == nil {
+		panic("KtCloudAPIMock.CreateTemplateWithContextFunc: method is nil but KtCloudAPI.CreateTemplateWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req *ktcloudsdk.CreateTemplateReqInfo
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockCreateTemplateWithContext.Lock()
+	mock.calls.CreateTemplateWithContext = append(mock.calls.CreateTemplateWithContext, callInfo)
+	mock.lockCreateTemplateWithContext.Unlock()
+	return mock.CreateTemplateWithContextFunc(ctx, req)
+}
+
+// CreateTemplateWithContextCalls gets all the calls that were made to CreateTemplateWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.CreateTemplateWithContextCalls())
+func (mock *KtCloudAPIMock) CreateTemplateWithContextCalls() []struct {
+	Ctx context.Context
+	Req *ktcloudsdk.CreateTemplateReqInfo
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req *ktcloudsdk.CreateTemplateReqInfo
+	}
+	mock.lockCreateTemplateWithContext.RLock()
+	calls = mock.calls.CreateTemplateWithContext
+	mock.lockCreateTemplateWithContext.RUnlock()
+	return calls
+}
+
+// CreateVolume calls CreateVolumeFunc.
+func (mock *KtCloudAPIMock) CreateVolume(req ktcloudsdk.CreateVolumeReqInfo) (ktcloudsdk.CreateVolumeResponse, error) {
+	if mock.CreateVolumeFunc == nil {
+		panic("KtCloudAPIMock.CreateVolumeFunc: method is nil but KtCloudAPI.CreateVolume was just called")
+	}
+	callInfo := struct {
+		Req ktcloudsdk.CreateVolumeReqInfo
+	}{
+		Req: req,
+	}
+	mock.lockCreateVolume.Lock()
+	mock.calls.CreateVolume = append(mock.calls.CreateVolume, callInfo)
+	mock.lockCreateVolume.Unlock()
+	return mock.CreateVolumeFunc(req)
+}
+
+// CreateVolumeCalls gets all the calls that were made to CreateVolume.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.CreateVolumeCalls())
+func (mock *KtCloudAPIMock) CreateVolumeCalls() []struct {
+	Req ktcloudsdk.CreateVolumeReqInfo
+} {
+	var calls []struct {
+		Req ktcloudsdk.CreateVolumeReqInfo
+	}
+	mock.lockCreateVolume.RLock()
+	calls = mock.calls.CreateVolume
+	mock.lockCreateVolume.RUnlock()
+	return calls
+}
+
+// CreateVolumeWithContext calls CreateVolumeWithContextFunc.
+func (mock *KtCloudAPIMock) CreateVolumeWithContext(ctx context.Context, req ktcloudsdk.CreateVolumeReqInfo) (ktcloudsdk.CreateVolumeResponse, error) {
+	if mock.CreateVolumeWithContextFunc == nil {
+		panic("KtCloudAPIMock.CreateVolumeWithContextFunc: method is nil but KtCloudAPI.CreateVolumeWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req ktcloudsdk.CreateVolumeReqInfo
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockCreateVolumeWithContext.Lock()
+	mock.calls.CreateVolumeWithContext = append(mock.calls.CreateVolumeWithContext, callInfo)
+	mock.lockCreateVolumeWithContext.Unlock()
+	return mock.CreateVolumeWithContextFunc(ctx, req)
+}
+
+// CreateVolumeWithContextCalls gets all the calls that were made to CreateVolumeWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.CreateVolumeWithContextCalls())
+func (mock *KtCloudAPIMock) CreateVolumeWithContextCalls() []struct {
+	Ctx context.Context
+	Req ktcloudsdk.CreateVolumeReqInfo
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req ktcloudsdk.CreateVolumeReqInfo
+	}
+	mock.lockCreateVolumeWithContext.RLock()
+	calls = mock.calls.CreateVolumeWithContext
+	mock.lockCreateVolumeWithContext.RUnlock()
+	return calls
+}
+
+// DeleteFirewallRule calls DeleteFirewallRuleFunc.
+func (mock *KtCloudAPIMock) DeleteFirewallRule(ruleId string) (ktcloudsdk.DeleteFirewallRuleResponse, error) {
+	if mock.DeleteFirewallRuleFunc == nil {
+		panic("KtCloudAPIMock.DeleteFirewallRuleFunc: method is nil but KtCloudAPI.DeleteFirewallRule was just called")
+	}
+	callInfo := struct {
+		RuleId string
+	}{
+		RuleId: ruleId,
+	}
+	mock.lockDeleteFirewallRule.Lock()
+	mock.calls.DeleteFirewallRule = append(mock.calls.DeleteFirewallRule, callInfo)
+	mock.lockDeleteFirewallRule.Unlock()
+	return mock.DeleteFirewallRuleFunc(ruleId)
+}
+
+// DeleteFirewallRuleCalls gets all the calls that were made to DeleteFirewallRule.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.DeleteFirewallRuleCalls())
+func (mock *KtCloudAPIMock) DeleteFirewallRuleCalls() []struct {
+	RuleId string
+} {
+	var calls []struct {
+		RuleId string
+	}
+	mock.lockDeleteFirewallRule.RLock()
+	calls = mock.calls.DeleteFirewallRule
+	mock.lockDeleteFirewallRule.RUnlock()
+	return calls
+}
+
+// DeleteFirewallRuleWithContext calls DeleteFirewallRuleWithContextFunc.
+func (mock *KtCloudAPIMock) DeleteFirewallRuleWithContext(ctx context.Context, ruleId string) (ktcloudsdk.DeleteFirewallRuleResponse, error) {
+	if mock.DeleteFirewallRuleWithContextFunc == nil {
+		panic("KtCloudAPIMock.DeleteFirewallRuleWithContextFunc: method is nil but KtCloudAPI.DeleteFirewallRuleWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		RuleId string
+	}{
+		Ctx:    ctx,
+		RuleId: ruleId,
+	}
+	mock.lockDeleteFirewallRuleWithContext.Lock()
+	mock.calls.DeleteFirewallRuleWithContext = append(mock.calls.DeleteFirewallRuleWithContext, callInfo)
+	mock.lockDeleteFirewallRuleWithContext.Unlock()
+	return mock.DeleteFirewallRuleWithContextFunc(ctx, ruleId)
+}
+
+// DeleteFirewallRuleWithContextCalls gets all the calls that were made to DeleteFirewallRuleWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.DeleteFirewallRuleWithContextCalls())
+func (mock *KtCloudAPIMock) DeleteFirewallRuleWithContextCalls() []struct {
+	Ctx    context.Context
+	RuleId string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		RuleId string
+	}
+	mock.lockDeleteFirewallRuleWithContext.RLock()
+	calls = mock.calls.DeleteFirewallRuleWithContext
+	mock.lockDeleteFirewallRuleWithContext.RUnlock()
+	return calls
+}
+
+// DeleteNLB calls DeleteNLBFunc.
+func (mock *KtCloudAPIMock) DeleteNLB(nlbId string) (ktcloudsdk.DeleteNLBResponse, error) {
+	if mock.DeleteNLBFunc == nil {
+		panic("KtCloudAPIMock.DeleteNLBFunc: method is nil but KtCloudAPI.DeleteNLB was just called")
+	}
+	callInfo := struct {
+		NlbId string
+	}{
+		NlbId: nlbId,
+	}
+	mock.lockDeleteNLB.Lock()
+	mock.calls.DeleteNLB = append(mock.calls.DeleteNLB, callInfo)
+	mock.lockDeleteNLB.Unlock()
+	return mock.DeleteNLBFunc(nlbId)
+}
+
+// DeleteNLBCalls gets all the calls that were made to DeleteNLB.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.DeleteNLBCalls())
+func (mock *KtCloudAPIMock) DeleteNLBCalls() []struct {
+	NlbId string
+} {
+	var calls []struct {
+		NlbId string
+	}
+	mock.lockDeleteNLB.RLock()
+	calls = mock.calls.DeleteNLB
+	mock.lockDeleteNLB.RUnlock()
+	return calls
+}
+
+// DeleteNLBWithContext calls DeleteNLBWithContextFunc.
+func (mock *KtCloudAPIMock) DeleteNLBWithContext(ctx context.Context, nlbId string) (ktcloudsdk.DeleteNLBResponse, error) {
+	if mock.DeleteNLBWithContextFunc == nil {
+		panic("KtCloudAPIMock.DeleteNLBWithContextFunc: method is nil but KtCloudAPI.DeleteNLBWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		NlbId string
+	}{
+		Ctx:   ctx,
+		NlbId: nlbId,
+	}
+	mock.lockDeleteNLBWithContext.Lock()
+	mock.calls.DeleteNLBWithContext = append(mock.calls.DeleteNLBWithContext, callInfo)
+	mock.lockDeleteNLBWithContext.Unlock()
+	return mock.DeleteNLBWithContextFunc(ctx, nlbId)
+}
+
+// DeleteNLBWithContextCalls gets all the calls that were made to DeleteNLBWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.DeleteNLBWithContextCalls())
+func (mock *KtCloudAPIMock) DeleteNLBWithContextCalls() []struct {
+	Ctx   context.Context
+	NlbId string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		NlbId string
+	}
+	mock.lockDeleteNLBWithContext.RLock()
+	calls = mock.calls.DeleteNLBWithContext
+	mock.lockDeleteNLBWithContext.RUnlock()
+	return calls
+}
+
+// DeletePortForwardingRule calls DeletePortForwardingRuleFunc.
+func (mock *KtCloudAPIMock) DeletePortForwardingRule(ruleId string) (ktcloudsdk.DeletePortForwardingRuleResponse, error) {
+	if mock.DeletePortForwardingRuleFunc == nil {
+		panic("KtCloudAPIMock.DeletePortForwardingRuleFunc: method is nil but KtCloudAPI.DeletePortForwardingRule was just called")
+	}
+	callInfo := struct {
+		RuleId string
+	}{
+		RuleId: ruleId,
+	}
+	mock.lockDeletePortForwardingRule.Lock()
+	mock.calls.DeletePortForwardingRule = append(mock.calls.DeletePortForwardingRule, callInfo)
+	mock.lockDeletePortForwardingRule.Unlock()
+	return mock.DeletePortForwardingRuleFunc(ruleId)
+}
+
+// DeletePortForwardingRuleCalls gets all the calls that were made to DeletePortForwardingRule.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.DeletePortForwardingRuleCalls())
+func (mock *KtCloudAPIMock) DeletePortForwardingRuleCalls() []struct {
+	RuleId string
+} {
+	var calls []struct {
+		RuleId string
+	}
+	mock.lockDeletePortForwardingRule.RLock()
+	calls = mock.calls.DeletePortForwardingRule
+	mock.lockDeletePortForwardingRule.RUnlock()
+	return calls
+}
+
+// DeletePortForwardingRuleWithContext calls DeletePortForwardingRuleWithContextFunc.
+func (mock *KtCloudAPIMock) DeletePortForwardingRuleWithContext(ctx context.Context, ruleId string) (ktcloudsdk.DeletePortForwardingRuleResponse, error) {
+	if mock.DeletePortForwardingRuleWithContextFunc == nil {
+		panic("KtCloudAPIMock.DeletePortForwardingRuleWithContextFunc: method is nil but KtCloudAPI.DeletePortForwardingRuleWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		RuleId string
+	}{
+		Ctx:    ctx,
+		RuleId: ruleId,
+	}
+	mock.lockDeletePortForwardingRuleWithContext.Lock()
+	mock.calls.DeletePortForwardingRuleWithContext = append(mock.calls.DeletePortForwardingRuleWithContext, callInfo)
+	mock.lockDeletePortForwardingRuleWithContext.Unlock()
+	return mock.DeletePortForwardingRuleWithContextFunc(ctx, ruleId)
+}
+
+// DeletePortForwardingRuleWithContextCalls gets all the calls that were made to DeletePortForwardingRuleWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.DeletePortForwardingRuleWithContextCalls())
+func (mock *KtCloudAPIMock) DeletePortForwardingRuleWithContextCalls() []struct {
+	Ctx    context.Context
+	RuleId string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		RuleId string
+	}
+	mock.lockDeletePortForwardingRuleWithContext.RLock()
+	calls = mock.calls.DeletePortForwardingRuleWithContext
+	mock.lockDeletePortForwardingRuleWithContext.RUnlock()
+	return calls
+}
+
+// DeleteSSHKeyPair calls DeleteSSHKeyPairFunc.
+func (mock *KtCloudAPIMock) DeleteSSHKeyPair(name string) (ktcloudsdk.DeleteSshKeyPairResponse, error) {
+	if mock.DeleteSSHKeyPairFunc == nil {
+		panic("KtCloudAPIMock.DeleteSSHKeyPairFunc: method is nil but KtCloudAPI.DeleteSSHKeyPair was just called")
+	}
+	callInfo := struct {
+		Name string
+	}{
+		Name: name,
+	}
+	mock.lockDeleteSSHKeyPair.Lock()
+	mock.calls.DeleteSSHKeyPair = append(mock.calls.DeleteSSHKeyPair, callInfo)
+	mock.lockDeleteSSHKeyPair.Unlock()
+	return mock.DeleteSSHKeyPairFunc(name)
+}
+
+// DeleteSSHKeyPairCalls gets all the calls that were made to DeleteSSHKeyPair.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.DeleteSSHKeyPairCalls())
+func (mock *KtCloudAPIMock) DeleteSSHKeyPairCalls() []struct {
+	Name string
+} {
+	var calls []struct {
+		Name string
+	}
+	mock.lockDeleteSSHKeyPair.RLock()
+	calls = mock.calls.DeleteSSHKeyPair
+	mock.lockDeleteSSHKeyPair.RUnlock()
+	return calls
+}
+
+// DeleteSSHKeyPairWithContext calls DeleteSSHKeyPairWithContextFunc.
+func (mock *KtCloudAPIMock) DeleteSSHKeyPairWithContext(ctx context.Context, name string) (ktcloudsdk.DeleteSshKeyPairResponse, error) {
+	if mock.DeleteSSHKeyPairWithContextFunc == nil {
+		panic("KtCloudAPIMock.DeleteSSHKeyPairWithContextFunc: method is nil but KtCloudAPI.DeleteSSHKeyPairWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+	}{
+		Ctx:  ctx,
+		Name: name,
+	}
+	mock.lockDeleteSSHKeyPairWithContext.Lock()
+	mock.calls.DeleteSSHKeyPairWithContext = append(mock.calls.DeleteSSHKeyPairWithContext, callInfo)
+	mock.lockDeleteSSHKeyPairWithContext.Unlock()
+	return mock.DeleteSSHKeyPairWithContextFunc(ctx, name)
+}
+
+// DeleteSSHKeyPairWithContextCalls gets all the calls that were made to DeleteSSHKeyPairWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.DeleteSSHKeyPairWithContextCalls())
+func (mock *KtCloudAPIMock) DeleteSSHKeyPairWithContextCalls() []struct {
+	Ctx  context.Context
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+	}
+	mock.lockDeleteSSHKeyPairWithContext.RLock()
+	calls = mock.calls.DeleteSSHKeyPairWithContext
+	mock.lockDeleteSSHKeyPairWithContext.RUnlock()
+	return calls
+}
+
+// DeleteTags calls DeleteTagsFunc.
+func (mock *KtCloudAPIMock) DeleteTags(options *ktcloudsdk.DeleteTagsReqInfo) (ktcloudsdk.DeleteTagsResponse, error) {
+	if mock.DeleteTagsFunc == nil {
+		panic("KtCloudAPIMock.DeleteTagsFunc: method is nil but KtCloudAPI.DeleteTags was just called")
+	}
+	callInfo := struct {
+		Options *ktcloudsdk.DeleteTagsReqInfo
+	}{
+		Options: options,
+	}
+	mock.lockDeleteTags.Lock()
+	mock.calls.DeleteTags = append(mock.calls.DeleteTags, callInfo)
+	mock.lockDeleteTags.Unlock()
+	return mock.DeleteTagsFunc(options)
+}
+
+// DeleteTagsCalls gets all the calls that were made to DeleteTags.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.DeleteTagsCalls())
+func (mock *KtCloudAPIMock) DeleteTagsCalls() []struct {
+	Options *ktcloudsdk.DeleteTagsReqInfo
+} {
+	var calls []struct {
+		Options *ktcloudsdk.DeleteTagsReqInfo
+	}
+	mock.lockDeleteTags.RLock()
+	calls = mock.calls.DeleteTags
+	mock.lockDeleteTags.RUnlock()
+	return calls
+}
+
+// DeleteTagsWithContext calls DeleteTagsWithContextFunc.
+func (mock *KtCloudAPIMock) DeleteTagsWithContext(ctx context.Context, options *ktcloudsdk.DeleteTagsReqInfo) (ktcloudsdk.DeleteTagsResponse, error) {
+	if mock.DeleteTagsWithContextFunc == nil {
+		panic("KtCloudAPIMock.DeleteTagsWithContextFunc: method is nil but KtCloudAPI.DeleteTagsWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Options *ktcloudsdk.DeleteTagsReqInfo
+	}{
+		Ctx:     ctx,
+		Options: options,
+	}
+	mock.lockDeleteTagsWithContext.Lock()
+	mock.calls.DeleteTagsWithContext = append(mock.calls.DeleteTagsWithContext, callInfo)
+	mock.lockDeleteTagsWithContext.Unlock()
+	return mock.DeleteTagsWithContextFunc(ctx, options)
+}
+
+// DeleteTagsWithContextCalls gets all the calls that were made to DeleteTagsWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.DeleteTagsWithContextCalls())
+func (mock *KtCloudAPIMock) DeleteTagsWithContextCalls() []struct {
+	Ctx     context.Context
+	Options *ktcloudsdk.DeleteTagsReqInfo
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Options *ktcloudsdk.DeleteTagsReqInfo
+	}
+	mock.lockDeleteTagsWithContext.RLock()
+	calls = mock.calls.DeleteTagsWithContext
+	mock.lockDeleteTagsWithContext.RUnlock()
+	return calls
+}
+
+// DeleteTemplate calls DeleteTemplateFunc.
+func (mock *KtCloudAPIMock) DeleteTemplate(id string, zoneId string) (ktcloudsdk.DeleteTemplateResponse, error) {
+	if mock.DeleteTemplateFunc == nil {
+		panic("KtCloudAPIMock.DeleteTemplateFunc: method is nil but KtCloudAPI.DeleteTemplate was just called")
+	}
+	callInfo := struct {
+		ID     string
+		ZoneId string
+	}{
+		ID:     id,
+		ZoneId: zoneId,
+	}
+	mock.lockDeleteTemplate.Lock()
+	mock.calls.DeleteTemplate = append(mock.calls.DeleteTemplate, callInfo)
+	mock.lockDeleteTemplate.Unlock()
+	return mock.DeleteTemplateFunc(id, zoneId)
+}
+
+// DeleteTemplateCalls gets all the calls that were made to DeleteTemplate.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.DeleteTemplateCalls())
+func (mock *KtCloudAPIMock) DeleteTemplateCalls() []struct {
+	ID     string
+	ZoneId string
+} {
+	var calls []struct {
+		ID     string
+		ZoneId string
+	}
+	mock.lockDeleteTemplate.RLock()
+	calls = mock.calls.DeleteTemplate
+	mock.lockDeleteTemplate.RUnlock()
+	return calls
+}
+
+// DeleteTemplateWithContext calls DeleteTemplateWithContextFunc.
+func (mock *KtCloudAPIMock) DeleteTemplateWithContext(ctx context.Context, id string, zoneId string) (ktcloudsdk.DeleteTemplateResponse, error) {
+	if mock.DeleteTemplateWithContextFunc == nil {
+		panic("KtCloudAPIMock.DeleteTemplateWithContextFunc: method is nil but KtCloudAPI.DeleteTemplateWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ID     string
+		ZoneId string
+	}{
+		Ctx:    ctx,
+		ID:     id,
+		ZoneId: zoneId,
+	}
+	mock.lockDeleteTemplateWithContext.Lock()
+	mock.calls.DeleteTemplateWithContext = append(mock.calls.DeleteTemplateWithContext, callInfo)
+	mock.lockDeleteTemplateWithContext.Unlock()
+	return mock.DeleteTemplateWithContextFunc(ctx, id, zoneId)
+}
+
+// DeleteTemplateWithContextCalls gets all the calls that were made to DeleteTemplateWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.DeleteTemplateWithContextCalls())
+func (mock *KtCloudAPIMock) DeleteTemplateWithContextCalls() []struct {
+	Ctx    context.Context
+	ID     string
+	ZoneId string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ID     string
+		ZoneId string
+	}
+	mock.lockDeleteTemplateWithContext.RLock()
+	calls = mock.calls.DeleteTemplateWithContext
+	mock.lockDeleteTemplateWithContext.RUnlock()
+	return calls
+}
+
+// DeleteVolume calls DeleteVolumeFunc.
+func (mock *KtCloudAPIMock) DeleteVolume(id string) (ktcloudsdk.DeleteVolumeResponse, error) {
+	if mock.DeleteVolumeFunc == nil {
+		panic("KtCloudAPIMock.DeleteVolumeFunc: method is nil but KtCloudAPI.DeleteVolume was just called")
+	}
+	callInfo := struct {
+		ID string
+	}{
+		ID: id,
+	}
+	mock.lockDeleteVolume.Lock()
+	mock.calls.DeleteVolume = append(mock.calls.DeleteVolume, callInfo)
+	mock.lockDeleteVolume.Unlock()
+	return mock.DeleteVolumeFunc(id)
+}
+
+// DeleteVolumeCalls gets all the calls that were made to DeleteVolume.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.DeleteVolumeCalls())
+func (mock *KtCloudAPIMock) DeleteVolumeCalls() []struct {
+	ID string
+} {
+	var calls []struct {
+		ID string
+	}
+	mock.lockDeleteVolume.RLock()
+	calls = mock.calls.DeleteVolume
+	mock.lockDeleteVolume.RUnlock()
+	return calls
+}
+
+// DeleteVolumeWithContext calls DeleteVolumeWithContextFunc.
+func (mock *KtCloudAPIMock) DeleteVolumeWithContext(ctx context.Context, id string) (ktcloudsdk.DeleteVolumeResponse, error) {
+	if mock.DeleteVolumeWithContextFunc == nil {
+		panic("KtCloudAPIMock.DeleteVolumeWithContextFunc: method is nil but KtCloudAPI.DeleteVolumeWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteVolumeWithContext.Lock()
+	mock.calls.DeleteVolumeWithContext = append(mock.calls.DeleteVolumeWithContext, callInfo)
+	mock.lockDeleteVolumeWithContext.Unlock()
+	return mock.DeleteVolumeWithContextFunc(ctx, id)
+}
+
+// DeleteVolumeWithContextCalls gets all the calls that were made to DeleteVolumeWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.DeleteVolumeWithContextCalls())
+func (mock *KtCloudAPIMock) DeleteVolumeWithContextCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockDeleteVolumeWithContext.RLock()
+	calls = mock.calls.DeleteVolumeWithContext
+	mock.lockDeleteVolumeWithContext.RUnlock()
+	return calls
+}
+
+// DeployVirtualMachine calls DeployVirtualMachineFunc.
+func (mock *KtCloudAPIMock) DeployVirtualMachine(vmReqInfo ktcloudsdk.DeployVMReqInfo) (ktcloudsdk.DeployVirtualMachineResponse, error) {
+	if mock.DeployVirtualMachineFunc == nil {
+		panic("KtCloudAPIMock.DeployVirtualMachineFunc: method is nil but KtCloudAPI.DeployVirtualMachine was just called")
+	}
+	callInfo := struct {
+		VmReqInfo ktcloudsdk.DeployVMReqInfo
+	}{
+		VmReqInfo: vmReqInfo,
+	}
+	mock.lockDeployVirtualMachine.Lock()
+	mock.calls.DeployVirtualMachine = append(mock.calls.DeployVirtualMachine, callInfo)
+	mock.lockDeployVirtualMachine.Unlock()
+	return mock.DeployVirtualMachineFunc(vmReqInfo)
+}
+
+// DeployVirtualMachineCalls gets all the calls that were made to DeployVirtualMachine.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.DeployVirtualMachineCalls())
+func (mock *KtCloudAPIMock) DeployVirtualMachineCalls() []struct {
+	VmReqInfo ktcloudsdk.DeployVMReqInfo
+} {
+	var calls []struct {
+		VmReqInfo ktcloudsdk.DeployVMReqInfo
+	}
+	mock.lockDeployVirtualMachine.RLock()
+	calls = mock.calls.DeployVirtualMachine
+	mock.lockDeployVirtualMachine.RUnlock()
+	return calls
+}
+
+// DeployVirtualMachineWithContext calls DeployVirtualMachineWithContextFunc.
+func (mock *KtCloudAPIMock) DeployVirtualMachineWithContext(ctx context.Context, vmReqInfo ktcloudsdk.DeployVMReqInfo) (ktcloudsdk.DeployVirtualMachineResponse, error) {
+	if mock.DeployVirtualMachineWithContextFunc == nil {
+		panic("KtCloudAPIMock.DeployVirtualMachineWithContextFunc: method is nil but KtCloudAPI.DeployVirtualMachineWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		VmReqInfo ktcloudsdk.DeployVMReqInfo
+	}{
+		Ctx:       ctx,
+		VmReqInfo: vmReqInfo,
+	}
+	mock.lockDeployVirtualMachineWithContext.Lock()
+	mock.calls.DeployVirtualMachineWithContext = append(mock.calls.DeployVirtualMachineWithContext, callInfo)
+	mock.lockDeployVirtualMachineWithContext.Unlock()
+	return mock.DeployVirtualMachineWithContextFunc(ctx, vmReqInfo)
+}
+
+// DeployVirtualMachineWithContextCalls gets all the calls that were made to DeployVirtualMachineWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.DeployVirtualMachineWithContextCalls())
+func (mock *KtCloudAPIMock) DeployVirtualMachineWithContextCalls() []struct {
+	Ctx       context.Context
+	VmReqInfo ktcloudsdk.DeployVMReqInfo
+} {
+	var calls []struct {
+		Ctx       context.Context
+		VmReqInfo ktcloudsdk.DeployVMReqInfo
+	}
+	mock.lockDeployVirtualMachineWithContext.RLock()
+	calls = mock.calls.DeployVirtualMachineWithContext
+	mock.lockDeployVirtualMachineWithContext.RUnlock()
+	return calls
+}
+
+// DestroyVirtualMachine calls DestroyVirtualMachineFunc.
+func (mock *KtCloudAPIMock) DestroyVirtualMachine(vmId string) (ktcloudsdk.DestroyVirtualMachineResponse, error) {
+	if mock.DestroyVirtualMachineFunc == nil {
+		panic("KtCloudAPIMock.DestroyVirtualMachineFunc: method is nil but KtCloudAPI.DestroyVirtualMachine was just called")
+	}
+	callInfo := struct {
+		VmId string
+	}{
+		VmId: vmId,
+	}
+	mock.lockDestroyVirtualMachine.Lock()
+	mock.calls.DestroyVirtualMachine = append(mock.calls.DestroyVirtualMachine, callInfo)
+	mock.lockDestroyVirtualMachine.Unlock()
+	return mock.DestroyVirtualMachineFunc(vmId)
+}
+
+// DestroyVirtualMachineCalls gets all the calls that were made to DestroyVirtualMachine.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.DestroyVirtualMachineCalls())
+func (mock *KtCloudAPIMock) DestroyVirtualMachineCalls() []struct {
+	VmId string
+} {
+	var calls []struct {
+		VmId string
+	}
+	mock.lockDestroyVirtualMachine.RLock()
+	calls = mock.calls.DestroyVirtualMachine
+	mock.lockDestroyVirtualMachine.RUnlock()
+	return calls
+}
+
+// DestroyVirtualMachineWithContext calls DestroyVirtualMachineWithContextFunc.
+func (mock *KtCloudAPIMock) DestroyVirtualMachineWithContext(ctx context.Context, vmId string) (ktcloudsdk.DestroyVirtualMachineResponse, error) {
+	if mock.DestroyVirtualMachineWithContextFunc == nil {
+		panic("KtCloudAPIMock.DestroyVirtualMachineWithContextFunc: method is nil but KtCloudAPI.DestroyVirtualMachineWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		VmId string
+	}{
+		Ctx:  ctx,
+		VmId: vmId,
+	}
+	mock.lockDestroyVirtualMachineWithContext.Lock()
+	mock.calls.DestroyVirtualMachineWithContext = append(mock.calls.DestroyVirtualMachineWithContext, callInfo)
+	mock.lockDestroyVirtualMachineWithContext.Unlock()
+	return mock.DestroyVirtualMachineWithContextFunc(ctx, vmId)
+}
+
+// DestroyVirtualMachineWithContextCalls gets all the calls that were made to DestroyVirtualMachineWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.DestroyVirtualMachineWithContextCalls())
+func (mock *KtCloudAPIMock) DestroyVirtualMachineWithContextCalls() []struct {
+	Ctx  context.Context
+	VmId string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		VmId string
+	}
+	mock.lockDestroyVirtualMachineWithContext.RLock()
+	calls = mock.calls.DestroyVirtualMachineWithContext
+	mock.lockDestroyVirtualMachineWithContext.RUnlock()
+	return calls
+}
+
+// DetachVolume calls DetachVolumeFunc.
+func (mock *KtCloudAPIMock) DetachVolume(req ktcloudsdk.DetachVolumeReqInfo) (ktcloudsdk.DetachVolumeResponse, error) {
+	if mock.DetachVolumeFunc == nil {
+		panic("KtCloudAPIMock.DetachVolumeFunc: method is nil but KtCloudAPI.DetachVolume was just called")
+	}
+	callInfo := struct {
+		Req ktcloudsdk.DetachVolumeReqInfo
+	}{
+		Req: req,
+	}
+	mock.lockDetachVolume.Lock()
+	mock.calls.DetachVolume = append(mock.calls.DetachVolume, callInfo)
+	mock.lockDetachVolume.Unlock()
+	return mock.DetachVolumeFunc(req)
+}
+
+// DetachVolumeCalls gets all the calls that were made to DetachVolume.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.DetachVolumeCalls())
+func (mock *KtCloudAPIMock) DetachVolumeCalls() []struct {
+	Req ktcloudsdk.DetachVolumeReqInfo
+} {
+	var calls []struct {
+		Req ktcloudsdk.DetachVolumeReqInfo
+	}
+	mock.lockDetachVolume.RLock()
+	calls = mock.calls.DetachVolume
+	mock.lockDetachVolume.RUnlock()
+	return calls
+}
+
+// DetachVolumeWithContext calls DetachVolumeWithContextFunc.
+func (mock *KtCloudAPIMock) DetachVolumeWithContext(ctx context.Context, req ktcloudsdk.DetachVolumeReqInfo) (ktcloudsdk.DetachVolumeResponse, error) {
+	if mock.DetachVolumeWithContextFunc == nil {
+		panic("KtCloudAPIMock.DetachVolumeWithContextFunc: method is nil but KtCloudAPI.DetachVolumeWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req ktcloudsdk.DetachVolumeReqInfo
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockDetachVolumeWithContext.Lock()
+	mock.calls.DetachVolumeWithContext = append(mock.calls.DetachVolumeWithContext, callInfo)
+	mock.lockDetachVolumeWithContext.Unlock()
+	return mock.DetachVolumeWithContextFunc(ctx, req)
+}
+
+// DetachVolumeWithContextCalls gets all the calls that were made to DetachVolumeWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.DetachVolumeWithContextCalls())
+func (mock *KtCloudAPIMock) DetachVolumeWithContextCalls() []struct {
+	Ctx context.Context
+	Req ktcloudsdk.DetachVolumeReqInfo
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req ktcloudsdk.DetachVolumeReqInfo
+	}
+	mock.lockDetachVolumeWithContext.RLock()
+	calls = mock.calls.DetachVolumeWithContext
+	mock.lockDetachVolumeWithContext.RUnlock()
+	return calls
+}
+
+// DisassociateIpAddress calls DisassociateIpAddressFunc.
+func (mock *KtCloudAPIMock) DisassociateIpAddress(publicIpId string) (ktcloudsdk.DisassociateIpAddressResponse, error) {
+	if mock.DisassociateIpAddressFunc == nil {
+		panic("KtCloudAPIMock.DisassociateIpAddressFunc: method is nil but KtCloudAPI.DisassociateIpAddress was just called")
+	}
+	callInfo := struct {
+		PublicIpId string
+	}{
+		PublicIpId: publicIpId,
+	}
+	mock.lockDisassociateIpAddress.Lock()
+	mock.calls.DisassociateIpAddress = append(mock.calls.DisassociateIpAddress, callInfo)
+	mock.lockDisassociateIpAddress.Unlock()
+	return mock.DisassociateIpAddressFunc(publicIpId)
+}
+
+// DisassociateIpAddressCalls gets all the calls that were made to DisassociateIpAddress.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.DisassociateIpAddressCalls())
+func (mock *KtCloudAPIMock) DisassociateIpAddressCalls() []struct {
+	PublicIpId string
+} {
+	var calls []struct {
+		PublicIpId string
+	}
+	mock.lockDisassociateIpAddress.RLock()
+	calls = mock.calls.DisassociateIpAddress
+	mock.lockDisassociateIpAddress.RUnlock()
+	return calls
+}
+
+// DisassociateIpAddressWithContext calls DisassociateIpAddressWithContextFunc.
+func (mock *KtCloudAPIMock) DisassociateIpAddressWithContext(ctx context.Context, publicIpId string) (ktcloudsdk.DisassociateIpAddressResponse, error) {
+	if mock.DisassociateIpAddressWithContextFunc == nil {
+		panic("KtCloudAPIMock.DisassociateIpAddressWithContextFunc: method is nil but KtCloudAPI.DisassociateIpAddressWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		PublicIpId string
+	}{
+		Ctx:        ctx,
+		PublicIpId: publicIpId,
+	}
+	mock.lockDisassociateIpAddressWithContext.Lock()
+	mock.calls.DisassociateIpAddressWithContext = append(mock.calls.DisassociateIpAddressWithContext, callInfo)
+	mock.lockDisassociateIpAddressWithContext.Unlock()
+	return mock.DisassociateIpAddressWithContextFunc(ctx, publicIpId)
+}
+
+// DisassociateIpAddressWithContextCalls gets all the calls that were made to DisassociateIpAddressWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.DisassociateIpAddressWithContextCalls())
+func (mock *KtCloudAPIMock) DisassociateIpAddressWithContextCalls() []struct {
+	Ctx        context.Context
+	PublicIpId string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		PublicIpId string
+	}
+	mock.lockDisassociateIpAddressWithContext.RLock()
+	calls = mock.calls.DisassociateIpAddressWithContext
+	mock.lockDisassociateIpAddressWithContext.RUnlock()
+	return calls
+}
+
+// Do calls DoFunc.
+func (mock *KtCloudAPIMock) Do(ctx context.Context, command string, params url.Values, out interface{}) error {
+	if mock.DoFunc == nil {
+		panic("KtCloudAPIMock.DoFunc: method is nil but KtCloudAPI.Do was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Command string
+		Params  url.Values
+		Out     interface{}
+	}{
+		Ctx:     ctx,
+		Command: command,
+		Params:  params,
+		Out:     out,
+	}
+	mock.lockDo.Lock()
+	mock.calls.Do = append(mock.calls.Do, callInfo)
+	mock.lockDo.Unlock()
+	return mock.DoFunc(ctx, command, params, out)
+}
+
+// DoCalls gets all the calls that were made to Do.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.DoCalls())
+func (mock *KtCloudAPIMock) DoCalls() []struct {
+	Ctx     context.Context
+	Command string
+	Params  url.Values
+	Out     interface{}
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Command string
+		Params  url.Values
+		Out     interface{}
+	}
+	mock.lockDo.RLock()
+	calls = mock.calls.Do
+	mock.lockDo.RUnlock()
+	return calls
+}
+
+// InvalidateCache calls InvalidateCacheFunc.
+func (mock *KtCloudAPIMock) InvalidateCache(commands ...string) {
+	if mock.InvalidateCacheFunc == nil {
+		panic("KtCloudAPIMock.InvalidateCacheFunc: method is nil but KtCloudAPI.InvalidateCache was just called")
+	}
+	callInfo := struct {
+		Commands []string
+	}{
+		Commands: commands,
+	}
+	mock.lockInvalidateCache.Lock()
+	mock.calls.InvalidateCache = append(mock.calls.InvalidateCache, callInfo)
+	mock.lockInvalidateCache.Unlock()
+	mock.InvalidateCacheFunc(commands...)
+}
+
+// InvalidateCacheCalls gets all the calls that were made to InvalidateCache.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.InvalidateCacheCalls())
+func (mock *KtCloudAPIMock) InvalidateCacheCalls() []struct {
+	Commands []string
+} {
+	var calls []struct {
+		Commands []string
+	}
+	mock.lockInvalidateCache.RLock()
+	calls = mock.calls.InvalidateCache
+	mock.lockInvalidateCache.RUnlock()
+	return calls
+}
+
+// ListAllFirewallRules calls ListAllFirewallRulesFunc.
+func (mock *KtCloudAPIMock) ListAllFirewallRules(req ktcloudsdk.ListFirewallRulesReqInfo) ([]ktcloudsdk.FirewallRule, error) {
+	if mock.ListAllFirewallRulesFunc == nil {
+		panic("KtCloudAPIMock.ListAllFirewallRulesFunc: method is nil but KtCloudAPI.ListAllFirewallRules was just called")
+	}
+	callInfo := struct {
+		Req ktcloudsdk.ListFirewallRulesReqInfo
+	}{
+		Req: req,
+	}
+	mock.lockListAllFirewallRules.Lock()
+	mock.calls.ListAllFirewallRules = append(mock.calls.ListAllFirewallRules, callInfo)
+	mock.lockListAllFirewallRules.Unlock()
+	return mock.ListAllFirewallRulesFunc(req)
+}
+
+// ListAllFirewallRulesCalls gets all the calls that were made to ListAllFirewallRules.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListAllFirewallRulesCalls())
+func (mock *KtCloudAPIMock) ListAllFirewallRulesCalls() []struct {
+	Req ktcloudsdk.ListFirewallRulesReqInfo
+} {
+	var calls []struct {
+		Req ktcloudsdk.ListFirewallRulesReqInfo
+	}
+	mock.lockListAllFirewallRules.RLock()
+	calls = mock.calls.ListAllFirewallRules
+	mock.lockListAllFirewallRules.RUnlock()
+	return calls
+}
+
+// ListAllFirewallRulesWithContext calls ListAllFirewallRulesWithContextFunc.
+func (mock *KtCloudAPIMock) ListAllFirewallRulesWithContext(ctx context.Context, req ktcloudsdk.ListFirewallRulesReqInfo) ([]ktcloudsdk.FirewallRule, error) {
+	if mock.ListAllFirewallRulesWithContextFunc == nil {
+		panic("KtCloudAPIMock.ListAllFirewallRulesWithContextFunc: method is nil but KtCloudAPI.ListAllFirewallRulesWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req ktcloudsdk.ListFirewallRulesReqInfo
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockListAllFirewallRulesWithContext.Lock()
+	mock.calls.ListAllFirewallRulesWithContext = append(mock.calls.ListAllFirewallRulesWithContext, callInfo)
+	mock.lockListAllFirewallRulesWithContext.Unlock()
+	return mock.ListAllFirewallRulesWithContextFunc(ctx, req)
+}
+
+// ListAllFirewallRulesWithContextCalls gets all the calls that were made to ListAllFirewallRulesWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListAllFirewallRulesWithContextCalls())
+func (mock *KtCloudAPIMock) ListAllFirewallRulesWithContextCalls() []struct {
+	Ctx context.Context
+	Req ktcloudsdk.ListFirewallRulesReqInfo
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req ktcloudsdk.ListFirewallRulesReqInfo
+	}
+	mock.lockListAllFirewallRulesWithContext.RLock()
+	calls = mock.calls.ListAllFirewallRulesWithContext
+	mock.lockListAllFirewallRulesWithContext.RUnlock()
+	return calls
+}
+
+// ListAllPortForwardingRules calls ListAllPortForwardingRulesFunc.
+func (mock *KtCloudAPIMock) ListAllPortForwardingRules(req ktcloudsdk.ListPortForwardingRulesReqInfo) ([]ktcloudsdk.PortForwardingRule, error) {
+	if mock.ListAllPortForwardingRulesFunc == nil {
+		panic("KtCloudAPIMock.ListAllPortForwardingRulesFunc: method is nil but KtCloudAPI.ListAllPortForwardingRules was just called")
+	}
+	callInfo := struct {
+		Req ktcloudsdk.ListPortForwardingRulesReqInfo
+	}{
+		Req: req,
+	}
+	mock.lockListAllPortForwardingRules.Lock()
+	mock.calls.ListAllPortForwardingRules = append(mock.calls.ListAllPortForwardingRules, callInfo)
+	mock.lockListAllPortForwardingRules.Unlock()
+	return mock.ListAllPortForwardingRulesFunc(req)
+}
+
+// ListAllPortForwardingRulesCalls gets all the calls that were made to ListAllPortForwardingRules.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListAllPortForwardingRulesCalls())
+func (mock *KtCloudAPIMock) ListAllPortForwardingRulesCalls() []struct {
+	Req ktcloudsdk.ListPortForwardingRulesReqInfo
+} {
+	var calls []struct {
+		Req ktcloudsdk.ListPortForwardingRulesReqInfo
+	}
+	mock.lockListAllPortForwardingRules.RLock()
+	calls = mock.calls.ListAllPortForwardingRules
+	mock.lockListAllPortForwardingRules.RUnlock()
+	return calls
+}
+
+// ListAllPortForwardingRulesWithContext calls ListAllPortForwardingRulesWithContextFunc.
+func (mock *KtCloudAPIMock) ListAllPortForwardingRulesWithContext(ctx context.Context, req ktcloudsdk.ListPortForwardingRulesReqInfo) ([]ktcloudsdk.PortForwardingRule, error) {
+	if mock.ListAllPortForwardingRulesWithContextFunc == nil {
+		panic("KtCloudAPIMock.ListAllPortForwardingRulesWithContextFunc: method is nil but KtCloudAPI.ListAllPortForwardingRulesWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req ktcloudsdk.ListPortForwardingRulesReqInfo
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockListAllPortForwardingRulesWithContext.Lock()
+	mock.calls.ListAllPortForwardingRulesWithContext = append(mock.calls.ListAllPortForwardingRulesWithContext, callInfo)
+	mock.lockListAllPortForwardingRulesWithContext.Unlock()
+	return mock.ListAllPortForwardingRulesWithContextFunc(ctx, req)
+}
+
+// ListAllPortForwardingRulesWithContextCalls gets all the calls that were made to ListAllPortForwardingRulesWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListAllPortForwardingRulesWithContextCalls())
+func (mock *KtCloudAPIMock) ListAllPortForwardingRulesWithContextCalls() []struct {
+	Ctx context.Context
+	Req ktcloudsdk.ListPortForwardingRulesReqInfo
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req ktcloudsdk.ListPortForwardingRulesReqInfo
+	}
+	mock.lockListAllPortForwardingRulesWithContext.RLock()
+	calls = mock.calls.ListAllPortForwardingRulesWithContext
+	mock.lockListAllPortForwardingRulesWithContext.RUnlock()
+	return calls
+}
+
+// ListAllPublicIpAddresses calls ListAllPublicIpAddressesFunc.
+func (mock *KtCloudAPIMock) ListAllPublicIpAddresses(req ktcloudsdk.ListPublicIpReqInfo) ([]ktcloudsdk.PublicIpAddress, error) {
+	if mock.ListAllPublicIpAddressesFunc == nil {
+		panic("KtCloudAPIMock.ListAllPublicIpAddressesFunc: method is nil but KtCloudAPI.ListAllPublicIpAddresses was just called")
+	}
+	callInfo := struct {
+		Req ktcloudsdk.ListPublicIpReqInfo
+	}{
+		Req: req,
+	}
+	mock.lockListAllPublicIpAddresses.Lock()
+	mock.calls.ListAllPublicIpAddresses = append(mock.calls.ListAllPublicIpAddresses, callInfo)
+	mock.lockListAllPublicIpAddresses.Unlock()
+	return mock.ListAllPublicIpAddressesFunc(req)
+}
+
+// ListAllPublicIpAddressesCalls gets all the calls that were made to ListAllPublicIpAddresses.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListAllPublicIpAddressesCalls())
+func (mock *KtCloudAPIMock) ListAllPublicIpAddressesCalls() []struct {
+	Req ktcloudsdk.ListPublicIpReqInfo
+} {
+	var calls []struct {
+		Req ktcloudsdk.ListPublicIpReqInfo
+	}
+	mock.lockListAllPublicIpAddresses.RLock()
+	calls = mock.calls.ListAllPublicIpAddresses
+	mock.lockListAllPublicIpAddresses.RUnlock()
+	return calls
+}
+
+// ListAllPublicIpAddressesWithContext calls ListAllPublicIpAddressesWithContextFunc.
+func (mock *KtCloudAPIMock) ListAllPublicIpAddressesWithContext(ctx context.Context, req ktcloudsdk.ListPublicIpReqInfo) ([]ktcloudsdk.PublicIpAddress, error) {
+	if mock.ListAllPublicIpAddressesWithContextFunc == nil {
+		panic("KtCloudAPIMock.ListAllPublicIpAddressesWithContextFunc: method is nil but KtCloudAPI.ListAllPublicIpAddressesWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req ktcloudsdk.ListPublicIpReqInfo
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockListAllPublicIpAddressesWithContext.Lock()
+	mock.calls.ListAllPublicIpAddressesWithContext = append(mock.calls.ListAllPublicIpAddressesWithContext, callInfo)
+	mock.lockListAllPublicIpAddressesWithContext.Unlock()
+	return mock.ListAllPublicIpAddressesWithContextFunc(ctx, req)
+}
+
+// ListAllPublicIpAddressesWithContextCalls gets all the calls that were made to ListAllPublicIpAddressesWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListAllPublicIpAddressesWithContextCalls())
+func (mock *KtCloudAPIMock) ListAllPublicIpAddressesWithContextCalls() []struct {
+	Ctx context.Context
+	Req ktcloudsdk.ListPublicIpReqInfo
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req ktcloudsdk.ListPublicIpReqInfo
+	}
+	mock.lockListAllPublicIpAddressesWithContext.RLock()
+	calls = mock.calls.ListAllPublicIpAddressesWithContext
+	mock.lockListAllPublicIpAddressesWithContext.RUnlock()
+	return calls
+}
+
+// ListAllTemplates calls ListAllTemplatesFunc.
+func (mock *KtCloudAPIMock) ListAllTemplates(req *ktcloudsdk.ListTemplateReqInfo) ([]ktcloudsdk.Template, error) {
+	if mock.ListAllTemplatesFunc == nil {
+		panic("KtCloudAPIMock.ListAllTemplatesFunc: method is nil but KtCloudAPI.ListAllTemplates was just called")
+	}
+	callInfo := struct {
+		Req *ktcloudsdk.ListTemplateReqInfo
+	}{
+		Req: req,
+	}
+	mock.lockListAllTemplates.Lock()
+	mock.calls.ListAllTemplates = append(mock.calls.ListAllTemplates, callInfo)
+	mock.lockListAllTemplates.Unlock()
+	return mock.ListAllTemplatesFunc(req)
+}
+
+// ListAllTemplatesCalls gets all the calls that were made to ListAllTemplates.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListAllTemplatesCalls())
+func (mock *KtCloudAPIMock) ListAllTemplatesCalls() []struct {
+	Req *ktcloudsdk.ListTemplateReqInfo
+} {
+	var calls []struct {
+		Req *ktcloudsdk.ListTemplateReqInfo
+	}
+	mock.lockListAllTemplates.RLock()
+	calls = mock.calls.ListAllTemplates
+	mock.lockListAllTemplates.RUnlock()
+	return calls
+}
+
+// ListAllTemplatesWithContext calls ListAllTemplatesWithContextFunc.
+func (mock *KtCloudAPIMock) ListAllTemplatesWithContext(ctx context.Context, req *ktcloudsdk.ListTemplateReqInfo) ([]ktcloudsdk.Template, error) {
+	if mock.ListAllTemplatesWithContextFunc == nil {
+		panic("KtCloudAPIMock.ListAllTemplatesWithContextFunc: method is nil but KtCloudAPI.ListAllTemplatesWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req *ktcloudsdk.ListTemplateReqInfo
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockListAllTemplatesWithContext.Lock()
+	mock.calls.ListAllTemplatesWithContext = append(mock.calls.ListAllTemplatesWithContext, callInfo)
+	mock.lockListAllTemplatesWithContext.Unlock()
+	return mock.ListAllTemplatesWithContextFunc(ctx, req)
+}
+
+// ListAllTemplatesWithContextCalls gets all the calls that were made to ListAllTemplatesWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListAllTemplatesWithContextCalls())
+func (mock *KtCloudAPIMock) ListAllTemplatesWithContextCalls() []struct {
+	Ctx context.Context
+	Req *ktcloudsdk.ListTemplateReqInfo
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req *ktcloudsdk.ListTemplateReqInfo
+	}
+	mock.lockListAllTemplatesWithContext.RLock()
+	calls = mock.calls.ListAllTemplatesWithContext
+	mock.lockListAllTemplatesWithContext.RUnlock()
+	return calls
+}
+
+// ListAllVirtualMachines calls ListAllVirtualMachinesFunc.
+func (mock *KtCloudAPIMock) ListAllVirtualMachines(vmListReqInfo ktcloudsdk.ListVMReqInfo) ([]ktcloudsdk.Virtualmachine, error) {
+	if mock.ListAllVirtualMachinesFunc == nil {
+		panic("KtCloudAPIMock.ListAllVirtualMachinesFunc: method is nil but KtCloudAPI.ListAllVirtualMachines was just called")
+	}
+	callInfo := struct {
+		VmListReqInfo ktcloudsdk.ListVMReqInfo
+	}{
+		VmListReqInfo: vmListReqInfo,
+	}
+	mock.lockListAllVirtualMachines.Lock()
+	mock.calls.ListAllVirtualMachines = append(mock.calls.ListAllVirtualMachines, callInfo)
+	mock.lockListAllVirtualMachines.Unlock()
+	return mock.ListAllVirtualMachinesFunc(vmListReqInfo)
+}
+
+// ListAllVirtualMachinesCalls gets all the calls that were made to ListAllVirtualMachines.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListAllVirtualMachinesCalls())
+func (mock *KtCloudAPIMock) ListAllVirtualMachinesCalls() []struct {
+	VmListReqInfo ktcloudsdk.ListVMReqInfo
+} {
+	var calls []struct {
+		VmListReqInfo ktcloudsdk.ListVMReqInfo
+	}
+	mock.lockListAllVirtualMachines.RLock()
+	calls = mock.calls.ListAllVirtualMachines
+	mock.lockListAllVirtualMachines.RUnlock()
+	return calls
+}
+
+// ListAllVirtualMachinesWithContext calls ListAllVirtualMachinesWithContextFunc.
+func (mock *KtCloudAPIMock) ListAllVirtualMachinesWithContext(ctx context.Context, vmListReqInfo ktcloudsdk.ListVMReqInfo) ([]ktcloudsdk.Virtualmachine, error) {
+	if mock.ListAllVirtualMachinesWithContextFunc == nil {
+		panic("KtCloudAPIMock.ListAllVirtualMachinesWithContextFunc: method is nil but KtCloudAPI.ListAllVirtualMachinesWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		VmListReqInfo ktcloudsdk.ListVMReqInfo
+	}{
+		Ctx:           ctx,
+		VmListReqInfo: vmListReqInfo,
+	}
+	mock.lockListAllVirtualMachinesWithContext.Lock()
+	mock.calls.ListAllVirtualMachinesWithContext = append(mock.calls.ListAllVirtualMachinesWithContext, callInfo)
+	mock.lockListAllVirtualMachinesWithContext.Unlock()
+	return mock.ListAllVirtualMachinesWithContextFunc(ctx, vmListReqInfo)
+}
+
+// ListAllVirtualMachinesWithContextCalls gets all the calls that were made to ListAllVirtualMachinesWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListAllVirtualMachinesWithContextCalls())
+func (mock *KtCloudAPIMock) ListAllVirtualMachinesWithContextCalls() []struct {
+	Ctx           context.Context
+	VmListReqInfo ktcloudsdk.ListVMReqInfo
+} {
+	var calls []struct {
+		Ctx           context.Context
+		VmListReqInfo ktcloudsdk.ListVMReqInfo
+	}
+	mock.lockListAllVirtualMachinesWithContext.RLock()
+	calls = mock.calls.ListAllVirtualMachinesWithContext
+	mock.lockListAllVirtualMachinesWithContext.RUnlock()
+	return calls
+}
+
+// ListAllVolumes calls ListAllVolumesFunc.
+func (mock *KtCloudAPIMock) ListAllVolumes(req ktcloudsdk.ListVolumeReqInfo) ([]ktcloudsdk.Volume, error) {
+	if mock.ListAllVolumesFunc == nil {
+		panic("KtCloudAPIMock.ListAllVolumesFunc: method is nil but KtCloudAPI.ListAllVolumes was just called")
+	}
+	callInfo := struct {
+		Req ktcloudsdk.ListVolumeReqInfo
+	}{
+		Req: req,
+	}
+	mock.lockListAllVolumes.Lock()
+	mock.calls.ListAllVolumes = append(mock.calls.ListAllVolumes, callInfo)
+	mock.lockListAllVolumes.Unlock()
+	return mock.ListAllVolumesFunc(req)
+}
+
+// ListAllVolumesCalls gets all the calls that were made to ListAllVolumes.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListAllVolumesCalls())
+func (mock *KtCloudAPIMock) ListAllVolumesCalls() []struct {
+	Req ktcloudsdk.ListVolumeReqInfo
+} {
+	var calls []struct {
+		Req ktcloudsdk.ListVolumeReqInfo
+	}
+	mock.lockListAllVolumes.RLock()
+	calls = mock.calls.ListAllVolumes
+	mock.lockListAllVolumes.RUnlock()
+	return calls
+}
+
+// ListAllVolumesWithContext calls ListAllVolumesWithContextFunc.
+func (mock *KtCloudAPIMock) ListAllVolumesWithContext(ctx context.Context, req ktcloudsdk.ListVolumeReqInfo) ([]ktcloudsdk.Volume, error) {
+	if mock.ListAllVolumesWithContextFunc == nil {
+		panic("KtCloudAPIMock.ListAllVolumesWithContextFunc: method is nil but KtCloudAPI.ListAllVolumesWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req ktcloudsdk.ListVolumeReqInfo
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockListAllVolumesWithContext.Lock()
+	mock.calls.ListAllVolumesWithContext = append(mock.calls.ListAllVolumesWithContext, callInfo)
+	mock.lockListAllVolumesWithContext.Unlock()
+	return mock.ListAllVolumesWithContextFunc(ctx, req)
+}
+
+// ListAllVolumesWithContextCalls gets all the calls that were made to ListAllVolumesWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListAllVolumesWithContextCalls())
+func (mock *KtCloudAPIMock) ListAllVolumesWithContextCalls() []struct {
+	Ctx context.Context
+	Req ktcloudsdk.ListVolumeReqInfo
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req ktcloudsdk.ListVolumeReqInfo
+	}
+	mock.lockListAllVolumesWithContext.RLock()
+	calls = mock.calls.ListAllVolumesWithContext
+	mock.lockListAllVolumesWithContext.RUnlock()
+	return calls
+}
+
+// ListAvailableProductTypes calls ListAvailableProductTypesFunc.
+func (mock *KtCloudAPIMock) ListAvailableProductTypes(zoneId string) (ktcloudsdk.ListAvailableProductTypesResponse, error) {
+	if mock.ListAvailableProductTypesFunc == nil {
+		panic("KtCloudAPIMock.ListAvailableProductTypesFunc: method is nil but KtCloudAPI.ListAvailableProductTypes was just called")
+	}
+	callInfo := struct {
+		ZoneId string
+	}{
+		ZoneId: zoneId,
+	}
+	mock.lockListAvailableProductTypes.Lock()
+	mock.calls.ListAvailableProductTypes = append(mock.calls.ListAvailableProductTypes, callInfo)
+	mock.lockListAvailableProductTypes.Unlock()
+	return mock.ListAvailableProductTypesFunc(zoneId)
+}
+
+// ListAvailableProductTypesCalls gets all the calls that were made to ListAvailableProductTypes.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListAvailableProductTypesCalls())
+func (mock *KtCloudAPIMock) ListAvailableProductTypesCalls() []struct {
+	ZoneId string
+} {
+	var calls []struct {
+		ZoneId string
+	}
+	mock.lockListAvailableProductTypes.RLock()
+	calls = mock.calls.ListAvailableProductTypes
+	mock.lockListAvailableProductTypes.RUnlock()
+	return calls
+}
+
+// ListAvailableProductTypesWithContext calls ListAvailableProductTypesWithContextFunc.
+func (mock *KtCloudAPIMock) ListAvailableProductTypesWithContext(ctx context.Context, zoneId string) (ktcloudsdk.ListAvailableProductTypesResponse, error) {
+	if mock.ListAvailableProductTypesWithContextFunc == nil {
+		panic("KtCloudAPIMock.ListAvailableProductTypesWithContextFunc: method is nil but KtCloudAPI.ListAvailableProductTypesWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ZoneId string
+	}{
+		Ctx:    ctx,
+		ZoneId: zoneId,
+	}
+	mock.lockListAvailableProductTypesWithContext.Lock()
+	mock.calls.ListAvailableProductTypesWithContext = append(mock.calls.ListAvailableProductTypesWithContext, callInfo)
+	mock.lockListAvailableProductTypesWithContext.Unlock()
+	return mock.ListAvailableProductTypesWithContextFunc(ctx, zoneId)
+}
+
+// ListAvailableProductTypesWithContextCalls gets all the calls that were made to ListAvailableProductTypesWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListAvailableProductTypesWithContextCalls())
+func (mock *KtCloudAPIMock) ListAvailableProductTypesWithContextCalls() []struct {
+	Ctx    context.Context
+	ZoneId string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ZoneId string
+	}
+	mock.lockListAvailableProductTypesWithContext.RLock()
+	calls = mock.calls.ListAvailableProductTypesWithContext
+	mock.lockListAvailableProductTypesWithContext.RUnlock()
+	return calls
+}
+
+// ListFirewallRules calls ListFirewallRulesFunc.
+func (mock *KtCloudAPIMock) ListFirewallRules(filewallRuleListReqInfo ktcloudsdk.ListFirewallRulesReqInfo) (ktcloudsdk.ListFirewallRulesResponse, error) {
+	if mock.ListFirewallRulesFunc == nil {
+		panic("KtCloudAPIMock.ListFirewallRulesFunc: method is nil but KtCloudAPI.ListFirewallRules was just called")
+	}
+	callInfo := struct {
+		FilewallRuleListReqInfo ktcloudsdk.ListFirewallRulesReqInfo
+	}{
+		FilewallRuleListReqInfo: filewallRuleListReqInfo,
+	}
+	mock.lockListFirewallRules.Lock()
+	mock.calls.ListFirewallRules = append(mock.calls.ListFirewallRules, callInfo)
+	mock.lockListFirewallRules.Unlock()
+	return mock.ListFirewallRulesFunc(filewallRuleListReqInfo)
+}
+
+// ListFirewallRulesCalls gets all the calls that were made to ListFirewallRules.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListFirewallRulesCalls())
+func (mock *KtCloudAPIMock) ListFirewallRulesCalls() []struct {
+	FilewallRuleListReqInfo ktcloudsdk.ListFirewallRulesReqInfo
+} {
+	var calls []struct {
+		FilewallRuleListReqInfo ktcloudsdk.ListFirewallRulesReqInfo
+	}
+	mock.lockListFirewallRules.RLock()
+	calls = mock.calls.ListFirewallRules
+	mock.lockListFirewallRules.RUnlock()
+	return calls
+}
+
+// ListFirewallRulesWithContext calls ListFirewallRulesWithContextFunc.
+func (mock *KtCloudAPIMock) ListFirewallRulesWithContext(ctx context.Context, filewallRuleListReqInfo ktcloudsdk.ListFirewallRulesReqInfo) (ktcloudsdk.ListFirewallRulesResponse, error) {
+	if mock.ListFirewallRulesWithContextFunc == nil {
+		panic("KtCloudAPIMock.ListFirewallRulesWithContextFunc: method is nil but KtCloudAPI.ListFirewallRulesWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx                     context.Context
+		FilewallRuleListReqInfo ktcloudsdk.ListFirewallRulesReqInfo
+	}{
+		Ctx:                     ctx,
+		FilewallRuleListReqInfo: filewallRuleListReqInfo,
+	}
+	mock.lockListFirewallRulesWithContext.Lock()
+	mock.calls.ListFirewallRulesWithContext = append(mock.calls.ListFirewallRulesWithContext, callInfo)
+	mock.lockListFirewallRulesWithContext.Unlock()
+	return mock.ListFirewallRulesWithContextFunc(ctx, filewallRuleListReqInfo)
+}
+
+// ListFirewallRulesWithContextCalls gets all the calls that were made to ListFirewallRulesWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListFirewallRulesWithContextCalls())
+func (mock *KtCloudAPIMock) ListFirewallRulesWithContextCalls() []struct {
+	Ctx                     context.Context
+	FilewallRuleListReqInfo ktcloudsdk.ListFirewallRulesReqInfo
+} {
+	var calls []struct {
+		Ctx                     context.Context
+		FilewallRuleListReqInfo ktcloudsdk.ListFirewallRulesReqInfo
+	}
+	mock.lockListFirewallRulesWithContext.RLock()
+	calls = mock.calls.ListFirewallRulesWithContext
+	mock.lockListFirewallRulesWithContext.RUnlock()
+	return calls
+}
+
+// ListNLBVMs calls ListNLBVMsFunc.
+func (mock *KtCloudAPIMock) ListNLBVMs(nlbId string) (ktcloudsdk.ListNLBVMsResponse, error) {
+	if mock.ListNLBVMsFunc == nil {
+		panic("KtCloudAPIMock.ListNLBVMsFunc: method is nil but KtCloudAPI.ListNLBVMs was just called")
+	}
+	callInfo := struct {
+		NlbId string
+	}{
+		NlbId: nlbId,
+	}
+	mock.lockListNLBVMs.Lock()
+	mock.calls.ListNLBVMs = append(mock.calls.ListNLBVMs, callInfo)
+	mock.lockListNLBVMs.Unlock()
+	return mock.ListNLBVMsFunc(nlbId)
+}
+
+// ListNLBVMsCalls gets all the calls that were made to ListNLBVMs.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListNLBVMsCalls())
+func (mock *KtCloudAPIMock) ListNLBVMsCalls() []struct {
+	NlbId string
+} {
+	var calls []struct {
+		NlbId string
+	}
+	mock.lockListNLBVMs.RLock()
+	calls = mock.calls.ListNLBVMs
+	mock.lockListNLBVMs.RUnlock()
+	return calls
+}
+
+// ListNLBVMsWithContext calls ListNLBVMsWithContextFunc.
+func (mock *KtCloudAPIMock) ListNLBVMsWithContext(ctx context.Context, nlbId string) (ktcloudsdk.ListNLBVMsResponse, error) {
+	if mock.ListNLBVMsWithContextFunc == nil {
+		panic("KtCloudAPIMock.ListNLBVMsWithContextFunc: method is nil but KtCloudAPI.ListNLBVMsWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		NlbId string
+	}{
+		Ctx:   ctx,
+		NlbId: nlbId,
+	}
+	mock.lockListNLBVMsWithContext.Lock()
+	mock.calls.ListNLBVMsWithContext = append(mock.calls.ListNLBVMsWithContext, callInfo)
+	mock.lockListNLBVMsWithContext.Unlock()
+	return mock.ListNLBVMsWithContextFunc(ctx, nlbId)
+}
+
+// ListNLBVMsWithContextCalls gets all the calls that were made to ListNLBVMsWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListNLBVMsWithContextCalls())
+func (mock *KtCloudAPIMock) ListNLBVMsWithContextCalls() []struct {
+	Ctx   context.Context
+	NlbId string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		NlbId string
+	}
+	mock.lockListNLBVMsWithContext.RLock()
+	calls = mock.calls.ListNLBVMsWithContext
+	mock.lockListNLBVMsWithContext.RUnlock()
+	return calls
+}
+
+// ListNLBs calls ListNLBsFunc.
+func (mock *KtCloudAPIMock) ListNLBs(req ktcloudsdk.ListNLBsReqInfo) (ktcloudsdk.ListNLBsResponse, error) {
+	if mock.ListNLBsFunc == nil {
+		panic("KtCloudAPIMock.ListNLBsFunc: method is nil but KtCloudAPI.ListNLBs was just called")
+	}
+	callInfo := struct {
+		Req ktcloudsdk.ListNLBsReqInfo
+	}{
+		Req: req,
+	}
+	mock.lockListNLBs.Lock()
+	mock.calls.ListNLBs = append(mock.calls.ListNLBs, callInfo)
+	mock.lockListNLBs.Unlock()
+	return mock.ListNLBsFunc(req)
+}
+
+// ListNLBsCalls gets all the calls that were made to ListNLBs.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListNLBsCalls())
+func (mock *KtCloudAPIMock) ListNLBsCalls() []struct {
+	Req ktcloudsdk.ListNLBsReqInfo
+} {
+	var calls []struct {
+		Req ktcloudsdk.ListNLBsReqInfo
+	}
+	mock.lockListNLBs.RLock()
+	calls = mock.calls.ListNLBs
+	mock.lockListNLBs.RUnlock()
+	return calls
+}
+
+// ListNLBsWithContext calls ListNLBsWithContextFunc.
+func (mock *KtCloudAPIMock) ListNLBsWithContext(ctx context.Context, req ktcloudsdk.ListNLBsReqInfo) (ktcloudsdk.ListNLBsResponse, error) {
+	if mock.ListNLBsWithContextFunc == nil {
+		panic("KtCloudAPIMock.ListNLBsWithContextFunc: method is nil but KtCloudAPI.ListNLBsWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req ktcloudsdk.ListNLBsReqInfo
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockListNLBsWithContext.Lock()
+	mock.calls.ListNLBsWithContext = append(mock.calls.ListNLBsWithContext, callInfo)
+	mock.lockListNLBsWithContext.Unlock()
+	return mock.ListNLBsWithContextFunc(ctx, req)
+}
+
+// ListNLBsWithContextCalls gets all the calls that were made to ListNLBsWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListNLBsWithContextCalls())
+func (mock *KtCloudAPIMock) ListNLBsWithContextCalls() []struct {
+	Ctx context.Context
+	Req ktcloudsdk.ListNLBsReqInfo
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req ktcloudsdk.ListNLBsReqInfo
+	}
+	mock.lockListNLBsWithContext.RLock()
+	calls = mock.calls.ListNLBsWithContext
+	mock.lockListNLBsWithContext.RUnlock()
+	return calls
+}
+
+// ListPortForwardingRules calls ListPortForwardingRulesFunc.
+func (mock *KtCloudAPIMock) ListPortForwardingRules(portForwardingRulesListReqInfo ktcloudsdk.ListPortForwardingRulesReqInfo) (ktcloudsdk.ListPortForwardingRulesResponse, error) {
+	if mock.ListPortForwardingRulesFunc == nil {
+		panic("KtCloudAPIMock.ListPortForwardingRulesFunc: method is nil but KtCloudAPI.ListPortForwardingRules was just called")
+	}
+	callInfo := struct {
+		PortForwardingRulesListReqInfo ktcloudsdk.ListPortForwardingRulesReqInfo
+	}{
+		PortForwardingRulesListReqInfo: portForwardingRulesListReqInfo,
+	}
+	mock.lockListPortForwardingRules.Lock()
+	mock.calls.ListPortForwardingRules = append(mock.calls.ListPortForwardingRules, callInfo)
+	mock.lockListPortForwardingRules.Unlock()
+	return mock.ListPortForwardingRulesFunc(portForwardingRulesListReqInfo)
+}
+
+// ListPortForwardingRulesCalls gets all the calls that were made to ListPortForwardingRules.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListPortForwardingRulesCalls())
+func (mock *KtCloudAPIMock) ListPortForwardingRulesCalls() []struct {
+	PortForwardingRulesListReqInfo ktcloudsdk.ListPortForwardingRulesReqInfo
+} {
+	var calls []struct {
+		PortForwardingRulesListReqInfo ktcloudsdk.ListPortForwardingRulesReqInfo
+	}
+	mock.lockListPortForwardingRules.RLock()
+	calls = mock.calls.ListPortForwardingRules
+	mock.lockListPortForwardingRules.RUnlock()
+	return calls
+}
+
+// ListPortForwardingRulesWithContext calls ListPortForwardingRulesWithContextFunc.
+func (mock *KtCloudAPIMock) ListPortForwardingRulesWithContext(ctx context.Context, portForwardingRulesListReqInfo ktcloudsdk.ListPortForwardingRulesReqInfo) (ktcloudsdk.ListPortForwardingRulesResponse, error) {
+	if mock.ListPortForwardingRulesWithContextFunc == nil {
+		panic("KtCloudAPIMock.ListPortForwardingRulesWithContextFunc: method is nil but KtCloudAPI.ListPortForwardingRulesWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx                            context.Context
+		PortForwardingRulesListReqInfo ktcloudsdk.ListPortForwardingRulesReqInfo
+	}{
+		Ctx:                            ctx,
+		PortForwardingRulesListReqInfo: portForwardingRulesListReqInfo,
+	}
+	mock.lockListPortForwardingRulesWithContext.Lock()
+	mock.calls.ListPortForwardingRulesWithContext = append(mock.calls.ListPortForwardingRulesWithContext, callInfo)
+	mock.lockListPortForwardingRulesWithContext.Unlock()
+	return mock.ListPortForwardingRulesWithContextFunc(ctx, portForwardingRulesListReqInfo)
+}
+
+// ListPortForwardingRulesWithContextCalls gets all the calls that were made to ListPortForwardingRulesWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListPortForwardingRulesWithContextCalls())
+func (mock *KtCloudAPIMock) ListPortForwardingRulesWithContextCalls() []struct {
+	Ctx                            context.Context
+	PortForwardingRulesListReqInfo ktcloudsdk.ListPortForwardingRulesReqInfo
+} {
+	var calls []struct {
+		Ctx                            context.Context
+		PortForwardingRulesListReqInfo ktcloudsdk.ListPortForwardingRulesReqInfo
+	}
+	mock.lockListPortForwardingRulesWithContext.RLock()
+	calls = mock.calls.ListPortForwardingRulesWithContext
+	mock.lockListPortForwardingRulesWithContext.RUnlock()
+	return calls
+}
+
+// ListPublicIpAddresses calls ListPublicIpAddressesFunc.
+func (mock *KtCloudAPIMock) ListPublicIpAddresses(ipListReqInfo ktcloudsdk.ListPublicIpReqInfo) (ktcloudsdk.ListPublicIpAddressesResponse, error) {
+	if mock.ListPublicIpAddressesFunc == nil {
+		panic("KtCloudAPIMock.ListPublicIpAddressesFunc: method is nil but KtCloudAPI.ListPublicIpAddresses was just called")
+	}
+	callInfo := struct {
+		IpListReqInfo ktcloudsdk.ListPublicIpReqInfo
+	}{
+		IpListReqInfo: ipListReqInfo,
+	}
+	mock.lockListPublicIpAddresses.Lock()
+	mock.calls.ListPublicIpAddresses = append(mock.calls.ListPublicIpAddresses, callInfo)
+	mock.lockListPublicIpAddresses.Unlock()
+	return mock.ListPublicIpAddressesFunc(ipListReqInfo)
+}
+
+// ListPublicIpAddressesCalls gets all the calls that were made to ListPublicIpAddresses.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListPublicIpAddressesCalls())
+func (mock *KtCloudAPIMock) ListPublicIpAddressesCalls() []struct {
+	IpListReqInfo ktcloudsdk.ListPublicIpReqInfo
+} {
+	var calls []struct {
+		IpListReqInfo ktcloudsdk.ListPublicIpReqInfo
+	}
+	mock.lockListPublicIpAddresses.RLock()
+	calls = mock.calls.ListPublicIpAddresses
+	mock.lockListPublicIpAddresses.RUnlock()
+	return calls
+}
+
+// ListPublicIpAddressesWithContext calls ListPublicIpAddressesWithContextFunc.
+func (mock *KtCloudAPIMock) ListPublicIpAddressesWithContext(ctx context.Context, ipListReqInfo ktcloudsdk.ListPublicIpReqInfo) (ktcloudsdk.ListPublicIpAddressesResponse, error) {
+	if mock.ListPublicIpAddressesWithContextFunc == nil {
+		panic("KtCloudAPIMock.ListPublicIpAddressesWithContextFunc: method is nil but KtCloudAPI.ListPublicIpAddressesWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		IpListReqInfo ktcloudsdk.ListPublicIpReqInfo
+	}{
+		Ctx:           ctx,
+		IpListReqInfo: ipListReqInfo,
+	}
+	mock.lockListPublicIpAddressesWithContext.Lock()
+	mock.calls.ListPublicIpAddressesWithContext = append(mock.calls.ListPublicIpAddressesWithContext, callInfo)
+	mock.lockListPublicIpAddressesWithContext.Unlock()
+	return mock.ListPublicIpAddressesWithContextFunc(ctx, ipListReqInfo)
+}
+
+// ListPublicIpAddressesWithContextCalls gets all the calls that were made to ListPublicIpAddressesWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListPublicIpAddressesWithContextCalls())
+func (mock *KtCloudAPIMock) ListPublicIpAddressesWithContextCalls() []struct {
+	Ctx           context.Context
+	IpListReqInfo ktcloudsdk.ListPublicIpReqInfo
+} {
+	var calls []struct {
+		Ctx           context.Context
+		IpListReqInfo ktcloudsdk.ListPublicIpReqInfo
+	}
+	mock.lockListPublicIpAddressesWithContext.RLock()
+	calls = mock.calls.ListPublicIpAddressesWithContext
+	mock.lockListPublicIpAddressesWithContext.RUnlock()
+	return calls
+}
+
+// ListSSHKeyPairs calls ListSSHKeyPairsFunc.
+func (mock *KtCloudAPIMock) ListSSHKeyPairs(name string) (ktcloudsdk.ListSshKeyPairsResponse, error) {
+	if mock.ListSSHKeyPairsFunc == nil {
+		panic("KtCloudAPIMock.ListSSHKeyPairsFunc: method is nil but KtCloudAPI.ListSSHKeyPairs was just called")
+	}
+	callInfo := struct {
+		Name string
+	}{
+		Name: name,
+	}
+	mock.lockListSSHKeyPairs.Lock()
+	mock.calls.ListSSHKeyPairs = append(mock.calls.ListSSHKeyPairs, callInfo)
+	mock.lockListSSHKeyPairs.Unlock()
+	return mock.ListSSHKeyPairsFunc(name)
+}
+
+// ListSSHKeyPairsCalls gets all the calls that were made to ListSSHKeyPairs.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListSSHKeyPairsCalls())
+func (mock *KtCloudAPIMock) ListSSHKeyPairsCalls() []struct {
+	Name string
+} {
+	var calls []struct {
+		Name string
+	}
+	mock.lockListSSHKeyPairs.RLock()
+	calls = mock.calls.ListSSHKeyPairs
+	mock.lockListSSHKeyPairs.RUnlock()
+	return calls
+}
+
+// ListSSHKeyPairsWithContext calls ListSSHKeyPairsWithContextFunc.
+func (mock *KtCloudAPIMock) ListSSHKeyPairsWithContext(ctx context.Context, name string) (ktcloudsdk.ListSshKeyPairsResponse, error) {
+	if mock.ListSSHKeyPairsWithContextFunc == nil {
+		panic("KtCloudAPIMock.ListSSHKeyPairsWithContextFunc: method is nil but KtCloudAPI.ListSSHKeyPairsWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+	}{
+		Ctx:  ctx,
+		Name: name,
+	}
+	mock.lockListSSHKeyPairsWithContext.Lock()
+	mock.calls.ListSSHKeyPairsWithContext = append(mock.calls.ListSSHKeyPairsWithContext, callInfo)
+	mock.lockListSSHKeyPairsWithContext.Unlock()
+	return mock.ListSSHKeyPairsWithContextFunc(ctx, name)
+}
+
+// ListSSHKeyPairsWithContextCalls gets all the calls that were made to ListSSHKeyPairsWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListSSHKeyPairsWithContextCalls())
+func (mock *KtCloudAPIMock) ListSSHKeyPairsWithContextCalls() []struct {
+	Ctx  context.Context
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+	}
+	mock.lockListSSHKeyPairsWithContext.RLock()
+	calls = mock.calls.ListSSHKeyPairsWithContext
+	mock.lockListSSHKeyPairsWithContext.RUnlock()
+	return calls
+}
+
+// ListTags calls ListTagsFunc.
+func (mock *KtCloudAPIMock) ListTags(options *ktcloudsdk.ListTagsReqInfo) (ktcloudsdk.ListTagsResponse, error) {
+	if mock.ListTagsFunc == nil {
+		panic("KtCloudAPIMock.ListTagsFunc: method is nil but KtCloudAPI.ListTags was just called")
+	}
+	callInfo := struct {
+		Options *ktcloudsdk.ListTagsReqInfo
+	}{
+		Options: options,
+	}
+	mock.lockListTags.Lock()
+	mock.calls.ListTags = append(mock.calls.ListTags, callInfo)
+	mock.lockListTags.Unlock()
+	return mock.ListTagsFunc(options)
+}
+
+// ListTagsCalls gets all the calls that were made to ListTags.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListTagsCalls())
+func (mock *KtCloudAPIMock) ListTagsCalls() []struct {
+	Options *ktcloudsdk.ListTagsReqInfo
+} {
+	var calls []struct {
+		Options *ktcloudsdk.ListTagsReqInfo
+	}
+	mock.lockListTags.RLock()
+	calls = mock.calls.ListTags
+	mock.lockListTags.RUnlock()
+	return calls
+}
+
+// ListTagsWithContext calls ListTagsWithContextFunc.
+func (mock *KtCloudAPIMock) ListTagsWithContext(ctx context.Context, options *ktcloudsdk.ListTagsReqInfo) (ktcloudsdk.ListTagsResponse, error) {
+	if mock.ListTagsWithContextFunc == nil {
+		panic("KtCloudAPIMock.ListTagsWithContextFunc: method is nil but KtCloudAPI.ListTagsWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Options *ktcloudsdk.ListTagsReqInfo
+	}{
+		Ctx:     ctx,
+		Options: options,
+	}
+	mock.lockListTagsWithContext.Lock()
+	mock.calls.ListTagsWithContext = append(mock.calls.ListTagsWithContext, callInfo)
+	mock.lockListTagsWithContext.Unlock()
+	return mock.ListTagsWithContextFunc(ctx, options)
+}
+
+// ListTagsWithContextCalls gets all the calls that were made to ListTagsWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListTagsWithContextCalls())
+func (mock *KtCloudAPIMock) ListTagsWithContextCalls() []struct {
+	Ctx     context.Context
+	Options *ktcloudsdk.ListTagsReqInfo
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Options *ktcloudsdk.ListTagsReqInfo
+	}
+	mock.lockListTagsWithContext.RLock()
+	calls = mock.calls.ListTagsWithContext
+	mock.lockListTagsWithContext.RUnlock()
+	return calls
+}
+
+// ListTemplates calls ListTemplatesFunc.
+func (mock *KtCloudAPIMock) ListTemplates(req *ktcloudsdk.ListTemplateReqInfo) (ktcloudsdk.ListTemplatesResponse, error) {
+	if mock.ListTemplatesFunc == nil {
+		panic("KtCloudAPIMock.ListTemplatesFunc: method is nil but KtCloudAPI.ListTemplates was just called")
+	}
+	callInfo := struct {
+		Req *ktcloudsdk.ListTemplateReqInfo
+	}{
+		Req: req,
+	}
+	mock.lockListTemplates.Lock()
+	mock.calls.ListTemplates = append(mock.calls.ListTemplates, callInfo)
+	mock.lockListTemplates.Unlock()
+	return mock.ListTemplatesFunc(req)
+}
+
+// ListTemplatesCalls gets all the calls that were made to ListTemplates.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListTemplatesCalls())
+func (mock *KtCloudAPIMock) ListTemplatesCalls() []struct {
+	Req *ktcloudsdk.ListTemplateReqInfo
+} {
+	var calls []struct {
+		Req *ktcloudsdk.ListTemplateReqInfo
+	}
+	mock.lockListTemplates.RLock()
+	calls = mock.calls.ListTemplates
+	mock.lockListTemplates.RUnlock()
+	return calls
+}
+
+// ListTemplatesWithContext calls ListTemplatesWithContextFunc.
+func (mock *KtCloudAPIMock) ListTemplatesWithContext(ctx context.Context, req *ktcloudsdk.ListTemplateReqInfo) (ktcloudsdk.ListTemplatesResponse, error) {
+	if mock.ListTemplatesWithContextFunc == nil {
+		panic("KtCloudAPIMock.ListTemplatesWithContextFunc: method is nil but KtCloudAPI.ListTemplatesWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req *ktcloudsdk.ListTemplateReqInfo
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockListTemplatesWithContext.Lock()
+	mock.calls.ListTemplatesWithContext = append(mock.calls.ListTemplatesWithContext, callInfo)
+	mock.lockListTemplatesWithContext.Unlock()
+	return mock.ListTemplatesWithContextFunc(ctx, req)
+}
+
+// ListTemplatesWithContextCalls gets all the calls that were made to ListTemplatesWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListTemplatesWithContextCalls())
+func (mock *KtCloudAPIMock) ListTemplatesWithContextCalls() []struct {
+	Ctx context.Context
+	Req *ktcloudsdk.ListTemplateReqInfo
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req *ktcloudsdk.ListTemplateReqInfo
+	}
+	mock.lockListTemplatesWithContext.RLock()
+	calls = mock.calls.ListTemplatesWithContext
+	mock.lockListTemplatesWithContext.RUnlock()
+	return calls
+}
+
+// ListVirtualMachines calls ListVirtualMachinesFunc.
+func (mock *KtCloudAPIMock) ListVirtualMachines(vmListReqInfo ktcloudsdk.ListVMReqInfo) (ktcloudsdk.ListVirtualMachinesResponse, error) {
+	if mock.ListVirtualMachinesFunc == nil {
+		panic("KtCloudAPIMock.ListVirtualMachinesFunc: method is nil but KtCloudAPI.ListVirtualMachines was just called")
+	}
+	callInfo := struct {
+		VmListReqInfo ktcloudsdk.ListVMReqInfo
+	}{
+		VmListReqInfo: vmListReqInfo,
+	}
+	mock.lockListVirtualMachines.Lock()
+	mock.calls.ListVirtualMachines = append(mock.calls.ListVirtualMachines, callInfo)
+	mock.lockListVirtualMachines.Unlock()
+	return mock.ListVirtualMachinesFunc(vmListReqInfo)
+}
+
+// ListVirtualMachinesCalls gets all the calls that were made to ListVirtualMachines.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListVirtualMachinesCalls())
+func (mock *KtCloudAPIMock) ListVirtualMachinesCalls() []struct {
+	VmListReqInfo ktcloudsdk.ListVMReqInfo
+} {
+	var calls []struct {
+		VmListReqInfo ktcloudsdk.ListVMReqInfo
+	}
+	mock.lockListVirtualMachines.RLock()
+	calls = mock.calls.ListVirtualMachines
+	mock.lockListVirtualMachines.RUnlock()
+	return calls
+}
+
+// ListVirtualMachinesWithContext calls ListVirtualMachinesWithContextFunc.
+func (mock *KtCloudAPIMock) ListVirtualMachinesWithContext(ctx context.Context, vmListReqInfo ktcloudsdk.ListVMReqInfo) (ktcloudsdk.ListVirtualMachinesResponse, error) {
+	if mock.ListVirtualMachinesWithContextFunc == nil {
+		panic("KtCloudAPIMock.ListVirtualMachinesWithContextFunc: method is nil but KtCloudAPI.ListVirtualMachinesWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		VmListReqInfo ktcloudsdk.ListVMReqInfo
+	}{
+		Ctx:           ctx,
+		VmListReqInfo: vmListReqInfo,
+	}
+	mock.lockListVirtualMachinesWithContext.Lock()
+	mock.calls.ListVirtualMachinesWithContext = append(mock.calls.ListVirtualMachinesWithContext, callInfo)
+	mock.lockListVirtualMachinesWithContext.Unlock()
+	return mock.ListVirtualMachinesWithContextFunc(ctx, vmListReqInfo)
+}
+
+// ListVirtualMachinesWithContextCalls gets all the calls that were made to ListVirtualMachinesWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListVirtualMachinesWithContextCalls())
+func (mock *KtCloudAPIMock) ListVirtualMachinesWithContextCalls() []struct {
+	Ctx           context.Context
+	VmListReqInfo ktcloudsdk.ListVMReqInfo
+} {
+	var calls []struct {
+		Ctx           context.Context
+		VmListReqInfo ktcloudsdk.ListVMReqInfo
+	}
+	mock.lockListVirtualMachinesWithContext.RLock()
+	calls = mock.calls.ListVirtualMachinesWithContext
+	mock.lockListVirtualMachinesWithContext.RUnlock()
+	return calls
+}
+
+// ListVolumes calls ListVolumesFunc.
+func (mock *KtCloudAPIMock) ListVolumes(req ktcloudsdk.ListVolumeReqInfo) (ktcloudsdk.ListVolumesResponse, error) {
+	if mock.ListVolumesFunc == nil {
+		panic("KtCloudAPIMock.ListVolumesFunc: method is nil but KtCloudAPI.ListVolumes was just called")
+	}
+	callInfo := struct {
+		Req ktcloudsdk.ListVolumeReqInfo
+	}{
+		Req: req,
+	}
+	mock.lockListVolumes.Lock()
+	mock.calls.ListVolumes = append(mock.calls.ListVolumes, callInfo)
+	mock.lockListVolumes.Unlock()
+	return mock.ListVolumesFunc(req)
+}
+
+// ListVolumesCalls gets all the calls that were made to ListVolumes.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListVolumesCalls())
+func (mock *KtCloudAPIMock) ListVolumesCalls() []struct {
+	Req ktcloudsdk.ListVolumeReqInfo
+} {
+	var calls []struct {
+		Req ktcloudsdk.ListVolumeReqInfo
+	}
+	mock.lockListVolumes.RLock()
+	calls = mock.calls.ListVolumes
+	mock.lockListVolumes.RUnlock()
+	return calls
+}
+
+// ListVolumesWithContext calls ListVolumesWithContextFunc.
+func (mock *KtCloudAPIMock) ListVolumesWithContext(ctx context.Context, req ktcloudsdk.ListVolumeReqInfo) (ktcloudsdk.ListVolumesResponse, error) {
+	if mock.ListVolumesWithContextFunc == nil {
+		panic("KtCloudAPIMock.ListVolumesWithContextFunc: method is nil but KtCloudAPI.ListVolumesWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req ktcloudsdk.ListVolumeReqInfo
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockListVolumesWithContext.Lock()
+	mock.calls.ListVolumesWithContext = append(mock.calls.ListVolumesWithContext, callInfo)
+	mock.lockListVolumesWithContext.Unlock()
+	return mock.ListVolumesWithContextFunc(ctx, req)
+}
+
+// ListVolumesWithContextCalls gets all the calls that were made to ListVolumesWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListVolumesWithContextCalls())
+func (mock *KtCloudAPIMock) ListVolumesWithContextCalls() []struct {
+	Ctx context.Context
+	Req ktcloudsdk.ListVolumeReqInfo
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req ktcloudsdk.ListVolumeReqInfo
+	}
+	mock.lockListVolumesWithContext.RLock()
+	calls = mock.calls.ListVolumesWithContext
+	mock.lockListVolumesWithContext.RUnlock()
+	return calls
+}
+
+// ListZones calls ListZonesFunc.
+func (mock *KtCloudAPIMock) ListZones(isAvailable bool, domainId string, zoneId string, keyword string) (ktcloudsdk.ListZonesResponse, error) {
+	if mock.ListZonesFunc == nil {
+		panic("KtCloudAPIMock.ListZonesFunc: method is nil but KtCloudAPI.ListZones was just called")
+	}
+	callInfo := struct {
+		IsAvailable bool
+		DomainId    string
+		ZoneId      string
+		Keyword     string
+	}{
+		IsAvailable: isAvailable,
+		DomainId:    domainId,
+		ZoneId:      zoneId,
+		Keyword:     keyword,
+	}
+	mock.lockListZones.Lock()
+	mock.calls.ListZones = append(mock.calls.ListZones, callInfo)
+	mock.lockListZones.Unlock()
+	return mock.ListZonesFunc(isAvailable, domainId, zoneId, keyword)
+}
+
+// ListZonesCalls gets all the calls that were made to ListZones.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListZonesCalls())
+func (mock *KtCloudAPIMock) ListZonesCalls() []struct {
+	IsAvailable bool
+	DomainId    string
+	ZoneId      string
+	Keyword     string
+} {
+	var calls []struct {
+		IsAvailable bool
+		DomainId    string
+		ZoneId      string
+		Keyword     string
+	}
+	mock.lockListZones.RLock()
+	calls = mock.calls.ListZones
+	mock.lockListZones.RUnlock()
+	return calls
+}
+
+// ListZonesWithContext calls ListZonesWithContextFunc.
+func (mock *KtCloudAPIMock) ListZonesWithContext(ctx context.Context, isAvailable bool, domainId string, zoneId string, keyword string) (ktcloudsdk.ListZonesResponse, error) {
+	if mock.ListZonesWithContextFunc == nil {
+		panic("KtCloudAPIMock.ListZonesWithContextFunc: method is nil but KtCloudAPI.ListZonesWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		IsAvailable bool
+		DomainId    string
+		ZoneId      string
+		Keyword     string
+	}{
+		Ctx:         ctx,
+		IsAvailable: isAvailable,
+		DomainId:    domainId,
+		ZoneId:      zoneId,
+		Keyword:     keyword,
+	}
+	mock.lockListZonesWithContext.Lock()
+	mock.calls.ListZonesWithContext = append(mock.calls.ListZonesWithContext, callInfo)
+	mock.lockListZonesWithContext.Unlock()
+	return mock.ListZonesWithContextFunc(ctx, isAvailable, domainId, zoneId, keyword)
+}
+
+// ListZonesWithContextCalls gets all the calls that were made to ListZonesWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ListZonesWithContextCalls())
+func (mock *KtCloudAPIMock) ListZonesWithContextCalls() []struct {
+	Ctx         context.Context
+	IsAvailable bool
+	DomainId    string
+	ZoneId      string
+	Keyword     string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		IsAvailable bool
+		DomainId    string
+		ZoneId      string
+		Keyword     string
+	}
+	mock.lockListZonesWithContext.RLock()
+	calls = mock.calls.ListZonesWithContext
+	mock.lockListZonesWithContext.RUnlock()
+	return calls
+}
+
+// QueryAsyncJobResult calls QueryAsyncJobResultFunc.
+func (mock *KtCloudAPIMock) QueryAsyncJobResult(jobId string) (ktcloudsdk.QueryAsyncJobResultResponse, error) {
+	if mock.QueryAsyncJobResultFunc == nil {
+		panic("KtCloudAPIMock.QueryAsyncJobResultFunc: method is nil but KtCloudAPI.QueryAsyncJobResult was just called")
+	}
+	callInfo := struct {
+		JobId string
+	}{
+		JobId: jobId,
+	}
+	mock.lockQueryAsyncJobResult.Lock()
+	mock.calls.QueryAsyncJobResult = append(mock.calls.QueryAsyncJobResult, callInfo)
+	mock.lockQueryAsyncJobResult.Unlock()
+	return mock.QueryAsyncJobResultFunc(jobId)
+}
+
+// QueryAsyncJobResultCalls gets all the calls that were made to QueryAsyncJobResult.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.QueryAsyncJobResultCalls())
+func (mock *KtCloudAPIMock) QueryAsyncJobResultCalls() []struct {
+	JobId string
+} {
+	var calls []struct {
+		JobId string
+	}
+	mock.lockQueryAsyncJobResult.RLock()
+	calls = mock.calls.QueryAsyncJobResult
+	mock.lockQueryAsyncJobResult.RUnlock()
+	return calls
+}
+
+// QueryAsyncJobResultWithContext calls QueryAsyncJobResultWithContextFunc.
+func (mock *KtCloudAPIMock) QueryAsyncJobResultWithContext(ctx context.Context, jobId string) (ktcloudsdk.QueryAsyncJobResultResponse, error) {
+	if mock.QueryAsyncJobResultWithContextFunc == nil {
+		panic("KtCloudAPIMock.QueryAsyncJobResultWithContextFunc: method is nil but KtCloudAPI.QueryAsyncJobResultWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		JobId string
+	}{
+		Ctx:   ctx,
+		JobId: jobId,
+	}
+	mock.lockQueryAsyncJobResultWithContext.Lock()
+	mock.calls.QueryAsyncJobResultWithContext = append(mock.calls.QueryAsyncJobResultWithContext, callInfo)
+	mock.lockQueryAsyncJobResultWithContext.Unlock()
+	return mock.QueryAsyncJobResultWithContextFunc(ctx, jobId)
+}
+
+// QueryAsyncJobResultWithContextCalls gets all the calls that were made to QueryAsyncJobResultWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.QueryAsyncJobResultWithContextCalls())
+func (mock *KtCloudAPIMock) QueryAsyncJobResultWithContextCalls() []struct {
+	Ctx   context.Context
+	JobId string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		JobId string
+	}
+	mock.lockQueryAsyncJobResultWithContext.RLock()
+	calls = mock.calls.QueryAsyncJobResultWithContext
+	mock.lockQueryAsyncJobResultWithContext.RUnlock()
+	return calls
+}
+
+// RebootVirtualMachine calls RebootVirtualMachineFunc.
+func (mock *KtCloudAPIMock) RebootVirtualMachine(vmId string) (ktcloudsdk.RebootVirtualMachineResponse, error) {
+	if mock.RebootVirtualMachineFunc == nil {
+		panic("KtCloudAPIMock.RebootVirtualMachineFunc: method is nil but KtCloudAPI.RebootVirtualMachine was just called")
+	}
+	callInfo := struct {
+		VmId string
+	}{
+		VmId: vmId,
+	}
+	mock.lockRebootVirtualMachine.Lock()
+	mock.calls.RebootVirtualMachine = append(mock.calls.RebootVirtualMachine, callInfo)
+	mock.lockRebootVirtualMachine.Unlock()
+	return mock.RebootVirtualMachineFunc(vmId)
+}
+
+// RebootVirtualMachineCalls gets all the calls that were made to RebootVirtualMachine.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.RebootVirtualMachineCalls())
+func (mock *KtCloudAPIMock) RebootVirtualMachineCalls() []struct {
+	VmId string
+} {
+	var calls []struct {
+		VmId string
+	}
+	mock.lockRebootVirtualMachine.RLock()
+	calls = mock.calls.RebootVirtualMachine
+	mock.lockRebootVirtualMachine.RUnlock()
+	return calls
+}
+
+// RebootVirtualMachineWithContext calls RebootVirtualMachineWithContextFunc.
+func (mock *KtCloudAPIMock) RebootVirtualMachineWithContext(ctx context.Context, vmId string) (ktcloudsdk.RebootVirtualMachineResponse, error) {
+	if mock.RebootVirtualMachineWithContextFunc == nil {
+		panic("KtCloudAPIMock.RebootVirtualMachineWithContextFunc: method is nil but KtCloudAPI.RebootVirtualMachineWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		VmId string
+	}{
+		Ctx:  ctx,
+		VmId: vmId,
+	}
+	mock.lockRebootVirtualMachineWithContext.Lock()
+	mock.calls.RebootVirtualMachineWithContext = append(mock.calls.RebootVirtualMachineWithContext, callInfo)
+	mock.lockRebootVirtualMachineWithContext.Unlock()
+	return mock.RebootVirtualMachineWithContextFunc(ctx, vmId)
+}
+
+// RebootVirtualMachineWithContextCalls gets all the calls that were made to RebootVirtualMachineWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.RebootVirtualMachineWithContextCalls())
+func (mock *KtCloudAPIMock) RebootVirtualMachineWithContextCalls() []struct {
+	Ctx  context.Context
+	VmId string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		VmId string
+	}
+	mock.lockRebootVirtualMachineWithContext.RLock()
+	calls = mock.calls.RebootVirtualMachineWithContext
+	mock.lockRebootVirtualMachineWithContext.RUnlock()
+	return calls
+}
+
+// RemoveNLBVM calls RemoveNLBVMFunc.
+func (mock *KtCloudAPIMock) RemoveNLBVM(serviceId string) (ktcloudsdk.RemoveNLBVMResponse, error) {
+	if mock.RemoveNLBVMFunc == nil {
+		panic("KtCloudAPIMock.RemoveNLBVMFunc: method is nil but KtCloudAPI.RemoveNLBVM was just called")
+	}
+	callInfo := struct {
+		ServiceId string
+	}{
+		ServiceId: serviceId,
+	}
+	mock.lockRemoveNLBVM.Lock()
+	mock.calls.RemoveNLBVM = append(mock.calls.RemoveNLBVM, callInfo)
+	mock.lockRemoveNLBVM.Unlock()
+	return mock.RemoveNLBVMFunc(serviceId)
+}
+
+// RemoveNLBVMCalls gets all the calls that were made to RemoveNLBVM.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.RemoveNLBVMCalls())
+func (mock *KtCloudAPIMock) RemoveNLBVMCalls() []struct {
+	ServiceId string
+} {
+	var calls []struct {
+		ServiceId string
+	}
+	mock.lockRemoveNLBVM.RLock()
+	calls = mock.calls.RemoveNLBVM
+	mock.lockRemoveNLBVM.RUnlock()
+	return calls
+}
+
+// RemoveNLBVMWithContext calls RemoveNLBVMWithContextFunc.
+func (mock *KtCloudAPIMock) RemoveNLBVMWithContext(ctx context.Context, serviceId string) (ktcloudsdk.RemoveNLBVMResponse, error) {
+	if mock.RemoveNLBVMWithContextFunc == nil {
+		panic("KtCloudAPIMock.RemoveNLBVMWithContextFunc: method is nil but KtCloudAPI.RemoveNLBVMWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ServiceId string
+	}{
+		Ctx:       ctx,
+		ServiceId: serviceId,
+	}
+	mock.lockRemoveNLBVMWithContext.Lock()
+	mock.calls.RemoveNLBVMWithContext = append(mock.calls.RemoveNLBVMWithContext, callInfo)
+	mock.lockRemoveNLBVMWithContext.Unlock()
+	return mock.RemoveNLBVMWithContextFunc(ctx, serviceId)
+}
+
+// RemoveNLBVMWithContextCalls gets all the calls that were made to RemoveNLBVMWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.RemoveNLBVMWithContextCalls())
+func (mock *KtCloudAPIMock) RemoveNLBVMWithContextCalls() []struct {
+	Ctx       context.Context
+	ServiceId string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ServiceId string
+	}
+	mock.lockRemoveNLBVMWithContext.RLock()
+	calls = mock.calls.RemoveNLBVMWithContext
+	mock.lockRemoveNLBVMWithContext.RUnlock()
+	return calls
+}
+
+// ResizeVolume calls ResizeVolumeFunc.
+func (mock *KtCloudAPIMock) ResizeVolume(req ktcloudsdk.ResizeVolumeReqInfo) (ktcloudsdk.ResizeVolumeResponse, error) {
+	if mock.ResizeVolumeFunc == nil {
+		panic("KtCloudAPIMock.ResizeVolumeFunc: method is nil but KtCloudAPI.ResizeVolume was just called")
+	}
+	callInfo := struct {
+		Req ktcloudsdk.ResizeVolumeReqInfo
+	}{
+		Req: req,
+	}
+	mock.lockResizeVolume.Lock()
+	mock.calls.ResizeVolume = append(mock.calls.ResizeVolume, callInfo)
+	mock.lockResizeVolume.Unlock()
+	return mock.ResizeVolumeFunc(req)
+}
+
+// ResizeVolumeCalls gets all the calls that were made to ResizeVolume.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ResizeVolumeCalls())
+func (mock *KtCloudAPIMock) ResizeVolumeCalls() []struct {
+	Req ktcloudsdk.ResizeVolumeReqInfo
+} {
+	var calls []struct {
+		Req ktcloudsdk.ResizeVolumeReqInfo
+	}
+	mock.lockResizeVolume.RLock()
+	calls = mock.calls.ResizeVolume
+	mock.lockResizeVolume.RUnlock()
+	return calls
+}
+
+// ResizeVolumeWithContext calls ResizeVolumeWithContextFunc.
+func (mock *KtCloudAPIMock) ResizeVolumeWithContext(ctx context.Context, req ktcloudsdk.ResizeVolumeReqInfo) (ktcloudsdk.ResizeVolumeResponse, error) {
+	if mock.ResizeVolumeWithContextFunc == nil {
+		panic("KtCloudAPIMock.ResizeVolumeWithContextFunc: method is nil but KtCloudAPI.ResizeVolumeWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req ktcloudsdk.ResizeVolumeReqInfo
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockResizeVolumeWithContext.Lock()
+	mock.calls.ResizeVolumeWithContext = append(mock.calls.ResizeVolumeWithContext, callInfo)
+	mock.lockResizeVolumeWithContext.Unlock()
+	return mock.ResizeVolumeWithContextFunc(ctx, req)
+}
+
+// ResizeVolumeWithContextCalls gets all the calls that were made to ResizeVolumeWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.ResizeVolumeWithContextCalls())
+func (mock *KtCloudAPIMock) ResizeVolumeWithContextCalls() []struct {
+	Ctx context.Context
+	Req ktcloudsdk.ResizeVolumeReqInfo
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req ktcloudsdk.ResizeVolumeReqInfo
+	}
+	mock.lockResizeVolumeWithContext.RLock()
+	calls = mock.calls.ResizeVolumeWithContext
+	mock.lockResizeVolumeWithContext.RUnlock()
+	return calls
+}
+
+// StartVirtualMachine calls StartVirtualMachineFunc.
+func (mock *KtCloudAPIMock) StartVirtualMachine(vmId string) (ktcloudsdk.StartVirtualMachineResponse, error) {
+	if mock.StartVirtualMachineFunc == nil {
+		panic("KtCloudAPIMock.StartVirtualMachineFunc: method is nil but KtCloudAPI.StartVirtualMachine was just called")
+	}
+	callInfo := struct {
+		VmId string
+	}{
+		VmId: vmId,
+	}
+	mock.lockStartVirtualMachine.Lock()
+	mock.calls.StartVirtualMachine = append(mock.calls.StartVirtualMachine, callInfo)
+	mock.lockStartVirtualMachine.Unlock()
+	return mock.StartVirtualMachineFunc(vmId)
+}
+
+// StartVirtualMachineCalls gets all the calls that were made to StartVirtualMachine.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.StartVirtualMachineCalls())
+func (mock *KtCloudAPIMock) StartVirtualMachineCalls() []struct {
+	VmId string
+} {
+	var calls []struct {
+		VmId string
+	}
+	mock.lockStartVirtualMachine.RLock()
+	calls = mock.calls.StartVirtualMachine
+	mock.lockStartVirtualMachine.RUnlock()
+	return calls
+}
+
+// StartVirtualMachineWithContext calls StartVirtualMachineWithContextFunc.
+func (mock *KtCloudAPIMock) StartVirtualMachineWithContext(ctx context.Context, vmId string) (ktcloudsdk.StartVirtualMachineResponse, error) {
+	if mock.StartVirtualMachineWithContextFunc == nil {
+		panic("KtCloudAPIMock.StartVirtualMachineWithContextFunc: method is nil but KtCloudAPI.StartVirtualMachineWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		VmId string
+	}{
+		Ctx:  ctx,
+		VmId: vmId,
+	}
+	mock.lockStartVirtualMachineWithContext.Lock()
+	mock.calls.StartVirtualMachineWithContext = append(mock.calls.StartVirtualMachineWithContext, callInfo)
+	mock.lockStartVirtualMachineWithContext.Unlock()
+	return mock.StartVirtualMachineWithContextFunc(ctx, vmId)
+}
+
+// StartVirtualMachineWithContextCalls gets all the calls that were made to StartVirtualMachineWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.StartVirtualMachineWithContextCalls())
+func (mock *KtCloudAPIMock) StartVirtualMachineWithContextCalls() []struct {
+	Ctx  context.Context
+	VmId string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		VmId string
+	}
+	mock.lockStartVirtualMachineWithContext.RLock()
+	calls = mock.calls.StartVirtualMachineWithContext
+	mock.lockStartVirtualMachineWithContext.RUnlock()
+	return calls
+}
+
+// StopVirtualMachine calls StopVirtualMachineFunc.
+func (mock *KtCloudAPIMock) StopVirtualMachine(vmId string) (ktcloudsdk.StopVirtualMachineResponse, error) {
+	if mock.StopVirtualMachineFunc == nil {
+		panic("KtCloudAPIMock.StopVirtualMachineFunc: method is nil but KtCloudAPI.StopVirtualMachine was just called")
+	}
+	callInfo := struct {
+		VmId string
+	}{
+		VmId: vmId,
+	}
+	mock.lockStopVirtualMachine.Lock()
+	mock.calls.StopVirtualMachine = append(mock.calls.StopVirtualMachine, callInfo)
+	mock.lockStopVirtualMachine.Unlock()
+	return mock.StopVirtualMachineFunc(vmId)
+}
+
+// StopVirtualMachineCalls gets all the calls that were made to StopVirtualMachine.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.StopVirtualMachineCalls())
+func (mock *KtCloudAPIMock) StopVirtualMachineCalls() []struct {
+	VmId string
+} {
+	var calls []struct {
+		VmId string
+	}
+	mock.lockStopVirtualMachine.RLock()
+	calls = mock.calls.StopVirtualMachine
+	mock.lockStopVirtualMachine.RUnlock()
+	return calls
+}
+
+// StopVirtualMachineWithContext calls StopVirtualMachineWithContextFunc.
+func (mock *KtCloudAPIMock) StopVirtualMachineWithContext(ctx context.Context, vmId string) (ktcloudsdk.StopVirtualMachineResponse, error) {
+	if mock.StopVirtualMachineWithContextFunc == nil {
+		panic("KtCloudAPIMock.StopVirtualMachineWithContextFunc: method is nil but KtCloudAPI.StopVirtualMachineWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		VmId string
+	}{
+		Ctx:  ctx,
+		VmId: vmId,
+	}
+	mock.lockStopVirtualMachineWithContext.Lock()
+	mock.calls.StopVirtualMachineWithContext = append(mock.calls.StopVirtualMachineWithContext, callInfo)
+	mock.lockStopVirtualMachineWithContext.Unlock()
+	return mock.StopVirtualMachineWithContextFunc(ctx, vmId)
+}
+
+// StopVirtualMachineWithContextCalls gets all the calls that were made to StopVirtualMachineWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.StopVirtualMachineWithContextCalls())
+func (mock *KtCloudAPIMock) StopVirtualMachineWithContextCalls() []struct {
+	Ctx  context.Context
+	VmId string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		VmId string
+	}
+	mock.lockStopVirtualMachineWithContext.RLock()
+	calls = mock.calls.StopVirtualMachineWithContext
+	mock.lockStopVirtualMachineWithContext.RUnlock()
+	return calls
+}
+
+// UpdateVirtualMachine calls UpdateVirtualMachineFunc.
+func (mock *KtCloudAPIMock) UpdateVirtualMachine(vmId string, displayname string, haenable string) (ktcloudsdk.UpdateVirtualMachineResponse, error) {
+	if mock.UpdateVirtualMachineFunc == nil {
+		panic("KtCloudAPIMock.UpdateVirtualMachineFunc: method is nil but KtCloudAPI.UpdateVirtualMachine was just called")
+	}
+	callInfo := struct {
+		VmId        string
+		Displayname string
+		Haenable    string
+	}{
+		VmId:        vmId,
+		Displayname: displayname,
+		Haenable:    haenable,
+	}
+	mock.lockUpdateVirtualMachine.Lock()
+	mock.calls.UpdateVirtualMachine = append(mock.calls.UpdateVirtualMachine, callInfo)
+	mock.lockUpdateVirtualMachine.Unlock()
+	return mock.UpdateVirtualMachineFunc(vmId, displayname, haenable)
+}
+
+// UpdateVirtualMachineCalls gets all the calls that were made to UpdateVirtualMachine.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.UpdateVirtualMachineCalls())
+func (mock *KtCloudAPIMock) UpdateVirtualMachineCalls() []struct {
+	VmId        string
+	Displayname string
+	Haenable    string
+} {
+	var calls []struct {
+		VmId        string
+		Displayname string
+		Haenable    string
+	}
+	mock.lockUpdateVirtualMachine.RLock()
+	calls = mock.calls.UpdateVirtualMachine
+	mock.lockUpdateVirtualMachine.RUnlock()
+	return calls
+}
+
+// UpdateVirtualMachineWithContext calls UpdateVirtualMachineWithContextFunc.
+func (mock *KtCloudAPIMock) UpdateVirtualMachineWithContext(ctx context.Context, vmId string, displayname string, haenable string) (ktcloudsdk.UpdateVirtualMachineResponse, error) {
+	if mock.UpdateVirtualMachineWithContextFunc == nil {
+		panic("KtCloudAPIMock.UpdateVirtualMachineWithContextFunc: method is nil but KtCloudAPI.UpdateVirtualMachineWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		VmId        string
+		Displayname string
+		Haenable    string
+	}{
+		Ctx:         ctx,
+		VmId:        vmId,
+		Displayname: displayname,
+		Haenable:    haenable,
+	}
+	mock.lockUpdateVirtualMachineWithContext.Lock()
+	mock.calls.UpdateVirtualMachineWithContext = append(mock.calls.UpdateVirtualMachineWithContext, callInfo)
+	mock.lockUpdateVirtualMachineWithContext.Unlock()
+	return mock.UpdateVirtualMachineWithContextFunc(ctx, vmId, displayname, haenable)
+}
+
+// UpdateVirtualMachineWithContextCalls gets all the calls that were made to UpdateVirtualMachineWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.UpdateVirtualMachineWithContextCalls())
+func (mock *KtCloudAPIMock) UpdateVirtualMachineWithContextCalls() []struct {
+	Ctx         context.Context
+	VmId        string
+	Displayname string
+	Haenable    string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		VmId        string
+		Displayname string
+		Haenable    string
+	}
+	mock.lockUpdateVirtualMachineWithContext.RLock()
+	calls = mock.calls.UpdateVirtualMachineWithContext
+	mock.lockUpdateVirtualMachineWithContext.RUnlock()
+	return calls
+}
+
+// WaitForAsyncJob calls WaitForAsyncJobFunc.
+func (mock *KtCloudAPIMock) WaitForAsyncJob(jobId string, timeOut time.Duration) error {
+	if mock.WaitForAsyncJobFunc == nil {
+		panic("KtCloudAPIMock.WaitForAsyncJobFunc: method is nil but KtCloudAPI.WaitForAsyncJob was just called")
+	}
+	callInfo := struct {
+		JobId   string
+		TimeOut time.Duration
+	}{
+		JobId:   jobId,
+		TimeOut: timeOut,
+	}
+	mock.lockWaitForAsyncJob.Lock()
+	mock.calls.WaitForAsyncJob = append(mock.calls.WaitForAsyncJob, callInfo)
+	mock.lockWaitForAsyncJob.Unlock()
+	return mock.WaitForAsyncJobFunc(jobId, timeOut)
+}
+
+// WaitForAsyncJobCalls gets all the calls that were made to WaitForAsyncJob.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.WaitForAsyncJobCalls())
+func (mock *KtCloudAPIMock) WaitForAsyncJobCalls() []struct {
+	JobId   string
+	TimeOut time.Duration
+} {
+	var calls []struct {
+		JobId   string
+		TimeOut time.Duration
+	}
+	mock.lockWaitForAsyncJob.RLock()
+	calls = mock.calls.WaitForAsyncJob
+	mock.lockWaitForAsyncJob.RUnlock()
+	return calls
+}
+
+// WaitForAsyncJobWithContext calls WaitForAsyncJobWithContextFunc.
+func (mock *KtCloudAPIMock) WaitForAsyncJobWithContext(ctx context.Context, jobId string, timeOut time.Duration) error {
+	if mock.WaitForAsyncJobWithContextFunc == nil {
+		panic("KtCloudAPIMock.WaitForAsyncJobWithContextFunc: method is nil but KtCloudAPI.WaitForAsyncJobWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		JobId   string
+		TimeOut time.Duration
+	}{
+		Ctx:     ctx,
+		JobId:   jobId,
+		TimeOut: timeOut,
+	}
+	mock.lockWaitForAsyncJobWithContext.Lock()
+	mock.calls.WaitForAsyncJobWithContext = append(mock.calls.WaitForAsyncJobWithContext, callInfo)
+	mock.lockWaitForAsyncJobWithContext.Unlock()
+	return mock.WaitForAsyncJobWithContextFunc(ctx, jobId, timeOut)
+}
+
+// WaitForAsyncJobWithContextCalls gets all the calls that were made to WaitForAsyncJobWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.WaitForAsyncJobWithContextCalls())
+func (mock *KtCloudAPIMock) WaitForAsyncJobWithContextCalls() []struct {
+	Ctx     context.Context
+	JobId   string
+	TimeOut time.Duration
+} {
+	var calls []struct {
+		Ctx     context.Context
+		JobId   string
+		TimeOut time.Duration
+	}
+	mock.lockWaitForAsyncJobWithContext.RLock()
+	calls = mock.calls.WaitForAsyncJobWithContext
+	mock.lockWaitForAsyncJobWithContext.RUnlock()
+	return calls
+}
+
+// WaitForVirtualMachineState calls WaitForVirtualMachineStateFunc.
+func (mock *KtCloudAPIMock) WaitForVirtualMachineState(zoneId string, vmId string, wantedState string, timeOut time.Duration) error {
+	if mock.WaitForVirtualMachineStateFunc == nil {
+		panic("KtCloudAPIMock.WaitForVirtualMachineStateFunc: method is nil but KtCloudAPI.WaitForVirtualMachineState was just called")
+	}
+	callInfo := struct {
+		ZoneId      string
+		VmId        string
+		WantedState string
+		TimeOut     time.Duration
+	}{
+		ZoneId:      zoneId,
+		VmId:        vmId,
+		WantedState: wantedState,
+		TimeOut:     timeOut,
+	}
+	mock.lockWaitForVirtualMachineState.Lock()
+	mock.calls.WaitForVirtualMachineState = append(mock.calls.WaitForVirtualMachineState, callInfo)
+	mock.lockWaitForVirtualMachineState.Unlock()
+	return mock.WaitForVirtualMachineStateFunc(zoneId, vmId, wantedState, timeOut)
+}
+
+// WaitForVirtualMachineStateCalls gets all the calls that were made to WaitForVirtualMachineState.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.WaitForVirtualMachineStateCalls())
+func (mock *KtCloudAPIMock) WaitForVirtualMachineStateCalls() []struct {
+	ZoneId      string
+	VmId        string
+	WantedState string
+	TimeOut     time.Duration
+} {
+	var calls []struct {
+		ZoneId      string
+		VmId        string
+		WantedState string
+		TimeOut     time.Duration
+	}
+	mock.lockWaitForVirtualMachineState.RLock()
+	calls = mock.calls.WaitForVirtualMachineState
+	mock.lockWaitForVirtualMachineState.RUnlock()
+	return calls
+}
+
+// WaitForVirtualMachineStateWithContext calls WaitForVirtualMachineStateWithContextFunc.
+func (mock *KtCloudAPIMock) WaitForVirtualMachineStateWithContext(ctx context.Context, zoneId string, vmId string, wantedState string, timeOut time.Duration) error {
+	if mock.WaitForVirtualMachineStateWithContextFunc == nil {
+		panic("KtCloudAPIMock.WaitForVirtualMachineStateWithContextFunc: method is nil but KtCloudAPI.WaitForVirtualMachineStateWithContext was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		ZoneId      string
+		VmId        string
+		WantedState string
+		TimeOut     time.Duration
+	}{
+		Ctx:         ctx,
+		ZoneId:      zoneId,
+		VmId:        vmId,
+		WantedState: wantedState,
+		TimeOut:     timeOut,
+	}
+	mock.lockWaitForVirtualMachineStateWithContext.Lock()
+	mock.calls.WaitForVirtualMachineStateWithContext = append(mock.calls.WaitForVirtualMachineStateWithContext, callInfo)
+	mock.lockWaitForVirtualMachineStateWithContext.Unlock()
+	return mock.WaitForVirtualMachineStateWithContextFunc(ctx, zoneId, vmId, wantedState, timeOut)
+}
+
+// WaitForVirtualMachineStateWithContextCalls gets all the calls that were made to WaitForVirtualMachineStateWithContext.
+// Check the length with:
+//
+//	len(mockedKtCloudAPI.WaitForVirtualMachineStateWithContextCalls())
+func (mock *KtCloudAPIMock) WaitForVirtualMachineStateWithContextCalls() []struct {
+	Ctx         context.Context
+	ZoneId      string
+	VmId        string
+	WantedState string
+	TimeOut     time.Duration
+} {
+	var calls []struct {
+		Ctx         context.Context
+		ZoneId      string
+		VmId        string
+		WantedState string
+		TimeOut     time.Duration
+	}
+	mock.lockWaitForVirtualMachineStateWithContext.RLock()
+	calls = mock.calls.WaitForVirtualMachineStateWithContext
+	mock.lockWaitForVirtualMachineStateWithContext.RUnlock()
+	return calls
+}
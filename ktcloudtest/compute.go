@@ -0,0 +1,260 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudtest
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+)
+
+func (s *Server) vm(id string) (*ktsdk.Virtualmachine, error) {
+	for _, vm := range s.vms {
+		if vm.ID == id {
+			return vm, nil
+		}
+	}
+	return nil, notFound("virtual machine", id)
+}
+
+func (s *Server) deployVirtualMachine(params url.Values) (interface{}, error) {
+	for _, name := range []string{"zoneid", "serviceofferingid", "templateid"} {
+		if _, err := required(params, name); err != nil {
+			return nil, err
+		}
+	}
+	zone, err := s.zone(params.Get("zoneid"))
+	if err != nil {
+		return nil, err
+	}
+
+	id := s.newId()
+	name := params.Get("name")
+	if name == "" {
+		name = "vm-" + id[:8]
+	}
+	displayName := params.Get("displayname")
+	if displayName == "" {
+		displayName = name
+	}
+	vm := &ktsdk.Virtualmachine{
+		ID:                id,
+		Name:              name,
+		DisplayName:       displayName,
+		Account:           "ktcloudtest",
+		Created:           s.timestamp(),
+		State:             ktsdk.VMStateStarting,
+		ZoneId:            zone.ID,
+		ZoneName:          zone.Name,
+		TemplateId:        params.Get("templateid"),
+		ServiceOfferingId: params.Get("serviceofferingid"),
+		CpuNumber:         1,
+		Memory:            1024,
+		Hypervisor:        "XenServer",
+		KeyPair:           params.Get("keypair"),
+		Group:             params.Get("group"),
+		Nic: []ktsdk.Nic{{
+			ID:          s.newId(),
+			NetworkId:   s.newId(),
+			Netmask:     "255.255.255.0",
+			Gateway:     "172.27.0.1",
+			IpAddress:   fmt.Sprintf("172.27.0.%d", len(s.vms)%250+2),
+			TrafficType: "Guest",
+			Type:        "Isolated",
+			IsDefault:   true,
+		}},
+	}
+	if vm.Group != "" {
+		vm.GroupId = s.groupId(vm.Group)
+	}
+	s.vms = append(s.vms, vm)
+
+	root := &ktsdk.Volume{
+		ID:         s.newId(),
+		Name:       "ROOT-" + id[:8],
+		ZoneId:     zone.ID,
+		ZoneName:   zone.Name,
+		Type:       ktsdk.VolumeTypeRoot,
+		VMId:       vm.ID,
+		VMName:     vm.Name,
+		TemplateId: vm.TemplateId,
+		Size:       20 << 30,
+		Created:    vm.Created,
+		State:      ktsdk.VolumeStateReady,
+		Account:    vm.Account,
+	}
+	s.volumes = append(s.volumes, root)
+
+	var resp ktsdk.DeployVirtualMachineResponse
+	resp.Deployvirtualmachineresponse.ID = vm.ID
+	resp.Deployvirtualmachineresponse.RootId = root.ID
+	resp.Deployvirtualmachineresponse.JobId = s.startJob("deployVirtualMachine", "VirtualMachine", vm.ID, func() error {
+		vm.State = ktsdk.VMStateRunning
+		return nil
+	}, func() {
+		vm.State = ktsdk.VMStateError
+	})
+	return resp, nil
+}
+
+func (s *Server) listVirtualMachines(params url.Values) (interface{}, error) {
+	var vms []*ktsdk.Virtualmachine
+	for _, vm := range s.vms {
+		if id := params.Get("id"); id != "" && vm.ID != id {
+			continue
+		}
+		if zoneId := params.Get("zoneid"); zoneId != "" && vm.ZoneId != zoneId {
+			continue
+		}
+		if name := params.Get("name"); name != "" && vm.Name != name {
+			continue
+		}
+		if state := params.Get("state"); state != "" && !strings.EqualFold(string(vm.State), state) {
+			continue
+		}
+		if keyword := params.Get("keyword"); keyword != "" && !strings.Contains(vm.Name, keyword) && !strings.Contains(vm.DisplayName, keyword) {
+			continue
+		}
+		if groupId := params.Get("groupid"); groupId != "" && vm.GroupId != groupId {
+			continue
+		}
+		if networkId := params.Get("networkid"); networkId != "" && !onNetwork(vm, networkId) {
+			continue
+		}
+		if !s.hasTags("userVm", vm.ID, tagArgs(params)) {
+			continue
+		}
+		vms = append(vms, vm)
+	}
+
+	var resp ktsdk.ListVirtualMachinesResponse
+	resp.Listvirtualmachinesresponse.Count = ktsdk.FlexInt(len(vms))
+	resp.Listvirtualmachinesresponse.Virtualmachine = values(paginate(vms, params))
+	return resp, nil
+}
+
+// groupId returns the ID of a VM group, the same for every VM of the group.
+func (s *Server) groupId(group string) string {
+	for _, vm := range s.vms {
+		if vm.Group == group {
+			return vm.GroupId
+		}
+	}
+	return s.newId()
+}
+
+func onNetwork(vm *ktsdk.Virtualmachine, networkId string) bool {
+	for _, nic := range vm.Nic {
+		if nic.NetworkId == networkId {
+			return true
+		}
+	}
+	return false
+}
+
+// changeVMState puts a VM in a transitional state, and in the final state when the job completes.
+func (s *Server) changeVMState(cmd string, params url.Values, transitional ktsdk.VMState, final ktsdk.VMState) (string, error) {
+	id, err := required(params, "id")
+	if err != nil {
+		return "", err
+	}
+	vm, err := s.vm(id)
+	if err != nil {
+		return "", err
+	}
+	previous := vm.State
+	vm.State = transitional
+	return s.startJob(cmd, "VirtualMachine", vm.ID, func() error {
+		vm.State = final
+		return nil
+	}, func() {
+		vm.State = previous
+	}), nil
+}
+
+func (s *Server) startVirtualMachine(params url.Values) (interface{}, error) {
+	jobId, err := s.changeVMState("startVirtualMachine", params, ktsdk.VMStateStarting, ktsdk.VMStateRunning)
+	if err != nil {
+		return nil, err
+	}
+	var resp ktsdk.StartVirtualMachineResponse
+	resp.Startvirtualmachineresponse.JobId = jobId
+	return resp, nil
+}
+
+func (s *Server) stopVirtualMachine(params url.Values) (interface{}, error) {
+	jobId, err := s.changeVMState("stopVirtualMachine", params, ktsdk.VMStateStopping, ktsdk.VMStateStopped)
+	if err != nil {
+		return nil, err
+	}
+	var resp ktsdk.StopVirtualMachineResponse
+	resp.Stopvirtualmachineresponse.JobId = jobId
+	return resp, nil
+}
+
+func (s *Server) rebootVirtualMachine(params url.Values) (interface{}, error) {
+	jobId, err := s.changeVMState("rebootVirtualMachine", params, ktsdk.VMStateStarting, ktsdk.VMStateRunning)
+	if err != nil {
+		return nil, err
+	}
+	var resp ktsdk.RebootVirtualMachineResponse
+	resp.Rebootvirtualmachineresponse.JobId = jobId
+	return resp, nil
+}
+
+// Destroying a VM deletes its ROOT volume and detaches its data volumes.
+func (s *Server) destroyVirtualMachine(params url.Values) (interface{}, error) {
+	id, err := required(params, "id")
+	if err != nil {
+		return nil, err
+	}
+	vm, err := s.vm(id)
+	if err != nil {
+		return nil, err
+	}
+	previous := vm.State
+	vm.State = ktsdk.VMStateDestroyed
+
+	var resp ktsdk.DestroyVirtualMachineResponse
+	resp.Destroyvirtualmachineresponse.JobId = s.startJob("destroyVirtualMachine", "VirtualMachine", vm.ID, func() error {
+		s.vms = remove(s.vms, func(v *ktsdk.Virtualmachine) bool { return v.ID == vm.ID })
+		s.volumes = remove(s.volumes, func(v *ktsdk.Volume) bool { return v.VMId == vm.ID && v.Type == ktsdk.VolumeTypeRoot })
+		for _, v := range s.volumes {
+			if v.VMId == vm.ID {
+				detach(v)
+			}
+		}
+		return nil
+	}, func() {
+		vm.State = previous
+	})
+	return resp, nil
+}
+
+func (s *Server) updateVirtualMachine(params url.Values) (interface{}, error) {
+	id, err := required(params, "id")
+	if err != nil {
+		return nil, err
+	}
+	vm, err := s.vm(id)
+	if err != nil {
+		return nil, err
+	}
+	if displayName := params.Get("displayname"); displayName != "" {
+		vm.DisplayName = displayName
+	}
+	if haEnable := params.Get("haenable"); haEnable != "" {
+		vm.Haenable = haEnable == "true"
+	}
+
+	var resp ktsdk.UpdateVirtualMachineResponse
+	resp.Updatevirtualmachineresponse.Virtualmachine = []ktsdk.Virtualmachine{*vm}
+	return resp, nil
+}
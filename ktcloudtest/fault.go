@@ -0,0 +1,175 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudtest
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Fault changes how the server answers the requests of a command, to exercise the failure paths of a client.
+//
+//	// Two throttled answers, then the real one.
+//	srv.InjectFault(ktcloudtest.Fault{Command: "listVirtualMachines", Times: 2, HTTPStatus: 429, RetryAfter: time.Second})
+//	// A body that isn't JSON.
+//	srv.InjectFault(ktcloudtest.Fault{Command: "listZones", Body: "<html>Bad Gateway</html>", HTTPStatus: 502})
+//	// Every call is slow.
+//	srv.InjectFault(ktcloudtest.Fault{Delay: 2 * time.Second})
+//
+// A Fault with only a Delay is answered normally once the delay has passed.
+type Fault struct {
+	Command    string        // Command to fail. Empty matches every command.
+	Times      int           // Requests the fault applies to. 0 means every matching request.
+	Delay      time.Duration // Wait before answering. The wait ends early if the client gives up.
+	HTTPStatus int           // Answer with this status and a KT Cloud error body.
+	ErrorCode  int           // 'errorcode' of the error body. Default : HTTPStatus
+	ErrorText  string        // 'errortext' of the error body.
+	RetryAfter time.Duration // Sent as the Retry-After header, in seconds.
+	Body       string        // Answer with this raw body instead, e.g. malformed JSON. Status : HTTPStatus, or 200.
+}
+
+// jobFailure makes the next jobs of a command fail.
+type jobFailure struct {
+	command   string
+	errorText string
+	remaining int // -1 means every job
+}
+
+// Adds a fault. Faults are matched in the order they were added, and a request takes the first one that matches.
+func (s *Server) InjectFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &f)
+}
+
+// Makes the next 'times' async jobs of command (0 : all of them) end with jobstatus 2 and errorText.
+// The job's resource is left as KT Cloud leaves it, e.g. a VM whose deploy failed is in 'Error' state.
+// An empty command matches every command.
+func (s *Server) FailJobs(command string, errorText string, times int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if times <= 0 {
+		times = -1
+	}
+	s.jobFailures = append(s.jobFailures, &jobFailure{command: command, errorText: errorText, remaining: times})
+}
+
+// Keeps the async jobs of command pending until ReleaseJobs(command), along with the transitional state of their resource.
+// Example) HoldJobs("deployVirtualMachine") leaves new VMs in 'Starting'.
+func (s *Server) HoldJobs(command string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.heldJobs[command] = true
+	for _, j := range s.pending {
+		if j.cmd == command {
+			j.held = true
+		}
+	}
+}
+
+// Lets the held async jobs of command, and its later jobs, complete.
+func (s *Server) ReleaseJobs(command string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.heldJobs, command)
+	for _, j := range s.pending {
+		if j.cmd == command {
+			j.held = false
+		}
+	}
+}
+
+// Removes every fault, job failure and job hold. Held jobs are released.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+	s.jobFailures = nil
+	s.heldJobs = make(map[string]bool)
+	for _, j := range s.pending {
+		j.held = false
+	}
+}
+
+// takeFault returns the fault to apply to a request of command, if any.
+func (s *Server) takeFault(command string) (Fault, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, f := range s.faults {
+		if f.Command != "" && f.Command != command {
+			continue
+		}
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+			}
+		}
+		return *f, true
+	}
+	return Fault{}, false
+}
+
+// takeJobFailure returns the error text of a new job of command that must fail. s.mu must be held.
+func (s *Server) takeJobFailure(command string) (string, bool) {
+	for i, f := range s.jobFailures {
+		if f.command != "" && f.command != command {
+			continue
+		}
+		if f.remaining > 0 {
+			f.remaining--
+			if f.remaining == 0 {
+				s.jobFailures = append(s.jobFailures[:i:i], s.jobFailures[i+1:]...)
+			}
+		}
+		return f.errorText, true
+	}
+	return "", false
+}
+
+// apply answers a request with the fault. It returns false when the request must still be served normally.
+func (f Fault) apply(w http.ResponseWriter, r *http.Request, command string) bool {
+	if f.Delay > 0 {
+		t := time.NewTimer(f.Delay)
+		defer t.Stop()
+		select {
+		case <-r.Context().Done():
+			return true
+		case <-t.C:
+		}
+	}
+	if f.Body == "" && f.HTTPStatus == 0 {
+		return false
+	}
+
+	if f.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(f.RetryAfter.Seconds())))
+	}
+	status := f.HTTPStatus
+	if f.Body != "" {
+		if status == 0 {
+			status = http.StatusOK
+		}
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		w.WriteHeader(status)
+		w.Write([]byte(f.Body))
+		return true
+	}
+
+	code := f.ErrorCode
+	if code == 0 {
+		code = status
+	}
+	text := f.ErrorText
+	if text == "" {
+		text = http.StatusText(status)
+	}
+	writeJSON(w, status, errorBody(command, code, text))
+	return true
+}
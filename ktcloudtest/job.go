@@ -0,0 +1,125 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudtest
+
+import (
+	"errors"
+	"net/url"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+)
+
+// Job status values of 'queryAsyncJobResult'.
+const (
+	jobPending   = 0
+	jobSucceeded = 1
+	jobFailed    = 2
+)
+
+// job is an async job. Its effect on the resources is applied by complete() when the job succeeds,
+// and rollback() (if any) undoes its transitional state when the job fails.
+// Pending jobs advance by one step on every request the server handles, so resources progress
+// whether a client polls the job or the resources themselves.
+type job struct {
+	id           string
+	cmd          string
+	instanceType string
+	instanceId   string
+	created      ktsdk.Timestamp
+
+	pendingSteps int
+	held         bool // Stays pending until released. See HoldJobs().
+	status       int
+	result       ktsdk.JobResult
+	complete     func() error
+	rollback     func()
+}
+
+// startJob registers a job and returns its ID. complete() and rollback() run with s.mu held,
+// and an error returned by complete() fails the job with its text.
+func (s *Server) startJob(cmd string, instanceType string, instanceId string, complete func() error, rollback func()) string {
+	j := &job{
+		id:           s.newId(),
+		cmd:          cmd,
+		instanceType: instanceType,
+		instanceId:   instanceId,
+		created:      s.timestamp(),
+		pendingSteps: s.jobSteps,
+		complete:     complete,
+		rollback:     rollback,
+	}
+	j.held = s.heldJobs[cmd]
+	if text, ok := s.takeJobFailure(cmd); ok {
+		j.complete = func() error { return errors.New(text) }
+	}
+	s.jobs[j.id] = j
+	s.pending = append(s.pending, j)
+	return j.id
+}
+
+// advanceJobs moves every pending job one step forward. s.mu must be held.
+func (s *Server) advanceJobs() {
+	pending := s.pending
+	s.pending = nil
+	for _, j := range pending {
+		j.step()
+		if j.status == jobPending {
+			s.pending = append(s.pending, j)
+		}
+	}
+}
+
+func (j *job) step() {
+	if j.held {
+		return
+	}
+	if j.pendingSteps > 0 {
+		j.pendingSteps--
+		return
+	}
+	if err := j.complete(); err != nil {
+		j.status = jobFailed
+		j.result = ktsdk.JobResult{ErrorCode: ktsdk.ErrCodeInternalError, CSErrorCode: 4250, ErrorText: err.Error()}
+		if j.rollback != nil {
+			j.rollback()
+		}
+		return
+	}
+	j.status = jobSucceeded
+}
+
+func (s *Server) queryAsyncJobResult(params url.Values) (interface{}, error) {
+	jobId, err := required(params, "jobid")
+	if err != nil {
+		return nil, err
+	}
+	j, ok := s.jobs[jobId]
+	if !ok {
+		return nil, notFound("async job", jobId)
+	}
+
+	var resp ktsdk.QueryAsyncJobResultResponse
+	r := &resp.Queryasyncjobresultresponse
+	r.AccountId = "ktcloudtest"
+	r.UserId = "ktcloudtest"
+	r.Cmd = j.cmd
+	r.JobStatus = ktsdk.FlexInt(j.status)
+	r.JobInstanceType = j.instanceType
+	r.JobInstanceId = j.instanceId
+	r.Created = j.created
+	r.JobId = j.id
+	switch j.status {
+	case jobSucceeded:
+		r.JobResultType = "object"
+	case jobFailed:
+		r.JobResultCode = j.result.ErrorCode
+		r.JobResultType = "object"
+		r.JobResult = j.result
+	}
+	return resp, nil
+}
@@ -0,0 +1,319 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudtest
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+)
+
+func (s *Server) publicIp(id string) (*ktsdk.PublicIpAddress, error) {
+	for _, ip := range s.publicIps {
+		if ip.ID == id {
+			return ip, nil
+		}
+	}
+	return nil, notFound("public IP address", id)
+}
+
+func (s *Server) associateIpAddress(params url.Values) (interface{}, error) {
+	zoneId, err := required(params, "zoneid")
+	if err != nil {
+		return nil, err
+	}
+	zone, err := s.zone(zoneId)
+	if err != nil {
+		return nil, err
+	}
+	usagePlanType := ktsdk.UsagePlanType(params.Get("usageplantype"))
+	if usagePlanType == "" {
+		usagePlanType = ktsdk.UsagePlanTypeHourly
+	}
+
+	n := s.nextId
+	ip := &ktsdk.PublicIpAddress{
+		ID:                s.newId(),
+		IpAddress:         fmt.Sprintf("211.252.%d.%d", n/250%250, n%250+1),
+		Allocated:         s.timestamp(),
+		ZoneId:            zone.ID,
+		ZoneName:          zone.Name,
+		Account:           "ktcloudtest",
+		ForVirtualNetwork: true,
+		State:             "Allocating",
+		UsagePlanType:     usagePlanType,
+	}
+	s.publicIps = append(s.publicIps, ip)
+
+	var resp ktsdk.AssociateIpAddressResponse
+	resp.Associateipaddressresponse.ID = ip.ID
+	resp.Associateipaddressresponse.JobId = s.startJob("associateIpAddress", "IpAddress", ip.ID, func() error {
+		ip.State = "Allocated"
+		return nil
+	}, func() {
+		s.publicIps = remove(s.publicIps, func(p *ktsdk.PublicIpAddress) bool { return p.ID == ip.ID })
+	})
+	return resp, nil
+}
+
+func (s *Server) listPublicIpAddresses(params url.Values) (interface{}, error) {
+	var ips []*ktsdk.PublicIpAddress
+	for _, ip := range s.publicIps {
+		if id := params.Get("id"); id != "" && ip.ID != id {
+			continue
+		}
+		if zoneId := params.Get("zoneid"); zoneId != "" && ip.ZoneId != zoneId {
+			continue
+		}
+		if address := params.Get("ipaddress"); address != "" && ip.IpAddress != address {
+			continue
+		}
+		if keyword := params.Get("keyword"); keyword != "" && !strings.Contains(ip.IpAddress, keyword) {
+			continue
+		}
+		ips = append(ips, ip)
+	}
+
+	var resp ktsdk.ListPublicIpAddressesResponse
+	resp.Listpublicipaddressesresponse.Count = ktsdk.FlexInt(len(ips))
+	resp.Listpublicipaddressesresponse.PublicIpAddress = values(paginate(ips, params))
+	return resp, nil
+}
+
+// Releasing a public IP deletes its firewall and port forwarding rules too.
+func (s *Server) disassociateIpAddress(params url.Values) (interface{}, error) {
+	id, err := required(params, "id")
+	if err != nil {
+		return nil, err
+	}
+	ip, err := s.publicIp(id)
+	if err != nil {
+		return nil, err
+	}
+	ip.State = "Releasing"
+
+	var resp ktsdk.DisassociateIpAddressResponse
+	resp.Disassociateipaddressresponse.JobId = s.startJob("disassociateIpAddress", "IpAddress", ip.ID, func() error {
+		s.publicIps = remove(s.publicIps, func(p *ktsdk.PublicIpAddress) bool { return p.ID == ip.ID })
+		s.firewall = remove(s.firewall, func(r *ktsdk.FirewallRule) bool { return r.IpAddressId == ip.ID })
+		s.portForwards = remove(s.portForwards, func(r *ktsdk.PortForwardingRule) bool { return r.IpAddressId == ip.ID })
+		return nil
+	}, func() {
+		ip.State = "Allocated"
+	})
+	return resp, nil
+}
+
+func (s *Server) createFirewallRule(params url.Values) (interface{}, error) {
+	ipId, err := required(params, "ipaddressid")
+	if err != nil {
+		return nil, err
+	}
+	ip, err := s.publicIp(ipId)
+	if err != nil {
+		return nil, err
+	}
+	protocol, err := required(params, "protocol")
+	if err != nil {
+		return nil, err
+	}
+	var startPort, endPort ktsdk.FlexInt
+	if !strings.EqualFold(protocol, "icmp") {
+		if startPort, err = portParam(params, "startport"); err != nil {
+			return nil, err
+		}
+		if endPort, err = portParam(params, "endport"); err != nil {
+			return nil, err
+		}
+		if endPort == 0 {
+			endPort = startPort
+		}
+	}
+	cidrList := params.Get("cidrlist")
+	if cidrList == "" {
+		cidrList = "0.0.0.0/0"
+	}
+
+	rule := &ktsdk.FirewallRule{
+		ID:          s.newId(),
+		Protocol:    ktsdk.Protocol(strings.ToLower(protocol)),
+		StartPort:   startPort,
+		EndPort:     endPort,
+		IpAddressId: ip.ID,
+		IpAddress:   ip.IpAddress,
+		State:       "Add",
+		CidrList:    cidrList,
+		ForDisplay:  true,
+	}
+	s.firewall = append(s.firewall, rule)
+
+	var resp ktsdk.CreateFirewallRuleResponse
+	resp.Createfirewallruleresponse.ID = rule.ID
+	resp.Createfirewallruleresponse.JobId = s.startJob("createFirewallRule", "FirewallRule", rule.ID, func() error {
+		rule.State = "Active"
+		return nil
+	}, func() {
+		s.firewall = remove(s.firewall, func(r *ktsdk.FirewallRule) bool { return r.ID == rule.ID })
+	})
+	return resp, nil
+}
+
+func (s *Server) listFirewallRules(params url.Values) (interface{}, error) {
+	var rules []*ktsdk.FirewallRule
+	for _, rule := range s.firewall {
+		if id := params.Get("id"); id != "" && rule.ID != id {
+			continue
+		}
+		if ipId := params.Get("ipaddressid"); ipId != "" && rule.IpAddressId != ipId {
+			continue
+		}
+		rules = append(rules, rule)
+	}
+
+	var resp ktsdk.ListFirewallRulesResponse
+	resp.Listfirewallrulesresponse.Count = ktsdk.FlexInt(len(rules))
+	resp.Listfirewallrulesresponse.FirewallRule = values(paginate(rules, params))
+	return resp, nil
+}
+
+func (s *Server) deleteFirewallRule(params url.Values) (interface{}, error) {
+	id, err := required(params, "id")
+	if err != nil {
+		return nil, err
+	}
+	var rule *ktsdk.FirewallRule
+	for _, r := range s.firewall {
+		if r.ID == id {
+			rule = r
+		}
+	}
+	if rule == nil {
+		return nil, notFound("firewall rule", id)
+	}
+	rule.State = "Revoke"
+
+	var resp ktsdk.DeleteFirewallRuleResponse
+	resp.Deletefirewallruleresponse.JobId = s.startJob("deleteFirewallRule", "FirewallRule", id, func() error {
+		s.firewall = remove(s.firewall, func(r *ktsdk.FirewallRule) bool { return r.ID == id })
+		return nil
+	}, func() {
+		rule.State = "Active"
+	})
+	return resp, nil
+}
+
+func (s *Server) createPortForwardingRule(params url.Values) (interface{}, error) {
+	for _, name := range []string{"ipaddressid", "privateport", "protocol", "publicport", "virtualmachineid"} {
+		if _, err := required(params, name); err != nil {
+			return nil, err
+		}
+	}
+	ip, err := s.publicIp(params.Get("ipaddressid"))
+	if err != nil {
+		return nil, err
+	}
+	vm, err := s.vm(params.Get("virtualmachineid"))
+	if err != nil {
+		return nil, err
+	}
+	var ports [4]ktsdk.FlexInt
+	for i, name := range []string{"privateport", "privateendport", "publicport", "publicendport"} {
+		if ports[i], err = portParam(params, name); err != nil {
+			return nil, err
+		}
+	}
+	for _, rule := range s.portForwards {
+		if rule.IpAddressId == ip.ID && rule.PublicPort == ports[2] && strings.EqualFold(string(rule.Protocol), params.Get("protocol")) {
+			return nil, errorf(ktsdk.ErrCodeNetworkRuleConflict, "The range specified, %d, conflicts with rule %s", rule.PublicPort, rule.ID)
+		}
+	}
+
+	rule := &ktsdk.PortForwardingRule{
+		ID:                        s.newId(),
+		PrivatePort:               ports[0],
+		PrivateEndPort:            ports[1],
+		Protocol:                  ktsdk.Protocol(strings.ToLower(params.Get("protocol"))),
+		PublicPort:                ports[2],
+		PublicEndPort:             ports[3],
+		VirtualmachineId:          vm.ID,
+		VirtualmachineName:        vm.Name,
+		VirtualmachineDisplayName: vm.DisplayName,
+		IpAddressId:               ip.ID,
+		IpAddress:                 ip.IpAddress,
+		State:                     "Add",
+		CidrList:                  "0.0.0.0/0",
+		ForDisplay:                true,
+	}
+	if rule.PrivateEndPort == 0 {
+		rule.PrivateEndPort = rule.PrivatePort
+	}
+	if rule.PublicEndPort == 0 {
+		rule.PublicEndPort = rule.PublicPort
+	}
+	if len(vm.Nic) > 0 {
+		rule.VmGuestIp = vm.Nic[0].IpAddress
+		rule.NetworkId = vm.Nic[0].NetworkId
+	}
+	s.portForwards = append(s.portForwards, rule)
+
+	var resp ktsdk.CreatePortForwardingRuleResponse
+	resp.Createportforwardingruleresponse.ID = rule.ID
+	resp.Createportforwardingruleresponse.JobId = s.startJob("createPortForwardingRule", "PortForwardingRule", rule.ID, func() error {
+		rule.State = "Active"
+		return nil
+	}, func() {
+		s.portForwards = remove(s.portForwards, func(r *ktsdk.PortForwardingRule) bool { return r.ID == rule.ID })
+	})
+	return resp, nil
+}
+
+func (s *Server) listPortForwardingRules(params url.Values) (interface{}, error) {
+	var rules []*ktsdk.PortForwardingRule
+	for _, rule := range s.portForwards {
+		if id := params.Get("id"); id != "" && rule.ID != id {
+			continue
+		}
+		if ipId := params.Get("ipaddressid"); ipId != "" && rule.IpAddressId != ipId {
+			continue
+		}
+		rules = append(rules, rule)
+	}
+
+	var resp ktsdk.ListPortForwardingRulesResponse
+	resp.Listportforwardingrulesresponse.Count = ktsdk.FlexInt(len(rules))
+	resp.Listportforwardingrulesresponse.PortForwardingRule = values(paginate(rules, params))
+	return resp, nil
+}
+
+func (s *Server) deletePortForwardingRule(params url.Values) (interface{}, error) {
+	id, err := required(params, "id")
+	if err != nil {
+		return nil, err
+	}
+	var rule *ktsdk.PortForwardingRule
+	for _, r := range s.portForwards {
+		if r.ID == id {
+			rule = r
+		}
+	}
+	if rule == nil {
+		return nil, notFound("port forwarding rule", id)
+	}
+	rule.State = "Revoke"
+
+	var resp ktsdk.DeletePortForwardingRuleResponse
+	resp.Deleteportforwardingruleresponse.JobId = s.startJob("deletePortForwardingRule", "PortForwardingRule", id, func() error {
+		s.portForwards = remove(s.portForwards, func(r *ktsdk.PortForwardingRule) bool { return r.ID == id })
+		return nil
+	}, func() {
+		rule.State = "Active"
+	})
+	return resp, nil
+}
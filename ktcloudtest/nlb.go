@@ -0,0 +1,203 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudtest
+
+import (
+	"net/url"
+	"strconv"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+)
+
+// NLB IDs and web server (service) IDs are numbers.
+func (s *Server) newNumber() ktsdk.FlexString {
+	s.nextId++
+	return ktsdk.FlexString(strconv.Itoa(s.serial*1000000 + s.nextId))
+}
+
+func (s *Server) nlb(id string) (*ktsdk.NLB, error) {
+	for _, nlb := range s.nlbs {
+		if nlb.NLBId.String() == id {
+			return nlb, nil
+		}
+	}
+	return nil, notFound("load balancer", id)
+}
+
+func (s *Server) createLoadBalancer(params url.Values) (interface{}, error) {
+	for _, name := range []string{"name", "zoneid", "loadbalanceroption", "serviceip", "serviceport", "servicetype", "healthchecktype"} {
+		if _, err := required(params, name); err != nil {
+			return nil, err
+		}
+	}
+	zone, err := s.zone(params.Get("zoneid"))
+	if err != nil {
+		return nil, err
+	}
+
+	servicePort, err := portParam(params, "serviceport")
+	if err != nil {
+		return nil, err
+	}
+
+	nlb := &ktsdk.NLB{
+		CipherGroupName: params.Get("ciphergroupname"),
+		HealthCheckType: ktsdk.HealthCheckType(params.Get("healthchecktype")),
+		HealthCheckURL:  params.Get("healthcheckurl"),
+		NLBId:           s.newNumber(),
+		NLBOption:       ktsdk.NLBOption(params.Get("loadbalanceroption")),
+		Name:            params.Get("name"),
+		NetworkId:       params.Get("networkid"),
+		ServiceIP:       params.Get("serviceip"),
+		ServicePort:     servicePort,
+		ServiceType:     ktsdk.ServiceType(params.Get("servicetype")),
+		Sslv3:           params.Get("sslv3"),
+		State:           "Active",
+		Tlsv1:           params.Get("tlsv1"),
+		Tlsv11:          params.Get("tlsv11"),
+		Tlsv12:          params.Get("tlsv12"),
+		ZoneId:          zone.ID,
+		ZoneName:        zone.Name,
+	}
+	s.nlbs = append(s.nlbs, nlb)
+
+	var resp ktsdk.CreateNLBResponse
+	r := &resp.Createnlbresponse
+	r.NLBId = nlb.NLBId
+	r.ZoneId = nlb.ZoneId
+	r.ZoneName = nlb.ZoneName
+	r.ServiceIP = nlb.ServiceIP
+	r.ServicePort = nlb.ServicePort
+	r.ServiceType = nlb.ServiceType
+	r.Name = nlb.Name
+	r.NLBOption = nlb.NLBOption
+	r.HealthCheckType = nlb.HealthCheckType
+	r.HealthCheckURL = nlb.HealthCheckURL
+	return resp, nil
+}
+
+func (s *Server) listLoadBalancers(params url.Values) (interface{}, error) {
+	var nlbs []*ktsdk.NLB
+	for _, nlb := range s.nlbs {
+		if id := params.Get("loadbalancerid"); id != "" && nlb.NLBId.String() != id {
+			continue
+		}
+		if name := params.Get("name"); name != "" && nlb.Name != name {
+			continue
+		}
+		if zoneId := params.Get("zoneid"); zoneId != "" && nlb.ZoneId != zoneId {
+			continue
+		}
+		if serviceIP := params.Get("serviceip"); serviceIP != "" && nlb.ServiceIP != serviceIP {
+			continue
+		}
+		nlbs = append(nlbs, nlb)
+	}
+
+	var resp ktsdk.ListNLBsResponse
+	resp.Listnlbsresponse.Count = ktsdk.FlexInt(len(nlbs))
+	resp.Listnlbsresponse.NLB = values(nlbs)
+	return resp, nil
+}
+
+// Deleting a Load-Balancer removes its web servers too.
+func (s *Server) deleteLoadBalancer(params url.Values) (interface{}, error) {
+	id, err := required(params, "loadbalancerid")
+	if err != nil {
+		return nil, err
+	}
+	nlb, err := s.nlb(id)
+	if err != nil {
+		return nil, err
+	}
+	s.nlbs = remove(s.nlbs, func(n *ktsdk.NLB) bool { return n.NLBId == nlb.NLBId })
+	s.nlbVMs = remove(s.nlbVMs, func(v *ktsdk.NLBVM) bool { return v.NLBId == nlb.NLBId })
+
+	var resp ktsdk.DeleteNLBResponse
+	resp.Deletenlbresponse.Success = true
+	resp.Deletenlbresponse.Displaytext = "Load balancer " + id + " is deleted."
+	return resp, nil
+}
+
+func (s *Server) addLoadBalancerWebServer(params url.Values) (interface{}, error) {
+	for _, name := range []string{"loadbalancerid", "virtualmachineid", "ipaddress", "publicport"} {
+		if _, err := required(params, name); err != nil {
+			return nil, err
+		}
+	}
+	nlb, err := s.nlb(params.Get("loadbalancerid"))
+	if err != nil {
+		return nil, err
+	}
+	vm, err := s.vm(params.Get("virtualmachineid"))
+	if err != nil {
+		return nil, err
+	}
+
+	publicPort, err := portParam(params, "publicport")
+	if err != nil {
+		return nil, err
+	}
+
+	webServer := &ktsdk.NLBVM{
+		NLBId:      nlb.NLBId,
+		ServiceId:  s.newNumber(),
+		VMId:       vm.ID,
+		IPAddress:  params.Get("ipaddress"),
+		PublicPort: publicPort,
+		State:      "UP",
+	}
+	s.nlbVMs = append(s.nlbVMs, webServer)
+
+	var resp ktsdk.AddNLBVMResponse
+	r := &resp.Addnlbvmresponse
+	r.ServiceId = webServer.ServiceId
+	r.NLBId = nlb.NLBId
+	r.VMId = webServer.VMId
+	r.IpAddress = webServer.IPAddress
+	r.PublicPort = webServer.PublicPort
+	return resp, nil
+}
+
+func (s *Server) listLoadBalancerWebServers(params url.Values) (interface{}, error) {
+	id, err := required(params, "loadbalancerid")
+	if err != nil {
+		return nil, err
+	}
+	nlb, err := s.nlb(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp ktsdk.ListNLBVMsResponse
+	r := &resp.Listnlbvmsresponse
+	for _, webServer := range s.nlbVMs {
+		if webServer.NLBId == nlb.NLBId {
+			r.NLBVM = append(r.NLBVM, *webServer)
+		}
+	}
+	r.Count = ktsdk.FlexInt(len(r.NLBVM))
+	return resp, nil
+}
+
+func (s *Server) removeLoadBalancerWebServer(params url.Values) (interface{}, error) {
+	id, err := required(params, "serviceid")
+	if err != nil {
+		return nil, err
+	}
+	n := len(s.nlbVMs)
+	s.nlbVMs = remove(s.nlbVMs, func(v *ktsdk.NLBVM) bool { return v.ServiceId.String() == id })
+	if len(s.nlbVMs) == n {
+		return nil, notFound("load balancer web server", id)
+	}
+
+	var resp ktsdk.RemoveNLBVMResponse
+	resp.Removenlbvmresponse.Success = true
+	resp.Removenlbvmresponse.Displaytext = "Web server " + id + " is removed."
+	return resp, nil
+}
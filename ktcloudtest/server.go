@@ -0,0 +1,420 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+// Package ktcloudtest provides an in-memory fake of the KT Cloud API, served by an httptest.Server,
+// so that code built on the SDK can unit-test its provisioning flows without a KT Cloud account.
+//
+//	srv := ktcloudtest.NewServer()
+//	defer srv.Close()
+//
+//	client := srv.Client()
+//	resp, err := client.DeployVirtualMachine(ktsdk.DeployVMReqInfo{ZoneId: ktcloudtest.ZoneSeoulM, ...})
+//	err = client.WaitForAsyncJob(resp.Deployvirtualmachineresponse.JobId, time.Minute)
+//
+// The fake speaks the same command / response JSON as KT Cloud for zones, product types, VMs, volumes,
+// public IPs, firewall rules, port forwarding rules, Load-Balancers, templates, tags and SSH keypairs.
+// Mutating commands start async jobs that report their progress through 'queryAsyncJobResult'.
+// Faults (HTTP errors, error bodies, malformed JSON, delays, failed or stuck jobs) can be injected
+// to exercise retries, waiters and rollback logic. See Fault.
+package ktcloudtest
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+)
+
+// Keys accepted by a Server unless changed.
+const (
+	DefaultAPIKey    = "ktcloudtest-api-key"
+	DefaultSecretKey = "ktcloudtest-secret-key"
+)
+
+// Zones served by a new Server.
+const (
+	ZoneSeoulM   = "95e2f517-d64a-4866-8585-5177c256f7c7" // 'KOR-Seoul M'
+	ZoneCentralA = "eceb5d65-6571-4696-875f-5a17949f3317" // 'KOR-Central A'
+)
+
+// Server is a fake KT Cloud API endpoint. Its state lives in memory and is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	APIKey    string
+	SecretKey string
+
+	// CheckSignature makes the server reject requests whose signature doesn't match SecretKey. (Default : true)
+	CheckSignature bool
+
+	mu       sync.Mutex
+	handlers map[string]handlerFunc
+	serial   int // Sequence number of the server, so that servers don't hand out the same IDs
+	nextId   int
+	now      func() time.Time
+
+	// Requests a job stays pending for, after the one that started it.
+	jobSteps int
+
+	zones        []ktsdk.Zone
+	productTypes []ktsdk.ProductTypes
+	vms          []*ktsdk.Virtualmachine
+	volumes      []*ktsdk.Volume
+	publicIps    []*ktsdk.PublicIpAddress
+	firewall     []*ktsdk.FirewallRule
+	portForwards []*ktsdk.PortForwardingRule
+	nlbs         []*ktsdk.NLB
+	nlbVMs       []*ktsdk.NLBVM
+	templates    []*ktsdk.Template
+	tags         []ktsdk.Tag
+	keyPairs     []*ktsdk.KeyPair
+	jobs         map[string]*job
+	pending      []*job
+
+	// Fault injection. See fault.go
+	faults      []*Fault
+	jobFailures []*jobFailure
+	heldJobs    map[string]bool
+}
+
+// handlerFunc serves a command. It returns the value to encode as the response body,
+// or an *apiError. s.mu is held while it runs.
+type handlerFunc func(s *Server, params url.Values) (interface{}, error)
+
+// apiError is written as a KT Cloud error body, with the error code as HTTP status.
+type apiError struct {
+	code int
+	text string
+}
+
+func (e *apiError) Error() string {
+	return e.text
+}
+
+func errorf(code int, format string, args ...interface{}) error {
+	return &apiError{code: code, text: fmt.Sprintf(format, args...)}
+}
+
+// Number of the Servers created so far.
+var servers atomic.Int64
+
+// Creates and starts a Server with two zones and a few product types. Close() it when done.
+func NewServer() *Server {
+	s := &Server{
+		serial:         int(servers.Add(1) - 1),
+		APIKey:         DefaultAPIKey,
+		SecretKey:      DefaultSecretKey,
+		CheckSignature: true,
+		now:            time.Now,
+		jobs:           make(map[string]*job),
+		heldJobs:       make(map[string]bool),
+	}
+	s.handlers = s.commandHandlers()
+	s.seed()
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Returns a client of the SDK talking to this server.
+func (s *Server) Client(opts ...ktsdk.ClientOption) *ktsdk.KtCloudClient {
+	opts = append([]ktsdk.ClientOption{ktsdk.WithHTTPClient(s.Server.Client())}, opts...)
+	return ktsdk.KtCloudClient{}.New(s.URL, s.APIKey, s.SecretKey, false, opts...)
+}
+
+// Sets how many requests, after the one that started it, an async job stays pending for. (Default : 0)
+// With 0, a job completes as soon as the server handles another request, e.g. the first 'queryAsyncJobResult'.
+func (s *Server) SetJobSteps(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jobSteps = n
+}
+
+// Adds a zone, with one product type, to the server.
+func (s *Server) AddZone(zone ktsdk.Zone) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.addZone(zone)
+}
+
+func (s *Server) seed() {
+	s.addZone(ktsdk.Zone{ID: ZoneSeoulM, Name: "KOR-Seoul M", NetworkType: "Advanced", AllocationState: "Enabled"})
+	s.addZone(ktsdk.Zone{ID: ZoneCentralA, Name: "KOR-Central A", NetworkType: "Advanced", AllocationState: "Enabled"})
+}
+
+func (s *Server) addZone(zone ktsdk.Zone) {
+	s.zones = append(s.zones, zone)
+	s.productTypes = append(s.productTypes, ktsdk.ProductTypes{
+		DiskOfferingDesc:    "100GB",
+		DiskOfferingId:      s.newId(),
+		Product:             "Ubuntu 22.04 64bit / 1vCore 1GB",
+		ProductId:           s.newId(),
+		ProductState:        "available",
+		ServiceOfferingDesc: "1vCore 1GB",
+		ServiceOfferingId:   s.newId(),
+		TemplateDesc:        "Ubuntu 22.04 64bit",
+		TemplateId:          s.newId(),
+		ZoneDesc:            zone.Name,
+		ZoneId:              zone.ID,
+	})
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, errorBody("", http.StatusBadRequest, err.Error()))
+		return
+	}
+	params := r.Form
+	command := params.Get("command")
+
+	if fault, ok := s.takeFault(command); ok && fault.apply(w, r, command) {
+		return
+	}
+
+	if err := s.authenticate(params); err != nil {
+		writeJSON(w, http.StatusUnauthorized, errorBody(command, ktsdk.ErrCodeUnauthorized, err.Error()))
+		return
+	}
+
+	s.mu.Lock()
+	s.advanceJobs()
+	handler, ok := s.handlers[command]
+	var result interface{}
+	var err error
+	if ok {
+		result, err = handler(s, params)
+	} else {
+		err = errorf(ktsdk.ErrCodeUnsupportedAction, "The given command '%s' does not exist or it is not available for user", command)
+	}
+	s.mu.Unlock()
+
+	if err != nil {
+		apiErr, ok := err.(*apiError)
+		if !ok {
+			apiErr = &apiError{code: ktsdk.ErrCodeInternalError, text: err.Error()}
+		}
+		writeJSON(w, apiErr.code, errorBody(command, apiErr.code, apiErr.text))
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// authenticate checks the apikey, the signature and, with signature version 3, the expiry of a request.
+func (s *Server) authenticate(params url.Values) error {
+	if params.Get("apikey") != s.APIKey {
+		return fmt.Errorf("unable to verify user credentials and/or request signature")
+	}
+	if !s.CheckSignature {
+		return nil
+	}
+
+	signature := params.Get("signature")
+	unsigned := url.Values{}
+	for key, values := range params {
+		if key != "signature" {
+			unsigned[key] = values
+		}
+	}
+	s2 := strings.Replace(unsigned.Encode(), "+", "%20", -1)
+	s3 := strings.ToLower(strings.Replace(strings.Replace(s2, "%5B", "[", -1), "%5D", "]", -1))
+	mac := hmac.New(sha1.New, []byte(s.SecretKey))
+	mac.Write([]byte(s3))
+	if !hmac.Equal([]byte(base64.StdEncoding.EncodeToString(mac.Sum(nil))), []byte(signature)) {
+		return fmt.Errorf("unable to verify user credentials and/or request signature")
+	}
+
+	if params.Get("signatureversion") == "3" {
+		expires, err := time.Parse(ktsdk.TimestampLayout, params.Get("expires"))
+		if err != nil || s.now().After(expires) {
+			return fmt.Errorf("request expired")
+		}
+	}
+	return nil
+}
+
+func errorBody(command string, code int, text string) map[string]interface{} {
+	key := strings.ToLower(command) + "response"
+	if command == "" {
+		key = "errorresponse"
+	}
+	return map[string]interface{}{
+		key: map[string]interface{}{
+			"errorcode":   code,
+			"cserrorcode": 9999,
+			"errortext":   text,
+		},
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// newId returns a new UUID-like ID. s.mu must be held, or the server must not be serving yet.
+func (s *Server) newId() string {
+	s.nextId++
+	return fmt.Sprintf("%08x-%04x-4000-8000-%012x", s.nextId, s.serial, s.nextId)
+}
+
+// timestamp is the current time, to the second as in KT Cloud responses.
+func (s *Server) timestamp() ktsdk.Timestamp {
+	return ktsdk.Timestamp{Time: s.now().Truncate(time.Second)}
+}
+
+// required returns the param, or an error naming it when it is empty.
+func required(params url.Values, name string) (string, error) {
+	v := params.Get(name)
+	if v == "" {
+		return "", errorf(ktsdk.ErrCodeParamError, "Unable to execute API command due to missing parameter %s", name)
+	}
+	return v, nil
+}
+
+// portParam returns 0 when the port isn't given.
+func portParam(params url.Values, name string) (ktsdk.FlexInt, error) {
+	v := params.Get(name)
+	if v == "" {
+		return 0, nil
+	}
+	port, err := strconv.Atoi(v)
+	if err != nil || port < 1 || port > 65535 {
+		return 0, errorf(ktsdk.ErrCodeMalformedParameter, "Invalid %s '%s'", name, v)
+	}
+	return ktsdk.FlexInt(port), nil
+}
+
+func notFound(kind string, id string) error {
+	return errorf(ktsdk.ErrCodeParamError, "Unable to find %s with specified id %s", kind, id)
+}
+
+func (s *Server) zone(id string) (ktsdk.Zone, error) {
+	for _, zone := range s.zones {
+		if zone.ID == id {
+			return zone, nil
+		}
+	}
+	return ktsdk.Zone{}, notFound("zone", id)
+}
+
+// paginate applies the 'page' / 'pagesize' params to items. Without 'pagesize', every item is returned.
+func paginate[T any](items []T, params url.Values) []T {
+	size, _ := strconv.Atoi(params.Get("pagesize"))
+	if size <= 0 {
+		return items
+	}
+	page, _ := strconv.Atoi(params.Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	start := (page - 1) * size
+	if start >= len(items) {
+		return nil
+	}
+	end := start + size
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[start:end]
+}
+
+// values turns a slice of pointers into a slice of values for a response.
+func values[T any](items []*T) []T {
+	out := make([]T, 0, len(items))
+	for _, item := range items {
+		out = append(out, *item)
+	}
+	return out
+}
+
+// remove drops the items matching del.
+func remove[T any](items []*T, del func(*T) bool) []*T {
+	out := items[:0]
+	for _, item := range items {
+		if !del(item) {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+func (s *Server) commandHandlers() map[string]handlerFunc {
+	return map[string]handlerFunc{
+		// Zone, Product Type
+		"listZones":                 (*Server).listZones,
+		"listAvailableProductTypes": (*Server).listAvailableProductTypes,
+
+		// AsyncJob
+		"queryAsyncJobResult": (*Server).queryAsyncJobResult,
+
+		// Virtual Machine (Server)
+		"deployVirtualMachine":  (*Server).deployVirtualMachine,
+		"listVirtualMachines":   (*Server).listVirtualMachines,
+		"startVirtualMachine":   (*Server).startVirtualMachine,
+		"stopVirtualMachine":    (*Server).stopVirtualMachine,
+		"rebootVirtualMachine":  (*Server).rebootVirtualMachine,
+		"destroyVirtualMachine": (*Server).destroyVirtualMachine,
+		"updateVirtualMachine":  (*Server).updateVirtualMachine,
+
+		// Disk Volume
+		"createVolume": (*Server).createVolume,
+		"listVolumes":  (*Server).listVolumes,
+		"resizeVolume": (*Server).resizeVolume,
+		"deleteVolume": (*Server).deleteVolume,
+		"attachVolume": (*Server).attachVolume,
+		"detachVolume": (*Server).detachVolume,
+
+		// Public IP
+		"associateIpAddress":    (*Server).associateIpAddress,
+		"listPublicIpAddresses": (*Server).listPublicIpAddresses,
+		"disassociateIpAddress": (*Server).disassociateIpAddress,
+
+		// Firewall Rule
+		"createFirewallRule": (*Server).createFirewallRule,
+		"listFirewallRules":  (*Server).listFirewallRules,
+		"deleteFirewallRule": (*Server).deleteFirewallRule,
+
+		// PortForwarding Rule
+		"createPortForwardingRule": (*Server).createPortForwardingRule,
+		"listPortForwardingRules":  (*Server).listPortForwardingRules,
+		"deletePortForwardingRule": (*Server).deletePortForwardingRule,
+
+		// Load Balancer
+		"createLoadBalancer":          (*Server).createLoadBalancer,
+		"listLoadBalancers":           (*Server).listLoadBalancers,
+		"deleteLoadBalancer":          (*Server).deleteLoadBalancer,
+		"addLoadBalancerWebServer":    (*Server).addLoadBalancerWebServer,
+		"listLoadBalancerWebServers":  (*Server).listLoadBalancerWebServers,
+		"removeLoadBalancerWebServer": (*Server).removeLoadBalancerWebServer,
+
+		// Template (Server Image)
+		"createTemplate": (*Server).createTemplate,
+		"listTemplates":  (*Server).listTemplates,
+		"deleteTemplate": (*Server).deleteTemplate,
+
+		// Tag
+		"createTags": (*Server).createTags,
+		"listTags":   (*Server).listTags,
+		"deleteTags": (*Server).deleteTags,
+
+		// SSH Key
+		"createSSHKeyPair": (*Server).createSSHKeyPair,
+		"listSSHKeyPairs":  (*Server).listSSHKeyPairs,
+		"deleteSSHKeyPair": (*Server).deleteSSHKeyPair,
+	}
+}
@@ -0,0 +1,263 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudtest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+)
+
+func TestAuthentication(t *testing.T) {
+	tests := []struct {
+		name      string
+		apiKey    string
+		secretKey string
+		unchecked bool          // CheckSignature off
+		expiry    time.Duration // Signature expiry of the client
+		clockSkew time.Duration // How far the clock of the server is ahead
+		wantErr   bool
+	}{
+		{"valid", DefaultAPIKey, DefaultSecretKey, false, 0, 0, false},
+		{"wrong apikey", "other-key", DefaultSecretKey, false, 0, 0, true},
+		{"wrong secret key", DefaultAPIKey, "other-secret", false, 0, 0, true},
+		{"signature not checked", DefaultAPIKey, "other-secret", true, 0, 0, false},
+		{"unexpired signature", DefaultAPIKey, DefaultSecretKey, false, 2 * time.Hour, time.Hour, false},
+		{"expired signature", DefaultAPIKey, DefaultSecretKey, false, time.Minute, time.Hour, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := NewServer()
+			defer srv.Close()
+			srv.CheckSignature = !tt.unchecked
+			srv.now = func() time.Time { return time.Now().Add(tt.clockSkew) }
+
+			client := ktsdk.KtCloudClient{}.New(srv.URL, tt.apiKey, tt.secretKey, false,
+				ktsdk.WithHTTPClient(srv.Server.Client()), ktsdk.WithSignatureExpiry(tt.expiry), ktsdk.WithRetryPolicy(nil))
+			resp, err := client.ListZones(true, "", "", "")
+			if !tt.wantErr {
+				if err != nil || resp.Listzonesresponse.Count != 2 {
+					t.Errorf("ListZones() = %+v, %v, want the 2 zones", resp.Listzonesresponse, err)
+				}
+				return
+			}
+			var apiErr *ktsdk.APIError
+			if !errors.As(err, &apiErr) || apiErr.HTTPStatus != http.StatusUnauthorized || apiErr.ErrorCode != ktsdk.ErrCodeUnauthorized {
+				t.Errorf("ListZones() = %v, want an HTTP 401 *APIError", err)
+			}
+		})
+	}
+}
+
+func TestErrorBody(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	// Validation is off so that the server sees the invalid requests.
+	client := srv.Client(ktsdk.WithoutValidation(), ktsdk.WithRetryPolicy(nil))
+
+	tests := []struct {
+		name     string
+		command  string
+		params   url.Values
+		wantCode int
+	}{
+		{"unknown command", "noSuchCommand", nil, ktsdk.ErrCodeUnsupportedAction},
+		{"missing parameter", "deployVirtualMachine", url.Values{"zoneid": {ZoneSeoulM}}, ktsdk.ErrCodeParamError},
+		{"unknown id", "destroyVirtualMachine", url.Values{"id": {"no-such-vm"}}, ktsdk.ErrCodeParamError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := client.Do(context.Background(), tt.command, tt.params, nil)
+			var apiErr *ktsdk.APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("Do(%s) = %v, want an *APIError", tt.command, err)
+			}
+			if apiErr.Command != tt.command || apiErr.HTTPStatus != tt.wantCode || apiErr.ErrorCode != tt.wantCode ||
+				apiErr.CSErrorCode != 9999 || apiErr.ErrorText == "" {
+				t.Errorf("Do(%s) = %+v, want errorcode and HTTP status %d", tt.command, apiErr, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestJobSteps(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	srv.SetJobSteps(2)
+	client := srv.Client()
+
+	deployed, err := client.DeployVirtualMachine(ktsdk.DeployVMReqInfo{ZoneId: ZoneSeoulM, ServiceOfferingId: "s", TemplateId: "t"})
+	if err != nil {
+		t.Fatalf("DeployVirtualMachine() = %v", err)
+	}
+	r := deployed.Deployvirtualmachineresponse
+
+	// The job is pending for 2 more requests, and completes on the third one.
+	for i, want := range []int{jobPending, jobPending, jobSucceeded} {
+		job, err := client.QueryAsyncJobResult(r.JobId)
+		if err != nil {
+			t.Fatalf("QueryAsyncJobResult() = %v", err)
+		}
+		if got := int(job.Queryasyncjobresultresponse.JobStatus); got != want {
+			t.Fatalf("jobstatus of request %d = %d, want %d", i+1, got, want)
+		}
+		if job.Queryasyncjobresultresponse.JobInstanceId != r.ID {
+			t.Errorf("jobinstanceid = %s, want the VM %s", job.Queryasyncjobresultresponse.JobInstanceId, r.ID)
+		}
+	}
+
+	vms, err := client.ListVirtualMachines(ktsdk.ListVMReqInfo{VMId: r.ID})
+	if err != nil || len(vms.Listvirtualmachinesresponse.Virtualmachine) != 1 {
+		t.Fatalf("ListVirtualMachines() = %+v, %v", vms.Listvirtualmachinesresponse, err)
+	}
+	if state := vms.Listvirtualmachinesresponse.Virtualmachine[0].State; state != ktsdk.VMStateRunning {
+		t.Errorf("state = %s after the job, want %s", state, ktsdk.VMStateRunning)
+	}
+}
+
+func TestUniqueIds(t *testing.T) {
+	first, second := NewServer(), NewServer()
+	defer first.Close()
+	defer second.Close()
+
+	seen := map[string]bool{}
+	for _, srv := range []*Server{first, second} {
+		for i := 0; i < 3; i++ {
+			resp, err := srv.Client().DeployVirtualMachine(ktsdk.DeployVMReqInfo{ZoneId: ZoneSeoulM, ServiceOfferingId: "s", TemplateId: "t"})
+			if err != nil {
+				t.Fatalf("DeployVirtualMachine() = %v", err)
+			}
+			id := resp.Deployvirtualmachineresponse.ID
+			if seen[id] {
+				t.Errorf("ID '%s' handed out twice", id)
+			}
+			seen[id] = true
+		}
+	}
+}
+
+// deploy deploys a VM with client, and returns the VM and job IDs.
+func deploy(t *testing.T, client *ktsdk.KtCloudClient) (string, string) {
+	t.Helper()
+	resp, err := client.DeployVirtualMachine(ktsdk.DeployVMReqInfo{ZoneId: ZoneSeoulM, ServiceOfferingId: "s", TemplateId: "t"})
+	if err != nil {
+		t.Fatalf("DeployVirtualMachine() = %v", err)
+	}
+	return resp.Deployvirtualmachineresponse.ID, resp.Deployvirtualmachineresponse.JobId
+}
+
+// jobAndVMState queries a job, then the state of its VM.
+func jobAndVMState(t *testing.T, client *ktsdk.KtCloudClient, jobId string, vmId string) (jobResult, ktsdk.VMState) {
+	t.Helper()
+	job, err := client.QueryAsyncJobResult(jobId)
+	if err != nil {
+		t.Fatalf("QueryAsyncJobResult() = %v", err)
+	}
+	r := job.Queryasyncjobresultresponse
+	vms, err := client.ListVirtualMachines(ktsdk.ListVMReqInfo{VMId: vmId})
+	if err != nil {
+		t.Fatalf("ListVirtualMachines() = %v", err)
+	}
+	var state ktsdk.VMState
+	if len(vms.Listvirtualmachinesresponse.Virtualmachine) == 1 {
+		state = vms.Listvirtualmachinesresponse.Virtualmachine[0].State
+	}
+	return jobResult{JobStatus: int(r.JobStatus), Cmd: r.Cmd, JobResult: r.JobResult}, state
+}
+
+// jobResult is the part of a 'queryAsyncJobResult' response checked by the tests.
+type jobResult struct {
+	JobStatus int
+	Cmd       string
+	JobResult ktsdk.JobResult
+}
+
+func TestFailJobs(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	srv.FailJobs("deployVirtualMachine", "Insufficient capacity", 1)
+
+	vmId, jobId := deploy(t, client)
+	job, state := jobAndVMState(t, client, jobId, vmId)
+	if job.JobStatus != jobFailed || job.JobResult.ErrorText != "Insufficient capacity" ||
+		int(job.JobResult.ErrorCode) != ktsdk.ErrCodeInternalError || job.Cmd != "deployVirtualMachine" {
+		t.Errorf("failed job = %+v, want jobstatus %d and the error text", job, jobFailed)
+	}
+	if state != ktsdk.VMStateError {
+		t.Errorf("state = %s after the failed deploy, want %s", state, ktsdk.VMStateError)
+	}
+
+	// Only the first job fails.
+	vmId, jobId = deploy(t, client)
+	if job, state := jobAndVMState(t, client, jobId, vmId); job.JobStatus != jobSucceeded || state != ktsdk.VMStateRunning {
+		t.Errorf("second job = %d, state %s, want it to succeed", job.JobStatus, state)
+	}
+
+	// An empty command and no count fail every job.
+	srv.FailJobs("", "Unexpected error", 0)
+	for i := 0; i < 2; i++ {
+		vmId, jobId = deploy(t, client)
+		if job, _ := jobAndVMState(t, client, jobId, vmId); job.JobStatus != jobFailed {
+			t.Errorf("job %d = %d, want it to fail", i+1, job.JobStatus)
+		}
+	}
+}
+
+func TestHoldJobs(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	srv.HoldJobs("deployVirtualMachine")
+
+	vmId, jobId := deploy(t, client)
+	for i := 0; i < 3; i++ {
+		if job, state := jobAndVMState(t, client, jobId, vmId); job.JobStatus != jobPending || state != ktsdk.VMStateStarting {
+			t.Fatalf("held job = %d, state %s, want it pending and the VM %s", job.JobStatus, state, ktsdk.VMStateStarting)
+		}
+	}
+
+	srv.ReleaseJobs("deployVirtualMachine")
+	if job, state := jobAndVMState(t, client, jobId, vmId); job.JobStatus != jobSucceeded || state != ktsdk.VMStateRunning {
+		t.Errorf("released job = %d, state %s, want it to succeed", job.JobStatus, state)
+	}
+
+	// Jobs of other commands aren't held.
+	srv.HoldJobs("createTemplate")
+	vmId, jobId = deploy(t, client)
+	if job, _ := jobAndVMState(t, client, jobId, vmId); job.JobStatus != jobSucceeded {
+		t.Errorf("job of another command = %d, want it to succeed", job.JobStatus)
+	}
+}
+
+func TestClearFaults(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client(ktsdk.WithRetryPolicy(nil))
+	srv.HoldJobs("deployVirtualMachine")
+	vmId, heldJobId := deploy(t, client)
+	srv.InjectFault(Fault{Command: "listZones", HTTPStatus: http.StatusServiceUnavailable})
+	srv.FailJobs("", "Unexpected error", 0)
+
+	srv.ClearFaults()
+	if _, err := client.ListZones(true, "", "", ""); err != nil {
+		t.Errorf("ListZones() = %v after ClearFaults()", err)
+	}
+	if job, state := jobAndVMState(t, client, heldJobId, vmId); job.JobStatus != jobSucceeded || state != ktsdk.VMStateRunning {
+		t.Errorf("held job = %d, state %s after ClearFaults(), want it to succeed", job.JobStatus, state)
+	}
+	vmId, jobId := deploy(t, client)
+	if job, _ := jobAndVMState(t, client, jobId, vmId); job.JobStatus != jobSucceeded {
+		t.Errorf("new job = %d after ClearFaults(), want it to succeed", job.JobStatus)
+	}
+}
@@ -0,0 +1,209 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudtest
+
+import (
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+)
+
+var tagKeyParam = regexp.MustCompile(`^tags\[(\d+)\]\.key$`)
+
+// tagArgs returns the 'tags[n].key' / 'tags[n].value' params, ordered by n.
+func tagArgs(params url.Values) []ktsdk.TagArg {
+	var indexes []int
+	for name := range params {
+		if m := tagKeyParam.FindStringSubmatch(name); m != nil {
+			n, _ := strconv.Atoi(m[1])
+			indexes = append(indexes, n)
+		}
+	}
+	sort.Ints(indexes)
+
+	var args []ktsdk.TagArg
+	for _, n := range indexes {
+		prefix := "tags[" + strconv.Itoa(n) + "]."
+		args = append(args, ktsdk.TagArg{Key: params.Get(prefix + "key"), Value: params.Get(prefix + "value")})
+	}
+	return args
+}
+
+func resourceIds(params url.Values) []string {
+	var ids []string
+	for _, id := range strings.Split(params.Get("resourceids"), ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// Creating a tag whose key is already set on the resource replaces its value.
+func (s *Server) createTags(params url.Values) (interface{}, error) {
+	if _, err := required(params, "resourceids"); err != nil {
+		return nil, err
+	}
+	resourceType, err := required(params, "resourcetype")
+	if err != nil {
+		return nil, err
+	}
+	args := tagArgs(params)
+	if len(args) == 0 {
+		return nil, errorf(ktsdk.ErrCodeParamError, "Unable to execute API command due to missing parameter tags")
+	}
+	ids := resourceIds(params)
+
+	var resp ktsdk.CreateTagsResponse
+	resp.Createtagsresponse.JobId = s.startJob("createTags", "None", "", func() error {
+		for _, id := range ids {
+			for _, arg := range args {
+				s.deleteTag(resourceType, id, arg.Key)
+				s.tags = append(s.tags, ktsdk.Tag{
+					Key:          arg.Key,
+					Value:        arg.Value,
+					ResourceType: resourceType,
+					ResourceId:   id,
+					Account:      "ktcloudtest",
+				})
+			}
+			s.refreshTags(resourceType, id)
+		}
+		return nil
+	}, nil)
+	return resp, nil
+}
+
+func (s *Server) deleteTag(resourceType string, resourceId string, key string) {
+	tags := s.tags[:0]
+	for _, tag := range s.tags {
+		if !(strings.EqualFold(tag.ResourceType, resourceType) && tag.ResourceId == resourceId && (key == "" || tag.Key == key)) {
+			tags = append(tags, tag)
+		}
+	}
+	s.tags = tags
+}
+
+// refreshTags copies the tags of a resource into its Tags field, so that they are listed along with it.
+func (s *Server) refreshTags(resourceType string, resourceId string) {
+	var tags ktsdk.Tags
+	for _, tag := range s.tags {
+		if strings.EqualFold(tag.ResourceType, resourceType) && tag.ResourceId == resourceId {
+			tags = append(tags, tag)
+		}
+	}
+	switch strings.ToLower(resourceType) {
+	case "uservm":
+		for _, vm := range s.vms {
+			if vm.ID == resourceId {
+				vm.Tags = tags
+			}
+		}
+	case "volume":
+		for _, v := range s.volumes {
+			if v.ID == resourceId {
+				v.Tags = tags
+			}
+		}
+	case "publicipaddress":
+		for _, ip := range s.publicIps {
+			if ip.ID == resourceId {
+				ip.Tags = tags
+			}
+		}
+	case "firewallrule":
+		for _, rule := range s.firewall {
+			if rule.ID == resourceId {
+				rule.Tags = tags
+			}
+		}
+	case "portforwardingrule":
+		for _, rule := range s.portForwards {
+			if rule.ID == resourceId {
+				rule.Tags = tags
+			}
+		}
+	case "template":
+		for _, t := range s.templates {
+			if t.ID == resourceId {
+				t.Tags = tags
+			}
+		}
+	}
+}
+
+// hasTags checks whether a resource has all the given tags.
+func (s *Server) hasTags(resourceType string, resourceId string, args []ktsdk.TagArg) bool {
+	for _, arg := range args {
+		found := false
+		for _, tag := range s.tags {
+			if strings.EqualFold(tag.ResourceType, resourceType) && tag.ResourceId == resourceId && tag.Key == arg.Key && tag.Value == arg.Value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) listTags(params url.Values) (interface{}, error) {
+	var resp ktsdk.ListTagsResponse
+	r := &resp.Listtagsresponse
+	for _, tag := range s.tags {
+		if key := params.Get("key"); key != "" && tag.Key != key {
+			continue
+		}
+		if value := params.Get("value"); value != "" && tag.Value != value {
+			continue
+		}
+		if resourceType := params.Get("resourcetype"); resourceType != "" && !strings.EqualFold(tag.ResourceType, resourceType) {
+			continue
+		}
+		if resourceId := params.Get("resourceid"); resourceId != "" && tag.ResourceId != resourceId {
+			continue
+		}
+		r.Tag = append(r.Tag, tag)
+	}
+	r.Count = ktsdk.FlexInt(len(r.Tag))
+	return resp, nil
+}
+
+// Without 'tags' params, every tag of the resources is deleted.
+func (s *Server) deleteTags(params url.Values) (interface{}, error) {
+	if _, err := required(params, "resourceids"); err != nil {
+		return nil, err
+	}
+	resourceType, err := required(params, "resourcetype")
+	if err != nil {
+		return nil, err
+	}
+	args := tagArgs(params)
+	ids := resourceIds(params)
+
+	var resp ktsdk.DeleteTagsResponse
+	resp.Deletetagsresponse.JobId = s.startJob("deleteTags", "None", "", func() error {
+		for _, id := range ids {
+			if len(args) == 0 {
+				s.deleteTag(resourceType, id, "")
+			}
+			for _, arg := range args {
+				s.deleteTag(resourceType, id, arg.Key)
+			}
+			s.refreshTags(resourceType, id)
+		}
+		return nil
+	}, nil)
+	return resp, nil
+}
@@ -0,0 +1,125 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudtest
+
+import (
+	"net/url"
+	"strings"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+)
+
+// Templates are created from a volume, and are ready once the creation job completes.
+func (s *Server) createTemplate(params url.Values) (interface{}, error) {
+	for _, name := range []string{"name", "displaytext", "ostypeid", "volumeid"} {
+		if _, err := required(params, name); err != nil {
+			return nil, err
+		}
+	}
+	v, err := s.volume(params.Get("volumeid"))
+	if err != nil {
+		return nil, err
+	}
+
+	t := &ktsdk.Template{
+		ID:               s.newId(),
+		Name:             params.Get("name"),
+		DisplayText:      params.Get("displaytext"),
+		IsPublic:         params.Get("ispublic") == "true",
+		IsFeatured:       params.Get("isfeatured") == "true",
+		Created:          s.timestamp(),
+		Format:           "VHD",
+		OSTypeId:         params.Get("ostypeid"),
+		Account:          "ktcloudtest",
+		ZoneId:           v.ZoneId,
+		ZoneName:         v.ZoneName,
+		Status:           "Creating",
+		Size:             v.Size,
+		TemplateType:     "USER",
+		Hypervisor:       "XenServer",
+		SourceTemplateId: v.TemplateId,
+		Bits:             64,
+	}
+	s.templates = append(s.templates, t)
+
+	var resp ktsdk.CreateTemplateResponse
+	resp.Createtemplateresponse.ID = t.ID
+	resp.Createtemplateresponse.JobId = s.startJob("createTemplate", "Template", t.ID, func() error {
+		t.IsReady = true
+		t.Status = "Download Complete"
+		return nil
+	}, func() {
+		s.templates = remove(s.templates, func(other *ktsdk.Template) bool { return other.ID == t.ID })
+	})
+	return resp, nil
+}
+
+// Every template of the server is a user's template, listed by the 'self' and 'selfexecutable' filters.
+// Without a templatefilter, every template is listed.
+func (s *Server) listTemplates(params url.Values) (interface{}, error) {
+	filter := params.Get("templatefilter")
+
+	var templates []*ktsdk.Template
+	for _, t := range s.templates {
+		switch filter {
+		case "", "self", "all":
+		case "selfexecutable", "executable":
+			if !t.IsReady {
+				continue
+			}
+		case "featured":
+			if !t.IsFeatured {
+				continue
+			}
+		case "community":
+			if !t.IsPublic {
+				continue
+			}
+		default:
+			return nil, errorf(ktsdk.ErrCodeMalformedParameter, "Invalid templatefilter '%s'", filter)
+		}
+		if id := params.Get("id"); id != "" && t.ID != id {
+			continue
+		}
+		if name := params.Get("name"); name != "" && t.Name != name {
+			continue
+		}
+		if keyword := params.Get("keyword"); keyword != "" && !strings.Contains(t.Name, keyword) && !strings.Contains(t.DisplayText, keyword) {
+			continue
+		}
+		templates = append(templates, t)
+	}
+
+	var resp ktsdk.ListTemplatesResponse
+	resp.Listtemplatesresponse.Count = ktsdk.FlexInt(len(templates))
+	resp.Listtemplatesresponse.Template = values(paginate(templates, params))
+	return resp, nil
+}
+
+func (s *Server) deleteTemplate(params url.Values) (interface{}, error) {
+	id, err := required(params, "id")
+	if err != nil {
+		return nil, err
+	}
+	found := false
+	for _, t := range s.templates {
+		if t.ID == id {
+			found = true
+		}
+	}
+	if !found {
+		return nil, notFound("template", id)
+	}
+
+	var resp ktsdk.DeleteTemplateResponse
+	resp.Deletetemplateresponse.JobId = s.startJob("deleteTemplate", "Template", id, func() error {
+		s.templates = remove(s.templates, func(t *ktsdk.Template) bool { return t.ID == id })
+		return nil
+	}, nil)
+	return resp, nil
+}
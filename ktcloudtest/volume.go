@@ -0,0 +1,234 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudtest
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+)
+
+func (s *Server) volume(id string) (*ktsdk.Volume, error) {
+	for _, v := range s.volumes {
+		if v.ID == id {
+			return v, nil
+		}
+	}
+	return nil, notFound("volume", id)
+}
+
+// detach clears the VM of a volume.
+func detach(v *ktsdk.Volume) {
+	v.VMId = ""
+	v.VMName = ""
+	v.VMDisplayName = ""
+	v.VMState = ""
+	v.DeviceId = 0
+	v.AttachedTime = ktsdk.Timestamp{}
+	v.State = ktsdk.VolumeStateAllocated
+}
+
+func (s *Server) createVolume(params url.Values) (interface{}, error) {
+	if _, err := required(params, "name"); err != nil {
+		return nil, err
+	}
+	if params.Get("productcode") == "" {
+		if _, err := required(params, "diskofferingid"); err != nil {
+			return nil, err
+		}
+	}
+	zoneId, err := required(params, "zoneid")
+	if err != nil {
+		return nil, err
+	}
+	zone, err := s.zone(zoneId)
+	if err != nil {
+		return nil, err
+	}
+	size := int64(100)
+	if p := params.Get("size"); p != "" {
+		if size, err = strconv.ParseInt(p, 10, 64); err != nil {
+			return nil, errorf(ktsdk.ErrCodeMalformedParameter, "Invalid size '%s'", p)
+		}
+	}
+	usagePlanType := ktsdk.UsagePlanType(params.Get("usageplantype"))
+	if usagePlanType == "" {
+		usagePlanType = ktsdk.UsagePlanTypeHourly
+	}
+
+	v := &ktsdk.Volume{
+		ID:             s.newId(),
+		Name:           params.Get("name"),
+		ZoneId:         zone.ID,
+		ZoneName:       zone.Name,
+		Type:           ktsdk.VolumeTypeDataDisk,
+		Size:           ktsdk.FlexInt(size << 30),
+		Created:        s.timestamp(),
+		State:          ktsdk.VolumeStateCreating,
+		Account:        "ktcloudtest",
+		DiskOfferingId: params.Get("diskofferingid"),
+		UsagePlanType:  usagePlanType,
+	}
+	s.volumes = append(s.volumes, v)
+
+	var resp ktsdk.CreateVolumeResponse
+	resp.Createvolumeresponse.ID = v.ID
+	resp.Createvolumeresponse.JobId = s.startJob("createVolume", "Volume", v.ID, func() error {
+		v.State = ktsdk.VolumeStateAllocated
+		return nil
+	}, func() {
+		s.volumes = remove(s.volumes, func(other *ktsdk.Volume) bool { return other.ID == v.ID })
+	})
+	return resp, nil
+}
+
+func (s *Server) listVolumes(params url.Values) (interface{}, error) {
+	var volumes []*ktsdk.Volume
+	for _, v := range s.volumes {
+		if id := params.Get("id"); id != "" && v.ID != id {
+			continue
+		}
+		if zoneId := params.Get("zoneid"); zoneId != "" && v.ZoneId != zoneId {
+			continue
+		}
+		if vmId := params.Get("virtualmachineid"); vmId != "" && v.VMId != vmId {
+			continue
+		}
+		if name := params.Get("name"); name != "" && v.Name != name {
+			continue
+		}
+		if typ := params.Get("type"); typ != "" && !strings.EqualFold(string(v.Type), typ) {
+			continue
+		}
+		if keyword := params.Get("keyword"); keyword != "" && !strings.Contains(v.Name, keyword) {
+			continue
+		}
+		volumes = append(volumes, v)
+	}
+
+	var resp ktsdk.ListVolumesResponse
+	resp.Listvolumesresponse.Count = ktsdk.FlexInt(len(volumes))
+	resp.Listvolumesresponse.Volume = values(paginate(volumes, params))
+	return resp, nil
+}
+
+func (s *Server) resizeVolume(params url.Values) (interface{}, error) {
+	id, err := required(params, "id")
+	if err != nil {
+		return nil, err
+	}
+	v, err := s.volume(id)
+	if err != nil {
+		return nil, err
+	}
+	p, err := required(params, "size")
+	if err != nil {
+		return nil, err
+	}
+	size, err := strconv.ParseInt(p, 10, 64)
+	if err != nil {
+		return nil, errorf(ktsdk.ErrCodeMalformedParameter, "Invalid size '%s'", p)
+	}
+
+	var resp ktsdk.ResizeVolumeResponse
+	resp.Resizevolumeresponse.JobId = s.startJob("resizeVolume", "Volume", v.ID, func() error {
+		v.Size = ktsdk.FlexInt(size << 30)
+		return nil
+	}, nil)
+	return resp, nil
+}
+
+func (s *Server) deleteVolume(params url.Values) (interface{}, error) {
+	id, err := required(params, "id")
+	if err != nil {
+		return nil, err
+	}
+	v, err := s.volume(id)
+	if err != nil {
+		return nil, err
+	}
+	if v.VMId != "" {
+		return nil, errorf(ktsdk.ErrCodeResourceInUse, "Please specify a volume that is not attached to any VM")
+	}
+	s.volumes = remove(s.volumes, func(vol *ktsdk.Volume) bool { return vol.ID == v.ID })
+
+	var resp ktsdk.DeleteVolumeResponse
+	resp.Deletevolumeresponse.Success = true
+	return resp, nil
+}
+
+func (s *Server) attachVolume(params url.Values) (interface{}, error) {
+	id, err := required(params, "id")
+	if err != nil {
+		return nil, err
+	}
+	v, err := s.volume(id)
+	if err != nil {
+		return nil, err
+	}
+	vmId, err := required(params, "virtualmachineid")
+	if err != nil {
+		return nil, err
+	}
+	vm, err := s.vm(vmId)
+	if err != nil {
+		return nil, err
+	}
+	if v.VMId != "" {
+		return nil, errorf(ktsdk.ErrCodeResourceInUse, "Volume %s is already attached to VM %s", v.ID, v.VMId)
+	}
+
+	var resp ktsdk.AttachVolumeResponse
+	resp.Attachvolumeresponse.JobId = s.startJob("attachVolume", "Volume", v.ID, func() error {
+		deviceId := ktsdk.FlexInt(1)
+		for _, other := range s.volumes {
+			if other.VMId == vm.ID && other.DeviceId >= deviceId {
+				deviceId = other.DeviceId + 1
+			}
+		}
+		if p := params.Get("deviceid"); p != "" {
+			n, _ := strconv.Atoi(p)
+			deviceId = ktsdk.FlexInt(n)
+		}
+		v.VMId = vm.ID
+		v.VMName = vm.Name
+		v.VMDisplayName = vm.DisplayName
+		v.VMState = vm.State
+		v.DeviceId = deviceId
+		v.AttachedTime = s.timestamp()
+		v.State = ktsdk.VolumeStateReady
+		return nil
+	}, nil)
+	return resp, nil
+}
+
+func (s *Server) detachVolume(params url.Values) (interface{}, error) {
+	id, err := required(params, "id")
+	if err != nil {
+		return nil, err
+	}
+	v, err := s.volume(id)
+	if err != nil {
+		return nil, err
+	}
+	if v.VMId == "" {
+		return nil, errorf(ktsdk.ErrCodeParamError, "Volume %s is not attached to a VM", v.ID)
+	}
+	if v.Type == ktsdk.VolumeTypeRoot {
+		return nil, errorf(ktsdk.ErrCodeParamError, "Please specify a data volume, not a ROOT volume")
+	}
+
+	var resp ktsdk.DetachVolumeResponse
+	resp.Detachvolumeresponse.JobId = s.startJob("detachVolume", "Volume", v.ID, func() error {
+		detach(v)
+		return nil
+	}, nil)
+	return resp, nil
+}
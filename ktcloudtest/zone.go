@@ -0,0 +1,47 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudtest
+
+import (
+	"net/url"
+	"strings"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+)
+
+func (s *Server) listZones(params url.Values) (interface{}, error) {
+	var resp ktsdk.ListZonesResponse
+	r := &resp.Listzonesresponse
+	for _, zone := range s.zones {
+		if id := params.Get("id"); id != "" && zone.ID != id {
+			continue
+		}
+		if params.Get("available") == "true" && zone.AllocationState != "Enabled" {
+			continue
+		}
+		if keyword := params.Get("keyword"); keyword != "" && !strings.Contains(zone.Name, keyword) {
+			continue
+		}
+		r.Zone = append(r.Zone, zone)
+	}
+	r.Count = ktsdk.FlexInt(len(r.Zone))
+	return resp, nil
+}
+
+func (s *Server) listAvailableProductTypes(params url.Values) (interface{}, error) {
+	var resp ktsdk.ListAvailableProductTypesResponse
+	r := &resp.Listavailableproducttypesresponse
+	for _, pt := range s.productTypes {
+		if zoneId := params.Get("zoneid"); zoneId != "" && pt.ZoneId != zoneId {
+			continue
+		}
+		r.ProductTypes = append(r.ProductTypes, pt)
+	}
+	r.Count = ktsdk.FlexInt(len(r.ProductTypes))
+	return resp, nil
+}
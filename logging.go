@@ -0,0 +1,90 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cloud-barista/ktcloud-sdk-go/internal/redact"
+)
+
+// Logger receives the debug log of the API calls. *logrus.Logger satisfies it.
+type Logger interface {
+	Debugf(format string, args ...interface{})
+}
+
+// Response bodies longer than this are cut in the debug log.
+const maxLoggedBodyLength = 4096
+
+type debugLogger struct {
+	logger    Logger
+	logBodies bool
+}
+
+func (d *debugLogger) logRequest(command string, method string, query string) {
+	if d == nil {
+		return
+	}
+	d.logger.Debugf("[KT Cloud API] --> %s %s params: %s", method, command, redactQuery(query))
+}
+
+func (d *debugLogger) logResponse(command string, status int, latency time.Duration, body []byte, err error) {
+	if d == nil {
+		return
+	}
+	if status == 0 {
+		d.logger.Debugf("[KT Cloud API] <-- %s failed (%v) : %v", command, latency, redactError(err))
+		return
+	}
+	d.logger.Debugf("[KT Cloud API] <-- %s HTTP %d (%v)", command, status, latency)
+	if d.logBodies {
+		d.logger.Debugf("[KT Cloud API] <-- %s body: %s", command, redactBody(body))
+	}
+}
+
+// redactQuery masks the secret values of a signed query string.
+func redactQuery(query string) string {
+	return redact.Query(query)
+}
+
+// redactError masks the secret values of the signed URL that an HTTP transport error (*url.Error) carries.
+func redactError(err error) error {
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return err
+	}
+	redacted := redactURL(urlErr.URL)
+	if redacted == urlErr.URL {
+		return err
+	}
+	if err == error(urlErr) {
+		return &url.Error{Op: urlErr.Op, URL: redacted, Err: urlErr.Err}
+	}
+	return errors.New(strings.ReplaceAll(err.Error(), urlErr.URL, redacted))
+}
+
+// redactURL masks the secret values of the query of a signed URL.
+func redactURL(rawURL string) string {
+	base, query, found := strings.Cut(rawURL, "?")
+	if !found {
+		return rawURL
+	}
+	return base + "?" + redactQuery(query)
+}
+
+// redactBody masks the secret fields of a JSON body, and cuts it down to maxLoggedBodyLength.
+func redactBody(body []byte) string {
+	s := string(redact.JSON(body))
+	if len(s) > maxLoggedBodyLength {
+		s = s[:maxLoggedBodyLength] + "...(truncated)"
+	}
+	return s
+}
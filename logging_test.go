@@ -0,0 +1,97 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk_test
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+	"github.com/cloud-barista/ktcloud-sdk-go/ktcloudtest"
+)
+
+// Matches the signature of a logged query.
+var signatureRegexp = regexp.MustCompile(`signature=([^&\s"]*)`)
+
+// logBuffer is a Logger keeping what is logged.
+type logBuffer struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+func (b *logBuffer) Debugf(format string, args ...interface{}) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.lines = append(b.lines, fmt.Sprintf(format, args...))
+}
+
+func (b *logBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return strings.Join(b.lines, "\n")
+}
+
+func TestDebugLoggingRedaction(t *testing.T) {
+	tests := []struct {
+		name string
+		// call makes a call with client, whose server is srv.
+		call func(srv *ktcloudtest.Server, client *ktsdk.KtCloudClient) error
+		// Logged when the call went as expected.
+		want string
+	}{
+		{
+			name: "response body",
+			call: func(srv *ktcloudtest.Server, client *ktsdk.KtCloudClient) error {
+				_, err := client.CreateSSHKeyPair("key")
+				return err
+			},
+			want: `"privatekey":"****"`,
+		},
+		{
+			name: "transport error",
+			call: func(srv *ktcloudtest.Server, client *ktsdk.KtCloudClient) error {
+				srv.Close()
+				if _, err := client.ListZones(true, "", "", ""); err == nil {
+					return fmt.Errorf("ListZones() of a closed server = nil, want an error")
+				}
+				return nil
+			},
+			want: "listZones failed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := ktcloudtest.NewServer()
+			defer srv.Close()
+			var log logBuffer
+			client := srv.Client(ktsdk.WithDebugLogger(&log, true), ktsdk.WithRetryPolicy(nil))
+
+			if err := tt.call(srv, client); err != nil {
+				t.Fatal(err)
+			}
+			logged := log.String()
+			if !strings.Contains(logged, tt.want) {
+				t.Errorf("log = %s, want %q in it", logged, tt.want)
+			}
+			for _, secret := range []string{srv.APIKey, srv.SecretKey, "PRIVATE KEY"} {
+				if strings.Contains(logged, secret) {
+					t.Errorf("log = %s, want no %q in it", logged, secret)
+				}
+			}
+			for _, m := range signatureRegexp.FindAllStringSubmatch(logged, -1) {
+				if m[1] != url.QueryEscape("****") {
+					t.Errorf("log = %s, want the signature masked", logged)
+				}
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@
 package ktcloudsdk
 
 import (
+	"context"
 	"net/url"
 )
 // KT Cloud (G1/G2 Platform) Load-Balancer API : https://cloud.kt.com/docs/open-api-guide/g/network/load-balancer
@@ -17,12 +18,12 @@ import (
 type CreateNLBReqInfo struct {
 	Name             string `json:"name"`				// Required
 	ZoneId           string `json:"zoneid"`				// Required. Zone ID that has the 'ServiceIP'
-	NLBOption 		 string `json:"loadbalanceroption"`	// Required. roundrobin / leastconnection / leastresponse / sourceiphash / srcipsrcporthash
+	NLBOption 		 NLBOption `json:"loadbalanceroption"`	// Required. roundrobin / leastconnection / leastresponse / sourceiphash / srcipsrcporthash
 	ServiceIP        string `json:"serviceip"`			// Required. KT Cloud Virtual IP. 
 														// 'ServiceIP' : $$$ In case of an empty value(""), it is newly created.
 	ServicePort      string `json:"serviceport"`		// Required
-	ServiceType      string `json:"servicetype"`		// Required. NLB ServiceType : https / http / sslbridge / tcp / ftp
-	HealthCheckType  string `json:"healthchecktype"`	// Required. HealthCheckType : http / https / tcp
+	ServiceType      ServiceType `json:"servicetype"`		// Required. NLB ServiceType : https / http / sslbridge / tcp / ftp
+	HealthCheckType  HealthCheckType `json:"healthchecktype"`	// Required. HealthCheckType : http / https / tcp
 	HealthCheckURL   string `json:"healthcheckurl"`		// Required. URL when the HealthCheckType is 'http' or 'https'.
 	CipherGroupName  string `json:"ciphergroupname"`	// Required when ServiceType is 'https'. Set CipherGroup Name
 	SSLv3        	 string `json:"sslv3"`				// Required when ServiceType is 'https'. Use SSLv3? : 'DISABLED' / 'ENABLED'
@@ -47,18 +48,73 @@ type AddNLBVMReqInfo struct {
 	PublicPort   	 string	`json:"publicport"`			// Required. Port of VM to be added
 }
 
+// Checks the fields of the request before it is sent. A problem is returned as a *ValidationError.
+func (r CreateNLBReqInfo) Validate() error {
+	var errs fieldErrors
+	errs.required("Name", r.Name)
+	errs.required("ZoneId", r.ZoneId)
+	errs.required("NLBOption", string(r.NLBOption))
+	knownEnum(&errs, "NLBOption", r.NLBOption)
+	errs.ip("ServiceIP", r.ServiceIP)
+	errs.required("ServicePort", r.ServicePort)
+	errs.port("ServicePort", r.ServicePort)
+	errs.required("ServiceType", string(r.ServiceType))
+	knownEnum(&errs, "ServiceType", r.ServiceType)
+	errs.required("HealthCheckType", string(r.HealthCheckType))
+	knownEnum(&errs, "HealthCheckType", r.HealthCheckType)
+	healthCheckType, serviceType := r.HealthCheckType.Canonical(), r.ServiceType.Canonical()
+	if healthCheckType == HealthCheckTypeHTTP || healthCheckType == HealthCheckTypeHTTPS {
+		errs.required("HealthCheckURL", r.HealthCheckURL)
+	}
+	if serviceType == ServiceTypeHTTPS {
+		errs.required("CipherGroupName", r.CipherGroupName)
+	}
+	for _, f := range []struct{ name, value string }{{"SSLv3", r.SSLv3}, {"TLSv1", r.TLSv1}, {"TLSv11", r.TLSv11}, {"TLSv12", r.TLSv12}} {
+		if serviceType == ServiceTypeHTTPS {
+			errs.required(f.name, f.value)
+		}
+		errs.oneOf(f.name, f.value, "ENABLED", "DISABLED")
+	}
+	return errs.err("CreateNLBReqInfo")
+}
+
+// ListNLBsReqInfo only has optional filters. Validate() is there for the sake of consistency, and never fails.
+func (r ListNLBsReqInfo) Validate() error {
+	return nil
+}
+
+// Checks the fields of the request before it is sent. A problem is returned as a *ValidationError.
+func (r AddNLBVMReqInfo) Validate() error {
+	var errs fieldErrors
+	errs.required("NLBId", r.NLBId)
+	errs.required("VMId", r.VMId)
+	errs.required("IpAddress", r.IpAddress)
+	errs.ip("IpAddress", r.IpAddress)
+	errs.required("PublicPort", r.PublicPort)
+	errs.port("PublicPort", r.PublicPort)
+	return errs.err("AddNLBVMReqInfo")
+}
+
 // # Create a Load-Balancer 
 func (c KtCloudClient) CreateNLB(req CreateNLBReqInfo) (CreateNLBResponse, error) {
+	return c.CreateNLBWithContext(context.Background(), req)
+}
+
+// CreateNLBWithContext is CreateNLB with a context that bounds the underlying API call.
+func (c KtCloudClient) CreateNLBWithContext(ctx context.Context, req CreateNLBReqInfo) (CreateNLBResponse, error) {
 	var resp CreateNLBResponse	
+	if err := c.validate(req); err != nil {
+		return resp, err
+	}
 	params := url.Values{}
 
 	params.Add("name", req.Name)
 	params.Add("zoneid", req.ZoneId)
-	params.Add("loadbalanceroption", req.NLBOption)
+	params.Add("loadbalanceroption", string(req.NLBOption.Canonical()))
 	params.Add("serviceip", req.ServiceIP)
 	params.Add("serviceport", req.ServicePort)
-	params.Add("servicetype", req.ServiceType)
-	params.Add("healthchecktype", req.HealthCheckType)
+	params.Add("servicetype", string(req.ServiceType.Canonical()))
+	params.Add("healthchecktype", string(req.HealthCheckType.Canonical()))
 
 	if req.HealthCheckURL != "" {
 		params.Add("healthcheckurl", req.HealthCheckURL)
@@ -82,17 +138,23 @@ func (c KtCloudClient) CreateNLB(req CreateNLBReqInfo) (CreateNLBResponse, error
 		params.Add("networkid", req.NetworkId)
 	}
 
-	response, err := NewRequest(c, "createLoadBalancer", params) // Request Command according to KT Cloud API doc.
-	if err != nil {
+	if err := c.Do(ctx, "createLoadBalancer", params, &resp); err != nil { // Request Command according to KT Cloud API doc.
 		return resp, err
 	}
-	resp = response.(CreateNLBResponse)
 	return resp, nil
 }
 
 // # List Load-Balancers 
 func (c KtCloudClient) ListNLBs(req ListNLBsReqInfo) (ListNLBsResponse, error) {
+	return c.ListNLBsWithContext(context.Background(), req)
+}
+
+// ListNLBsWithContext is ListNLBs with a context that bounds the underlying API call.
+func (c KtCloudClient) ListNLBsWithContext(ctx context.Context, req ListNLBsReqInfo) (ListNLBsResponse, error) {
 	var resp ListNLBsResponse	
+	if err := c.validate(req); err != nil {
+		return resp, err
+	}
 	params := url.Values{}
 
 	if req.Name != "" {
@@ -111,32 +173,41 @@ func (c KtCloudClient) ListNLBs(req ListNLBsReqInfo) (ListNLBsResponse, error) {
 		params.Add("memid", req.MemId)
 	}
 
-	response, err := NewRequest(c, "listLoadBalancers", params)
-	if err != nil {
+	if err := c.Do(ctx, "listLoadBalancers", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(ListNLBsResponse)
 	return resp, nil
 }
 
 // # Delete a Load-Balancer
 func (c KtCloudClient) DeleteNLB(nlbId string) (DeleteNLBResponse, error) {
+	return c.DeleteNLBWithContext(context.Background(), nlbId)
+}
+
+// DeleteNLBWithContext is DeleteNLB with a context that bounds the underlying API call.
+func (c KtCloudClient) DeleteNLBWithContext(ctx context.Context, nlbId string) (DeleteNLBResponse, error) {
 	var resp DeleteNLBResponse
 	params := url.Values{}
 
 	params.Set("loadbalancerid", nlbId)
 
-	response, err := NewRequest(c, "deleteLoadBalancer", params)
-	if err != nil {
+	if err := c.Do(ctx, "deleteLoadBalancer", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(DeleteNLBResponse)
-	return resp, err
+	return resp, nil
 }
 
 // # Ad a VM to Load-Balancer
 func (c KtCloudClient) AddNLBVM(req AddNLBVMReqInfo) (AddNLBVMResponse, error) {
+	return c.AddNLBVMWithContext(context.Background(), req)
+}
+
+// AddNLBVMWithContext is AddNLBVM with a context that bounds the underlying API call.
+func (c KtCloudClient) AddNLBVMWithContext(ctx context.Context, req AddNLBVMReqInfo) (AddNLBVMResponse, error) {
 	var resp AddNLBVMResponse
+	if err := c.validate(req); err != nil {
+		return resp, err
+	}
 	params := url.Values{}
 	
 	params.Set("loadbalancerid", req.NLBId)
@@ -144,60 +215,65 @@ func (c KtCloudClient) AddNLBVM(req AddNLBVMReqInfo) (AddNLBVMResponse, error) {
 	params.Set("ipaddress", req.IpAddress)
 	params.Set("publicport", req.PublicPort)
 
-	response, err := NewRequest(c, "addLoadBalancerWebServer", params)
-	if err != nil {
+	if err := c.Do(ctx, "addLoadBalancerWebServer", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(AddNLBVMResponse)
-	return resp, err
+	return resp, nil
 }
 
 // # List Load-Balancers VMs
 // $$$ Caution!!) After this method execution, there must be a second of time sleep.
+// (A RateLimiter command limit on 'listLoadBalancerWebServers' can take care of it. See NewRateLimiter().)
 func (c KtCloudClient) ListNLBVMs(nlbId string) (ListNLBVMsResponse, error) {
+	return c.ListNLBVMsWithContext(context.Background(), nlbId)
+}
+
+// ListNLBVMsWithContext is ListNLBVMs with a context that bounds the underlying API call.
+func (c KtCloudClient) ListNLBVMsWithContext(ctx context.Context, nlbId string) (ListNLBVMsResponse, error) {
 	var resp ListNLBVMsResponse	
 	params := url.Values{}
 
 	params.Set("loadbalancerid", nlbId)
 
-	response, err := NewRequest(c, "listLoadBalancerWebServers", params)
-	if err != nil {
+	if err := c.Do(ctx, "listLoadBalancerWebServers", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(ListNLBVMsResponse)
 	return resp, nil
 }
 
 // # Delete a Load-Balancer VM
 func (c KtCloudClient) RemoveNLBVM(serviceId string) (RemoveNLBVMResponse, error) {
+	return c.RemoveNLBVMWithContext(context.Background(), serviceId)
+}
+
+// RemoveNLBVMWithContext is RemoveNLBVM with a context that bounds the underlying API call.
+func (c KtCloudClient) RemoveNLBVMWithContext(ctx context.Context, serviceId string) (RemoveNLBVMResponse, error) {
 	var resp RemoveNLBVMResponse
 	params := url.Values{}
 
 	params.Set("serviceid", serviceId)
 
-	response, err := NewRequest(c, "removeLoadBalancerWebServer", params)
-	if err != nil {
+	if err := c.Do(ctx, "removeLoadBalancerWebServer", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(RemoveNLBVMResponse)
-	return resp, err
+	return resp, nil
 }
 
 type NLB struct {
     CertificateName     string `json:"certificatename"`
     CipherGroupName     string `json:"cipherGroupName"`
     ClientIpYn          string `json:"clientIpYn"`
-    EstablishedConn     string `json:"establishedconn"`
-    HealthCheckType     string `json:"healthchecktype"` // Health CheckType : http / https / tcp
+    EstablishedConn     FlexInt `json:"establishedconn"`
+    HealthCheckType     HealthCheckType `json:"healthchecktype"` // Health CheckType : http / https / tcp
     HealthCheckURL      string `json:"healthcheckurl"`
-    NLBId      			int    `json:"loadbalancerid"`
-    NLBOption  			string `json:"loadbalanceroption"`
+    NLBId      			FlexString `json:"loadbalancerid"`
+    NLBOption  			NLBOption `json:"loadbalanceroption"`
     Name                string `json:"name"`
     NetworkId           string `json:"networkid"`
-    RequestsRate        int    `json:"requestsrate"`
+    RequestsRate        FlexInt `json:"requestsrate"`
     ServiceIP           string `json:"serviceip"`
-    ServicePort         string `json:"serviceport"`
-    ServiceType         string `json:"servicetype"`	// NLB Service Type : https / http / sslbridge / tcp / ftp
+    ServicePort         FlexInt `json:"serviceport"`
+    ServiceType         ServiceType `json:"servicetype"`	// NLB Service Type : https / http / sslbridge / tcp / ftp
     Sslv2               string `json:"sslv2"`
     Sslv3               string `json:"sslv3"`
     State               string `json:"state"`
@@ -211,68 +287,68 @@ type NLB struct {
 
 type CreateNLBResponse struct {
 	Createnlbresponse struct {
-		NLBId    			string `json:"loadbalancerid"`
+		NLBId    			FlexString `json:"loadbalancerid"`
 		ZoneId            	string `json:"zoneid"`
 		ZoneName          	string `json:"zonename"`
 		ServiceIP         	string `json:"serviceip"`
-		ServicePort       	string `json:"serviceport"`
-		ServiceType       	string `json:"servicetype"`
+		ServicePort       	FlexInt `json:"serviceport"`
+		ServiceType       	ServiceType `json:"servicetype"`
 		Name              	string `json:"name"`
-		NLBOption 			string `json:"loadbalanceroption"`
-		HealthCheckType   	string `json:"healthchecktype"`
+		NLBOption 			NLBOption `json:"loadbalanceroption"`
+		HealthCheckType   	HealthCheckType `json:"healthchecktype"`
 		HealthCheckURL    	string `json:"healthcheckurl"`
-		ErrorCode    		string `json:"errorcode"`
+		ErrorCode    		FlexInt `json:"errorcode"`
 		ErrorText    		string `json:"errortext"`		
 	} `json:"createLoadBalancerresponse"`
 }
 
 type ListNLBsResponse struct {   // Note) Plural
 	Listnlbsresponse struct {	 // Note) Plural
-		Count       		int 	`json:"count"`
+		Count       		FlexInt 	`json:"count"`
 		NLB 				[]NLB 	`json:"loadbalancer"`
 	} `json:"listloadbalancersresponse"`
 }
 
 type DeleteNLBResponse struct {
 	Deletenlbresponse struct {
-		Success 			bool	`json:"success"` // 'bool' type of value (Not like DeleteVolumeResponse)
+		Success 			FlexBool	`json:"success"`
 		Displaytext 		string 	`json:"displaytext"`
 	} `json:"deleteloadbalancerresponse"`
 }
 
 type AddNLBVMResponse struct {
 	Addnlbvmresponse struct {
-		ServiceId         	int    `json:"serviceid"`
-		NLBId    			string `json:"loadbalancerid"`
+		ServiceId         	FlexString `json:"serviceid"`
+		NLBId    			FlexString `json:"loadbalancerid"`
 		VMId 			 	string `json:"virtualmachineid"`
 		IpAddress   	 	string `json:"ipaddress"`	// Public IP of VM added
-		PublicPort   	 	string `json:"publicport"`	// Port of VM added
+		PublicPort   	 	FlexInt `json:"publicport"`	// Port of VM added
 	} `json:"addLoadBalancerWebServerresponse"`
 }
 
 type NLBVM struct {
-	NLBId        		int 	`json:"loadbalancerid"`
-	ServiceId           int 	`json:"serviceid"`
+	NLBId        		FlexString `json:"loadbalancerid"`
+	ServiceId           FlexString `json:"serviceid"`
 	VMId      			string `json:"virtualmachineid"`
 	IPAddress           string `json:"ipaddress"`
-	PublicPort          string `json:"publicport"`
-	CurVMConnections    int    `json:"cursrvrconnections"`
+	PublicPort          FlexInt `json:"publicport"`
+	CurVMConnections    FlexInt `json:"cursrvrconnections"`
 	State               string `json:"state"`
-	ThroughputRate      int    `json:"throughputrate"` // Throughput (Mbps)
-	AvgSvrttfb          int    `json:"avgsvrttfb"`	   // TTFB (VM response time, unit: msec)
-	RequestsRate        int    `json:"requestsrate"`
+	ThroughputRate      FlexInt `json:"throughputrate"` // Throughput (Mbps)
+	AvgSvrttfb          FlexInt `json:"avgsvrttfb"`	   // TTFB (VM response time, unit: msec)
+	RequestsRate        FlexInt `json:"requestsrate"`
 }
 
 type ListNLBVMsResponse struct { // Note) Plural
 	Listnlbvmsresponse struct {  // Note) Plural
 		NLBVM 				[]NLBVM `json:"loadbalancerwebserver"`
-		Count       		int 	`json:"count"`
+		Count       		FlexInt 	`json:"count"`
 	} `json:"listLoadBalancerWebServersresponse"`
 }
 
 type RemoveNLBVMResponse struct {
 	Removenlbvmresponse struct {
-		Success 			bool	`json:"success"` // 'bool' type of value (Not like DeleteVolumeResponse)
+		Success 			FlexBool	`json:"success"`
 		Displaytext 		string 	`json:"displaytext"`
 	} `json:"removeLoadbalancerWebServerresponse"`
 }
@@ -0,0 +1,313 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// User-Agent sent with every API call unless WithUserAgent() is given.
+const DefaultUserAgent = "ktcloud-sdk-go"
+
+// Requests whose URL would be longer than this are sent with POST unless WithPOSTThreshold() is given.
+const DefaultPOSTThreshold = 2048
+
+// ClientOption configures a KtCloudClient created by KtCloudClient{}.New().
+type ClientOption func(*clientOptions)
+
+type clientOptions struct {
+	httpClient  *http.Client
+	transport   http.RoundTripper
+	timeout     time.Duration
+	rootCAs     *x509.CertPool
+	proxy       func(*http.Request) (*url.URL, error)
+	userAgent   string
+	retryPolicy *RetryPolicy
+
+	postThreshold int
+	forcePOST     bool
+
+	signatureExpiry time.Duration
+
+	credentials CredentialsProvider
+
+	debug *debugLogger
+
+	interceptors []Interceptor
+
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+
+	rateLimiter *RateLimiter
+
+	cache              Cache
+	cacheTTLs          map[string]time.Duration
+	cacheInvalidations map[string][]string
+
+	skipValidation bool
+}
+
+// Uses the given http.Client as is, instead of building one.
+// WithTimeout() and WithTransport() still apply to (a copy of) it, while the TLS and proxy options don't.
+func WithHTTPClient(hc *http.Client) ClientOption {
+	return func(o *clientOptions) {
+		o.httpClient = hc
+	}
+}
+
+// Uses the given RoundTripper for every API call. (ex. a test or recording transport)
+// The TLS and proxy options don't apply to it.
+func WithTransport(rt http.RoundTripper) ClientOption {
+	return func(o *clientOptions) {
+		o.transport = rt
+	}
+}
+
+// Limits the whole time of a single HTTP attempt, including reading the response body.
+func WithTimeout(d time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.timeout = d
+	}
+}
+
+// Verifies KT Cloud's certificate against the given CA pool instead of the system roots.
+func WithCACertPool(pool *x509.CertPool) ClientOption {
+	return func(o *clientOptions) {
+		o.rootCAs = pool
+	}
+}
+
+// Sets the proxy function of the transport. The default is http.ProxyFromEnvironment.
+func WithProxy(proxy func(*http.Request) (*url.URL, error)) ClientOption {
+	return func(o *clientOptions) {
+		o.proxy = proxy
+	}
+}
+
+// Sends every API call through the given proxy URL. (ex. "http://proxy.example.com:3128")
+func WithProxyURL(proxyURL *url.URL) ClientOption {
+	return WithProxy(http.ProxyURL(proxyURL))
+}
+
+// Sets the User-Agent header of every API call.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(o *clientOptions) {
+		o.userAgent = userAgent
+	}
+}
+
+// Replaces DefaultRetryPolicy(). A nil policy disables retries.
+func WithRetryPolicy(policy *RetryPolicy) ClientOption {
+	return func(o *clientOptions) {
+		o.retryPolicy = policy
+	}
+}
+
+// Sends a request as a signed form-encoded POST when its GET URL would be longer than n bytes.
+// 0 or less never switches to POST automatically.
+func WithPOSTThreshold(n int) ClientOption {
+	return func(o *clientOptions) {
+		o.postThreshold = n
+	}
+}
+
+// Sends every request as a signed form-encoded POST.
+func WithForcePOST() ClientOption {
+	return func(o *clientOptions) {
+		o.forcePOST = true
+	}
+}
+
+// Makes every signed request expire after d (signature version 3 with 'expires'),
+// so that a request URL leaked through logs or proxies can't be replayed later.
+// 'expires' has a precision of one second : the expiry time is rounded up to the second,
+// and a d under a second is taken as one second. Leave d at 0 for no expiry.
+func WithSignatureExpiry(d time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.signatureExpiry = d
+	}
+}
+
+// Takes the keys (and the endpoint, if apiUrl is empty) from the provider on every API call.
+// (ex. WithCredentials(DefaultCredentialsChain()))
+func WithCredentials(provider CredentialsProvider) ClientOption {
+	return func(o *clientOptions) {
+		o.credentials = provider
+	}
+}
+
+// Logs every API call (command, params, HTTP status and latency) at debug level to the SDK's cb-log logger.
+// With logBodies, the response bodies are logged as well. Keys, signatures, passwords and private keys are always masked.
+// The cb-log configuration must allow the debug level for the log to show up.
+func WithDebugLogging(logBodies bool) ClientOption {
+	return WithDebugLogger(cblogger, logBodies)
+}
+
+// Like WithDebugLogging(), but logs to the given Logger.
+func WithDebugLogger(logger Logger, logBodies bool) ClientOption {
+	return func(o *clientOptions) {
+		if logger == nil {
+			o.debug = nil
+			return
+		}
+		o.debug = &debugLogger{logger: logger, logBodies: logBodies}
+	}
+}
+
+// Adds interceptors that run around every API call. The first one given is the outermost.
+func WithInterceptors(interceptors ...Interceptor) ClientOption {
+	return func(o *clientOptions) {
+		o.interceptors = append(o.interceptors, interceptors...)
+	}
+}
+
+// Records a span per API command, and per poll of the waiters, with the given TracerProvider.
+func WithTracerProvider(tp trace.TracerProvider) ClientOption {
+	return func(o *clientOptions) {
+		o.tracerProvider = tp
+	}
+}
+
+// Records the count, errors and latency of the API calls with the given MeterProvider.
+func WithMeterProvider(mp metric.MeterProvider) ClientOption {
+	return func(o *clientOptions) {
+		o.meterProvider = mp
+	}
+}
+
+// Enables the OpenTelemetry spans and metrics with the global providers of the otel package.
+func WithOpenTelemetry() ClientOption {
+	return func(o *clientOptions) {
+		o.tracerProvider = otel.GetTracerProvider()
+		o.meterProvider = otel.GetMeterProvider()
+	}
+}
+
+// telemetry returns nil when no OpenTelemetry option is given.
+func (o *clientOptions) telemetry() *telemetry {
+	if o.tracerProvider == nil && o.meterProvider == nil {
+		return nil
+	}
+	return newTelemetry(o.tracerProvider, o.meterProvider)
+}
+
+// Throttles every API call (each retry included) with the given RateLimiter.
+// Give the same RateLimiter to several clients to make them share one budget per endpoint.
+func WithRateLimiter(limiter *RateLimiter) ClientOption {
+	return func(o *clientOptions) {
+		o.rateLimiter = limiter
+	}
+}
+
+// Throttles every API call with a new RateLimiter of the given default limit.
+func WithRateLimit(limit RateLimit) ClientOption {
+	return WithRateLimiter(NewRateLimiter(limit))
+}
+
+// Serves the read-only commands of DefaultCacheTTLs from the given Cache (ex. NewMemoryCache()) until their TTL expires.
+// Template and tag changes made through the client invalidate the related cached lists, also when their job is over.
+func WithCache(cache Cache) ClientOption {
+	return func(o *clientOptions) {
+		o.cache = cache
+	}
+}
+
+// Sets the cache TTL of a read-only command. 0 stops caching it. Only effective along with WithCache().
+func WithCacheTTL(command string, ttl time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		if o.cacheTTLs == nil {
+			o.cacheTTLs = make(map[string]time.Duration)
+		}
+		o.cacheTTLs[command] = ttl
+	}
+}
+
+// Drops the cached responses of the invalidated commands whenever the given command succeeds.
+// If it starts an async job, they are dropped again when the job is over, and not cached until then.
+// (ex. WithCacheInvalidation("deployVirtualMachine", "listAvailableProductTypes"))
+func WithCacheInvalidation(command string, invalidated ...string) ClientOption {
+	return func(o *clientOptions) {
+		if o.cacheInvalidations == nil {
+			o.cacheInvalidations = make(map[string][]string)
+		}
+		o.cacheInvalidations[command] = append(o.cacheInvalidations[command], invalidated...)
+	}
+}
+
+// Sends the requests as given, without checking them with their Validate() method first.
+// Invalid requests are then rejected by KT Cloud, if at all.
+func WithoutValidation() ClientOption {
+	return func(o *clientOptions) {
+		o.skipValidation = true
+	}
+}
+
+// responseCache returns nil when WithCache() isn't given.
+func (o *clientOptions) responseCache() *responseCache {
+	if o.cache == nil {
+		return nil
+	}
+	rc := newResponseCache(o.cache)
+	for command, ttl := range o.cacheTTLs {
+		rc.ttls[command] = ttl
+	}
+	for command, invalidated := range o.cacheInvalidations {
+		rc.invalidations[command] = append(rc.invalidations[command], invalidated...)
+	}
+	return rc
+}
+
+// buildHTTPClient creates the http.Client of a KtCloudClient from the options.
+func (o *clientOptions) buildHTTPClient(insecureSkipVerify bool) *http.Client {
+	if o.httpClient != nil {
+		hc := *o.httpClient
+		if o.transport != nil {
+			hc.Transport = o.transport
+		}
+		if o.timeout > 0 {
+			hc.Timeout = o.timeout
+		}
+		return &hc
+	}
+
+	transport := o.transport
+	if transport == nil {
+		proxy := o.proxy
+		if proxy == nil {
+			proxy = http.ProxyFromEnvironment
+		}
+		transport = &http.Transport{
+			Proxy: proxy,
+			DialContext: (&net.Dialer{
+				Timeout:   30 * time.Second,
+				KeepAlive: 30 * time.Second,
+			}).DialContext,
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: insecureSkipVerify,
+				RootCAs:            o.rootCAs,
+			},
+			TLSHandshakeTimeout:   10 * time.Second,
+			IdleConnTimeout:       90 * time.Second,
+			ExpectContinueTimeout: 1 * time.Second,
+			MaxIdleConnsPerHost:   10,
+		}
+	}
+	return &http.Client{
+		Transport: transport,
+		Timeout:   o.timeout,
+	}
+}
@@ -0,0 +1,181 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk_test
+
+import (
+	"crypto/x509"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+	"github.com/cloud-barista/ktcloud-sdk-go/ktcloudtest"
+)
+
+func listZones(client *ktsdk.KtCloudClient) error {
+	_, err := client.ListZones(true, "", "", "")
+	return err
+}
+
+func TestWithHTTPClient(t *testing.T) {
+	srv := ktcloudtest.NewServer()
+	defer srv.Close()
+	var requests requestRecorder
+	hc := &http.Client{Transport: &requests}
+
+	client := ktsdk.KtCloudClient{}.New(srv.URL, srv.APIKey, srv.SecretKey, false, ktsdk.WithHTTPClient(hc), ktsdk.WithTimeout(time.Minute))
+	if err := listZones(client); err != nil {
+		t.Fatalf("ListZones() = %v", err)
+	}
+	if requests.count() != 1 {
+		t.Errorf("%d requests through the given http.Client, want 1", requests.count())
+	}
+	// WithTimeout() applies to a copy : the given http.Client is left as is.
+	if client.HTTPClient().Timeout != time.Minute || hc.Timeout != 0 {
+		t.Errorf("Timeout = %v of the client, %v of the given one, want %v and 0", client.HTTPClient().Timeout, hc.Timeout, time.Minute)
+	}
+}
+
+func TestWithTransport(t *testing.T) {
+	srv := ktcloudtest.NewServer()
+	defer srv.Close()
+	var requests requestRecorder
+
+	// Also replaces the transport of a given http.Client.
+	for _, client := range []*ktsdk.KtCloudClient{
+		ktsdk.KtCloudClient{}.New(srv.URL, srv.APIKey, srv.SecretKey, false, ktsdk.WithTransport(&requests)),
+		srv.Client(ktsdk.WithTransport(&requests)),
+	} {
+		if err := listZones(client); err != nil {
+			t.Fatalf("ListZones() = %v", err)
+		}
+	}
+	if requests.count() != 2 {
+		t.Errorf("%d requests through the transport, want 2", requests.count())
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	srv := ktcloudtest.NewServer()
+	defer srv.Close()
+	srv.InjectFault(ktcloudtest.Fault{Command: "listZones", Delay: time.Second})
+
+	for _, client := range []*ktsdk.KtCloudClient{
+		ktsdk.KtCloudClient{}.New(srv.URL, srv.APIKey, srv.SecretKey, false, ktsdk.WithTimeout(100*time.Millisecond), ktsdk.WithRetryPolicy(nil)),
+		srv.Client(ktsdk.WithTimeout(100*time.Millisecond), ktsdk.WithRetryPolicy(nil)),
+	} {
+		start := time.Now()
+		err := listZones(client)
+		var netErr interface{ Timeout() bool }
+		if !errors.As(err, &netErr) || !netErr.Timeout() {
+			t.Errorf("ListZones() = %v, want a timeout", err)
+		}
+		if elapsed := time.Since(start); elapsed > 800*time.Millisecond {
+			t.Errorf("ListZones() took %v, want the timeout to cut it", elapsed)
+		}
+	}
+}
+
+func TestWithCACertPool(t *testing.T) {
+	srv := ktcloudtest.NewServer()
+	defer srv.Close()
+	tlsSrv := httptest.NewTLSServer(srv.Config.Handler)
+	defer tlsSrv.Close()
+	pool := x509.NewCertPool()
+	pool.AddCert(tlsSrv.Certificate())
+
+	tests := []struct {
+		name     string
+		insecure bool
+		opts     []ktsdk.ClientOption
+		wantErr  bool
+	}{
+		{"system roots", false, nil, true},
+		{"CA pool", false, []ktsdk.ClientOption{ktsdk.WithCACertPool(pool)}, false},
+		{"insecure", true, nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := append([]ktsdk.ClientOption{ktsdk.WithRetryPolicy(nil)}, tt.opts...)
+			client := ktsdk.KtCloudClient{}.New(tlsSrv.URL, srv.APIKey, srv.SecretKey, tt.insecure, opts...)
+			err := listZones(client)
+			var certErr x509.UnknownAuthorityError
+			if tt.wantErr && !errors.As(err, &certErr) {
+				t.Errorf("ListZones() = %v, want an unknown authority error", err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("ListZones() = %v", err)
+			}
+		})
+	}
+}
+
+func TestWithProxy(t *testing.T) {
+	srv := ktcloudtest.NewServer()
+	defer srv.Close()
+	// A forward proxy for plain HTTP : it gets the absolute URL and passes the request on.
+	var proxied atomic.Int32
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied.Add(1)
+		r.RequestURI = ""
+		resp, err := http.DefaultTransport.RoundTrip(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		defer resp.Body.Close()
+		w.WriteHeader(resp.StatusCode)
+		io.Copy(w, resp.Body)
+	}))
+	defer proxy.Close()
+	proxyURL, _ := url.Parse(proxy.URL)
+
+	for _, opt := range []ktsdk.ClientOption{
+		ktsdk.WithProxyURL(proxyURL),
+		ktsdk.WithProxy(func(r *http.Request) (*url.URL, error) { return proxyURL, nil }),
+	} {
+		client := ktsdk.KtCloudClient{}.New(srv.URL, srv.APIKey, srv.SecretKey, false, opt)
+		if err := listZones(client); err != nil {
+			t.Fatalf("ListZones() = %v", err)
+		}
+	}
+	if proxied.Load() != 2 {
+		t.Errorf("%d requests through the proxy, want 2", proxied.Load())
+	}
+}
+
+func TestWithUserAgent(t *testing.T) {
+	srv := ktcloudtest.NewServer()
+	defer srv.Close()
+
+	tests := []struct {
+		name string
+		opts []ktsdk.ClientOption
+		want string
+	}{
+		{"default", nil, ktsdk.DefaultUserAgent},
+		{"custom", []ktsdk.ClientOption{ktsdk.WithUserAgent("cb-spider/1.0")}, "cb-spider/1.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests requestRecorder
+			client := srv.Client(append(tt.opts, ktsdk.WithTransport(&requests))...)
+			if err := listZones(client); err != nil {
+				t.Fatalf("ListZones() = %v", err)
+			}
+			if got := requests.requests[0].Header.Get("User-Agent"); got != tt.want {
+				t.Errorf("User-Agent = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strconv"
+)
+
+// Page size used by the ListAll* helpers and the All* iterators when the request has no PageSize.
+const DefaultPageSize = 100
+
+// Most pages the ListAll* helpers and the All* iterators fetch. Past it, they fail with an error
+// rather than keep going, in case KT Cloud ignores the paging.
+const MaxPages = 1000
+
+// pageFetcher returns a page of items, and the total count of the items reported by KT Cloud (0 when unknown).
+type pageFetcher[T any] func(ctx context.Context, page string, pageSize string) ([]T, int, error)
+
+// pages walks the pages from the first one, fetching each only when the previous one is used up.
+// It stops after the item reported by Count (or without a Count, on a short page), on a page without any new item
+// (by key, ex. the page is sent again), or when the caller breaks. Items already seen are skipped.
+// Past MaxPages, it yields an error. fetch must not modify what it captures, as the iterator may be ranged over
+// several times, also concurrently.
+func pages[T any](ctx context.Context, pageSize string, key func(T) string, fetch pageFetcher[T]) iter.Seq2[T, error] {
+	size, err := strconv.Atoi(pageSize)
+	if err != nil || size <= 0 {
+		size = DefaultPageSize
+	}
+	return func(yield func(T, error) bool) {
+		var zero T
+		seen := make(map[string]bool)
+		for page := 1; ; page++ {
+			if page > MaxPages {
+				yield(zero, fmt.Errorf("Failed to List all the items : there are more than %d pages of %d items", MaxPages, size))
+				return
+			}
+			items, count, err := fetch(ctx, strconv.Itoa(page), strconv.Itoa(size))
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			newItems := 0
+			for _, item := range items {
+				if seen[key(item)] {
+					continue
+				}
+				seen[key(item)] = true
+				newItems++
+				if !yield(item, nil) {
+					return
+				}
+			}
+			// With a count, a short page doesn't mean the end : KT Cloud may cap the page size below the requested one.
+			if newItems == 0 || (count > 0 && len(seen) >= count) || (count <= 0 && len(items) < size) {
+				return
+			}
+		}
+	}
+}
+
+// collect gathers every item of seq, stopping at the first error.
+func collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var all []T
+	for item, err := range seq {
+		if err != nil {
+			return all, err
+		}
+		all = append(all, item)
+	}
+	return all, nil
+}
+
+// Returns an iterator over the VMs matching vmListReqInfo, fetched page by page. vmListReqInfo.Page is ignored.
+func (c KtCloudClient) AllVirtualMachines(ctx context.Context, vmListReqInfo ListVMReqInfo) iter.Seq2[Virtualmachine, error] {
+	return pages(ctx, vmListReqInfo.PageSize, func(item Virtualmachine) string { return item.ID }, func(ctx context.Context, page string, pageSize string) ([]Virtualmachine, int, error) {
+		pageReq := vmListReqInfo
+		pageReq.Page, pageReq.PageSize = page, pageSize
+		resp, err := c.ListVirtualMachinesWithContext(ctx, pageReq)
+		return resp.Listvirtualmachinesresponse.Virtualmachine, int(resp.Listvirtualmachinesresponse.Count), err
+	})
+}
+
+// Returns all the VMs matching vmListReqInfo, walking every page.
+func (c KtCloudClient) ListAllVirtualMachines(vmListReqInfo ListVMReqInfo) ([]Virtualmachine, error) {
+	return c.ListAllVirtualMachinesWithContext(context.Background(), vmListReqInfo)
+}
+
+// ListAllVirtualMachinesWithContext is ListAllVirtualMachines with a context that bounds the underlying API calls.
+func (c KtCloudClient) ListAllVirtualMachinesWithContext(ctx context.Context, vmListReqInfo ListVMReqInfo) ([]Virtualmachine, error) {
+	return collect(c.AllVirtualMachines(ctx, vmListReqInfo))
+}
+
+// Returns an iterator over the volumes matching req, fetched page by page. req.Page is ignored.
+func (c KtCloudClient) AllVolumes(ctx context.Context, req ListVolumeReqInfo) iter.Seq2[Volume, error] {
+	return pages(ctx, req.PageSize, func(item Volume) string { return item.ID }, func(ctx context.Context, page string, pageSize string) ([]Volume, int, error) {
+		pageReq := req
+		pageReq.Page, pageReq.PageSize = page, pageSize
+		resp, err := c.ListVolumesWithContext(ctx, pageReq)
+		return resp.Listvolumesresponse.Volume, int(resp.Listvolumesresponse.Count), err
+	})
+}
+
+// Returns all the volumes matching req, walking every page.
+func (c KtCloudClient) ListAllVolumes(req ListVolumeReqInfo) ([]Volume, error) {
+	return c.ListAllVolumesWithContext(context.Background(), req)
+}
+
+// ListAllVolumesWithContext is ListAllVolumes with a context that bounds the underlying API calls.
+func (c KtCloudClient) ListAllVolumesWithContext(ctx context.Context, req ListVolumeReqInfo) ([]Volume, error) {
+	return collect(c.AllVolumes(ctx, req))
+}
+
+// Returns an iterator over the public IPs matching req, fetched page by page. req.Page is ignored.
+func (c KtCloudClient) AllPublicIpAddresses(ctx context.Context, req ListPublicIpReqInfo) iter.Seq2[PublicIpAddress, error] {
+	return pages(ctx, req.PageSize, func(item PublicIpAddress) string { return item.ID }, func(ctx context.Context, page string, pageSize string) ([]PublicIpAddress, int, error) {
+		pageReq := req
+		pageReq.Page, pageReq.PageSize = page, pageSize
+		resp, err := c.ListPublicIpAddressesWithContext(ctx, pageReq)
+		return resp.Listpublicipaddressesresponse.PublicIpAddress, int(resp.Listpublicipaddressesresponse.Count), err
+	})
+}
+
+// Returns all the public IPs matching req, walking every page.
+func (c KtCloudClient) ListAllPublicIpAddresses(req ListPublicIpReqInfo) ([]PublicIpAddress, error) {
+	return c.ListAllPublicIpAddressesWithContext(context.Background(), req)
+}
+
+// ListAllPublicIpAddressesWithContext is ListAllPublicIpAddresses with a context that bounds the underlying API calls.
+func (c KtCloudClient) ListAllPublicIpAddressesWithContext(ctx context.Context, req ListPublicIpReqInfo) ([]PublicIpAddress, error) {
+	return collect(c.AllPublicIpAddresses(ctx, req))
+}
+
+// Returns an iterator over the firewall rules matching req, fetched page by page. req.Page is ignored.
+func (c KtCloudClient) AllFirewallRules(ctx context.Context, req ListFirewallRulesReqInfo) iter.Seq2[FirewallRule, error] {
+	return pages(ctx, req.PageSize, func(item FirewallRule) string { return item.ID }, func(ctx context.Context, page string, pageSize string) ([]FirewallRule, int, error) {
+		pageReq := req
+		pageReq.Page, pageReq.PageSize = page, pageSize
+		resp, err := c.ListFirewallRulesWithContext(ctx, pageReq)
+		return resp.Listfirewallrulesresponse.FirewallRule, int(resp.Listfirewallrulesresponse.Count), err
+	})
+}
+
+// Returns all the firewall rules matching req, walking every page.
+func (c KtCloudClient) ListAllFirewallRules(req ListFirewallRulesReqInfo) ([]FirewallRule, error) {
+	return c.ListAllFirewallRulesWithContext(context.Background(), req)
+}
+
+// ListAllFirewallRulesWithContext is ListAllFirewallRules with a context that bounds the underlying API calls.
+func (c KtCloudClient) ListAllFirewallRulesWithContext(ctx context.Context, req ListFirewallRulesReqInfo) ([]FirewallRule, error) {
+	return collect(c.AllFirewallRules(ctx, req))
+}
+
+// Returns an iterator over the port forwarding rules matching req, fetched page by page. req.Page is ignored.
+func (c KtCloudClient) AllPortForwardingRules(ctx context.Context, req ListPortForwardingRulesReqInfo) iter.Seq2[PortForwardingRule, error] {
+	return pages(ctx, req.PageSize, func(item PortForwardingRule) string { return item.ID }, func(ctx context.Context, page string, pageSize string) ([]PortForwardingRule, int, error) {
+		pageReq := req
+		pageReq.Page, pageReq.PageSize = page, pageSize
+		resp, err := c.ListPortForwardingRulesWithContext(ctx, pageReq)
+		return resp.Listportforwardingrulesresponse.PortForwardingRule, int(resp.Listportforwardingrulesresponse.Count), err
+	})
+}
+
+// Returns all the port forwarding rules matching req, walking every page.
+func (c KtCloudClient) ListAllPortForwardingRules(req ListPortForwardingRulesReqInfo) ([]PortForwardingRule, error) {
+	return c.ListAllPortForwardingRulesWithContext(context.Background(), req)
+}
+
+// ListAllPortForwardingRulesWithContext is ListAllPortForwardingRules with a context that bounds the underlying API calls.
+func (c KtCloudClient) ListAllPortForwardingRulesWithContext(ctx context.Context, req ListPortForwardingRulesReqInfo) ([]PortForwardingRule, error) {
+	return collect(c.AllPortForwardingRules(ctx, req))
+}
+
+// Returns an iterator over the templates matching req, fetched page by page. req.Page is ignored, and req isn't modified.
+func (c KtCloudClient) AllTemplates(ctx context.Context, req *ListTemplateReqInfo) iter.Seq2[Template, error] {
+	// A template is listed once per zone it is available in.
+	key := func(item Template) string { return item.ID + "|" + item.ZoneId }
+	return pages(ctx, req.PageSize, key, func(ctx context.Context, page string, pageSize string) ([]Template, int, error) {
+		pageReq := *req
+		pageReq.Page, pageReq.PageSize = page, pageSize
+		resp, err := c.ListTemplatesWithContext(ctx, &pageReq)
+		return resp.Listtemplatesresponse.Template, int(resp.Listtemplatesresponse.Count), err
+	})
+}
+
+// Returns all the templates matching req, walking every page.
+func (c KtCloudClient) ListAllTemplates(req *ListTemplateReqInfo) ([]Template, error) {
+	return c.ListAllTemplatesWithContext(context.Background(), req)
+}
+
+// ListAllTemplatesWithContext is ListAllTemplates with a context that bounds the underlying API calls.
+func (c KtCloudClient) ListAllTemplatesWithContext(ctx context.Context, req *ListTemplateReqInfo) ([]Template, error) {
+	return collect(c.AllTemplates(ctx, req))
+}
@@ -0,0 +1,125 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk_test
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"testing"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+	"github.com/cloud-barista/ktcloud-sdk-go/ktcloudtest"
+)
+
+func TestListAllVirtualMachines(t *testing.T) {
+	// ignorePaging makes the server send every VM on every page, without a count.
+	ignorePaging := func(ctx context.Context, command string, params url.Values, out interface{}, next ktsdk.Invoker) error {
+		if command != "listVirtualMachines" {
+			return next(ctx, command, params, out)
+		}
+		params.Del("page")
+		params.Del("pagesize")
+		err := next(ctx, command, params, out)
+		out.(*ktsdk.ListVirtualMachinesResponse).Listvirtualmachinesresponse.Count = 0
+		return err
+	}
+
+	// capPageSize makes the server send pages of 2 VMs at most, with the count.
+	capPageSize := func(ctx context.Context, command string, params url.Values, out interface{}, next ktsdk.Invoker) error {
+		if command == "listVirtualMachines" {
+			params.Set("pagesize", "2")
+		}
+		return next(ctx, command, params, out)
+	}
+
+	tests := []struct {
+		name     string
+		vms      int
+		pageSize string
+		opts     []ktsdk.ClientOption
+	}{
+		{"single page", 3, "", nil},
+		{"several pages", 5, "2", nil},
+		{"full last page", 4, "2", nil},
+		{"paging ignored", 2, "2", []ktsdk.ClientOption{ktsdk.WithInterceptors(ignorePaging)}},
+		{"paging ignored with a short page", 3, "2", []ktsdk.ClientOption{ktsdk.WithInterceptors(ignorePaging)}},
+		{"page size capped by the server", 5, "10", []ktsdk.ClientOption{ktsdk.WithInterceptors(capPageSize)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := ktcloudtest.NewServer()
+			defer srv.Close()
+			for i := 0; i < tt.vms; i++ {
+				deployRunningVM(t, srv.Client())
+			}
+
+			vms, err := srv.Client(tt.opts...).ListAllVirtualMachines(ktsdk.ListVMReqInfo{PageSize: tt.pageSize})
+			if err != nil {
+				t.Fatalf("ListAllVirtualMachines() = %v", err)
+			}
+			if len(vms) != tt.vms {
+				t.Errorf("ListAllVirtualMachines() listed %d VMs, want %d", len(vms), tt.vms)
+			}
+		})
+	}
+}
+
+func TestListAllVirtualMachinesMaxPages(t *testing.T) {
+	srv := ktcloudtest.NewServer()
+	defer srv.Close()
+
+	// endless sends a new VM on every page, without a count.
+	fetched := 0
+	endless := func(ctx context.Context, command string, params url.Values, out interface{}, next ktsdk.Invoker) error {
+		fetched++
+		r := &out.(*ktsdk.ListVirtualMachinesResponse).Listvirtualmachinesresponse
+		r.Virtualmachine = []ktsdk.Virtualmachine{{ID: params.Get("page")}}
+		return nil
+	}
+	vms, err := srv.Client(ktsdk.WithInterceptors(endless)).ListAllVirtualMachines(ktsdk.ListVMReqInfo{PageSize: "1"})
+	if err == nil {
+		t.Fatalf("ListAllVirtualMachines() = nil, want an error past %d pages", ktsdk.MaxPages)
+	}
+	if fetched != ktsdk.MaxPages || len(vms) != ktsdk.MaxPages {
+		t.Errorf("fetched %d pages and %d VMs, want %d of both", fetched, len(vms), ktsdk.MaxPages)
+	}
+}
+
+func TestAllVirtualMachinesRangedTwice(t *testing.T) {
+	srv := ktcloudtest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	for i := 0; i < 3; i++ {
+		deployRunningVM(t, client)
+	}
+
+	// The same iterator is ranged over several times, also at once.
+	seq := client.AllVirtualMachines(context.Background(), ktsdk.ListVMReqInfo{PageSize: "1"})
+	var wg sync.WaitGroup
+	counts := make([]int, 4)
+	for i := range counts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, err := range seq {
+				if err != nil {
+					t.Errorf("AllVirtualMachines() = %v", err)
+					return
+				}
+				counts[i]++
+			}
+		}()
+	}
+	wg.Wait()
+	for i, n := range counts {
+		if n != 3 {
+			t.Errorf("range %d listed %d VMs, want 3", i, n)
+		}
+	}
+}
@@ -8,13 +8,14 @@
 package ktcloudsdk
 
 import (
+	"context"
 	"net/url"
 )
 
 type CreatePortForwardingRuleReqInfo struct {
 	IpAddressId         	    string
 	PrivatePort      			string
-	Protocol         			string   //TCP or UDP
+	Protocol         			Protocol //TCP or UDP
 	PublicPort       			string	 //Port of the public IP Address
 	VirtualmachineId    		string
 	OpenFirewall  				bool
@@ -35,14 +36,46 @@ type ListPortForwardingRulesReqInfo struct {
 	ListAll				bool
 }
 
+// Checks the fields of the request before it is sent. A problem is returned as a *ValidationError.
+func (r CreatePortForwardingRuleReqInfo) Validate() error {
+	var errs fieldErrors
+	errs.required("IpAddressId", r.IpAddressId)
+	errs.required("VirtualmachineId", r.VirtualmachineId)
+	errs.required("Protocol", string(r.Protocol))
+	knownEnum(&errs, "Protocol", r.Protocol)
+	if r.Protocol.Canonical() == ProtocolICMP {
+		errs.add("Protocol", string(r.Protocol), "must be tcp or udp")
+	}
+	errs.required("PrivatePort", r.PrivatePort)
+	errs.portRange("PrivatePort", r.PrivatePort, "PrivateEndPort", r.PrivateEndPort)
+	errs.required("PublicPort", r.PublicPort)
+	errs.portRange("PublicPort", r.PublicPort, "PublicEndPort", r.PublicEndPort)
+	return errs.err("CreatePortForwardingRuleReqInfo")
+}
+
+// Checks the fields of the request before it is sent. A problem is returned as a *ValidationError.
+func (r ListPortForwardingRulesReqInfo) Validate() error {
+	var errs fieldErrors
+	errs.paging(r.Page, r.PageSize)
+	return errs.err("ListPortForwardingRulesReqInfo")
+}
+
 // Creates a PortForwardingRule
 func (c KtCloudClient) CreatePortForwardingRule(portForwardingRuleCreateReqInfo CreatePortForwardingRuleReqInfo) (CreatePortForwardingRuleResponse, error) {
+	return c.CreatePortForwardingRuleWithContext(context.Background(), portForwardingRuleCreateReqInfo)
+}
+
+// CreatePortForwardingRuleWithContext is CreatePortForwardingRule with a context that bounds the underlying API call.
+func (c KtCloudClient) CreatePortForwardingRuleWithContext(ctx context.Context, portForwardingRuleCreateReqInfo CreatePortForwardingRuleReqInfo) (CreatePortForwardingRuleResponse, error) {
 	var resp CreatePortForwardingRuleResponse
+	if err := c.validate(portForwardingRuleCreateReqInfo); err != nil {
+		return resp, err
+	}
 	params := url.Values{}
 
 	params.Set("ipaddressid", portForwardingRuleCreateReqInfo.IpAddressId)
 	params.Set("privateport", portForwardingRuleCreateReqInfo.PrivatePort)
-	params.Set("protocol", portForwardingRuleCreateReqInfo.Protocol)
+	params.Set("protocol", string(portForwardingRuleCreateReqInfo.Protocol.Canonical()))
 	params.Set("publicport", portForwardingRuleCreateReqInfo.PublicPort)
 	params.Set("virtualmachineid", portForwardingRuleCreateReqInfo.VirtualmachineId)
 
@@ -64,19 +97,24 @@ func (c KtCloudClient) CreatePortForwardingRule(portForwardingRuleCreateReqInfo
 		params.Set("publicendport", portForwardingRuleCreateReqInfo.PublicEndPort)
 	}
 	
-	response, err := NewRequest(c, "createPortForwardingRule", params)
-	if err != nil {
+	if err := c.Do(ctx, "createPortForwardingRule", params, &resp); err != nil {
 		return resp, err
 	}
-
-	resp = response.(CreatePortForwardingRuleResponse)
-	return resp, err
+	return resp, nil
 }
 
 
 // Returns all available templates
 func (c KtCloudClient) ListPortForwardingRules(portForwardingRulesListReqInfo ListPortForwardingRulesReqInfo) (ListPortForwardingRulesResponse, error) {
+	return c.ListPortForwardingRulesWithContext(context.Background(), portForwardingRulesListReqInfo)
+}
+
+// ListPortForwardingRulesWithContext is ListPortForwardingRules with a context that bounds the underlying API call.
+func (c KtCloudClient) ListPortForwardingRulesWithContext(ctx context.Context, portForwardingRulesListReqInfo ListPortForwardingRulesReqInfo) (ListPortForwardingRulesResponse, error) {
 	var resp ListPortForwardingRulesResponse
+	if err := c.validate(portForwardingRulesListReqInfo); err != nil {
+		return resp, err
+	}
 	params := url.Values{}
 
 	if portForwardingRulesListReqInfo.ID != "" {
@@ -115,37 +153,36 @@ func (c KtCloudClient) ListPortForwardingRules(portForwardingRulesListReqInfo Li
 		params.Set("listall", "true")
 	}
 	
-	response, err := NewRequest(c, "listPortForwardingRules", params)
-	if err != nil {
+	if err := c.Do(ctx, "listPortForwardingRules", params, &resp); err != nil {
 		return resp, err
 	}
-
-	resp = response.(ListPortForwardingRulesResponse)
-	return resp, err
+	return resp, nil
 }
 
 // Deletes a PortForwarding Rule by its ID.
 func (c KtCloudClient) DeletePortForwardingRule(ruleId string) (DeletePortForwardingRuleResponse, error) {
+	return c.DeletePortForwardingRuleWithContext(context.Background(), ruleId)
+}
+
+// DeletePortForwardingRuleWithContext is DeletePortForwardingRule with a context that bounds the underlying API call.
+func (c KtCloudClient) DeletePortForwardingRuleWithContext(ctx context.Context, ruleId string) (DeletePortForwardingRuleResponse, error) {
 	var resp DeletePortForwardingRuleResponse
 	params := url.Values{}
 	params.Set("id", ruleId)  // PortForwardingRule ID
 	
-	response, err := NewRequest(c, "deletePortForwardingRule", params)
-	if err != nil {
+	if err := c.Do(ctx, "deletePortForwardingRule", params, &resp); err != nil {
 		return resp, err
 	}
-
-	resp = response.(DeletePortForwardingRuleResponse)
-	return resp, err
+	return resp, nil
 }
 
 type PortForwardingRule struct {
 	ID               			string  		`json:"id"`
-	PrivatePort      			string  		`json:"privateport"`
-	PrivateEndPort   			string  		`json:"privateendport"`
-	Protocol         			string  		`json:"protocol"`
-	PublicPort       			string  		`json:"publicport"`
-	PublicEndPort    			string  		`json:"publicendport"`
+	PrivatePort      			FlexInt  		`json:"privateport"`
+	PrivateEndPort   			FlexInt  		`json:"privateendport"`
+	Protocol         			Protocol  		`json:"protocol"`
+	PublicPort       			FlexInt  		`json:"publicport"`
+	PublicEndPort    			FlexInt  		`json:"publicendport"`
 	VirtualmachineId    		string  		`json:"virtualmachineid"`
 	VirtualmachineName  		string  		`json:"virtualmachinename"`
 	VirtualmachineDisplayName	string  		`json:"virtualmachinedisplayname"`
@@ -153,10 +190,10 @@ type PortForwardingRule struct {
 	IpAddress         	  		string  		`json:"ipaddress"`
 	State            			string  		`json:"state"`
 	CidrList      				string  		`json:"cidrlist"`
-	Tags  			 	        []interface{}  	`json:"tags"`
+	Tags  			 	        Tags  			`json:"tags"`
 	VmGuestIp       			string  		`json:"vmguestip"`
 	NetworkId 					string  		`json:"networkid"`
-	ForDisplay				    bool    		`json:"fordisplay"`
+	ForDisplay				    FlexBool		`json:"fordisplay"`
 }
 
 
@@ -169,6 +206,7 @@ type CreatePortForwardingRuleResponse struct {
 
 type ListPortForwardingRulesResponse struct {
 	Listportforwardingrulesresponse struct {
+		Count              FlexInt                  `json:"count"`
 		PortForwardingRule []PortForwardingRule `json:"portforwardingrule"`
 	} `json:"listportforwardingrulesresponse"`
 }
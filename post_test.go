@@ -0,0 +1,122 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk_test
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+	"github.com/cloud-barista/ktcloud-sdk-go/ktcloudtest"
+)
+
+// sentRequest is what bodyRecorder keeps of a request.
+type sentRequest struct {
+	method      string
+	query       string
+	contentType string
+	body        string
+}
+
+// bodyRecorder is a transport keeping the method and the body of the requests sent through it.
+// tamper, if set, changes the body before it is sent on.
+type bodyRecorder struct {
+	tamper   func(body string) string
+	requests []sentRequest
+}
+
+func (br *bodyRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body string
+	if req.Body != nil {
+		data, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		body = string(data)
+		if br.tamper != nil {
+			body = br.tamper(body)
+		}
+		req.Body = io.NopCloser(strings.NewReader(body))
+		req.ContentLength = int64(len(body))
+	}
+	br.requests = append(br.requests, sentRequest{method: req.Method, query: req.URL.RawQuery, contentType: req.Header.Get("Content-Type"), body: body})
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestPOST(t *testing.T) {
+	deployWithUserData := func(size int) func(c *ktsdk.KtCloudClient) error {
+		return func(c *ktsdk.KtCloudClient) error {
+			_, err := c.DeployVirtualMachine(ktsdk.DeployVMReqInfo{ZoneId: ktcloudtest.ZoneSeoulM, ServiceOfferingId: "s", TemplateId: "t",
+				UserData: strings.Repeat("#", size)})
+			return err
+		}
+	}
+
+	tests := []struct {
+		name       string
+		opts       []ktsdk.ClientOption
+		call       func(c *ktsdk.KtCloudClient) error
+		wantMethod string
+	}{
+		{"short request", nil, listZones, http.MethodGet},
+		{"just under the threshold", nil, deployWithUserData(1200), http.MethodGet},
+		{"over the threshold", nil, deployWithUserData(1500), http.MethodPost},
+		{"large userdata", nil, deployWithUserData(32 * 1024), http.MethodPost},
+		{"lower threshold", []ktsdk.ClientOption{ktsdk.WithPOSTThreshold(100)}, listZones, http.MethodPost},
+		{"no threshold", []ktsdk.ClientOption{ktsdk.WithPOSTThreshold(0)}, deployWithUserData(4096), http.MethodGet},
+		{"forced", []ktsdk.ClientOption{ktsdk.WithForcePOST()}, listZones, http.MethodPost},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := ktcloudtest.NewServer()
+			defer srv.Close()
+			var requests bodyRecorder
+			client := srv.Client(append(tt.opts, ktsdk.WithTransport(&requests))...)
+
+			// The server checks the signature wherever the params are, so a successful call means it verified.
+			if err := tt.call(client); err != nil {
+				t.Fatalf("call = %v", err)
+			}
+			sent := requests.requests[0]
+			if sent.method != tt.wantMethod {
+				t.Fatalf("method = %s, want %s", sent.method, tt.wantMethod)
+			}
+			signed := sent.query
+			if tt.wantMethod == http.MethodPost {
+				if sent.query != "" || sent.contentType != "application/x-www-form-urlencoded" {
+					t.Errorf("POST with query %q and Content-Type %q, want the params in a form body only", sent.query, sent.contentType)
+				}
+				signed = sent.body
+			}
+			params, err := url.ParseQuery(signed)
+			if err != nil || params.Get("signature") == "" || params.Get("apikey") != srv.APIKey {
+				t.Errorf("params = %q, want them signed", signed)
+			}
+		})
+	}
+}
+
+func TestPOSTSignatureCoversBody(t *testing.T) {
+	srv := ktcloudtest.NewServer()
+	defer srv.Close()
+	requests := bodyRecorder{tamper: func(body string) string {
+		return strings.Replace(body, "available=true", "available=false", 1)
+	}}
+	client := srv.Client(ktsdk.WithForcePOST(), ktsdk.WithTransport(&requests), ktsdk.WithRetryPolicy(nil))
+
+	if err := listZones(client); !ktsdk.IsAuthError(err) {
+		t.Errorf("ListZones() with a changed body = %v, want an auth error", err)
+	}
+	if len(requests.requests) != 1 || !strings.Contains(requests.requests[0].body, "available=false") {
+		t.Fatalf("requests = %+v, want the changed body sent", requests.requests)
+	}
+}
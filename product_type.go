@@ -8,10 +8,16 @@
 package ktcloudsdk
 
 import (
+	"context"
 	"net/url"
 )
 
 func (c KtCloudClient) ListAvailableProductTypes(zoneId string) (ListAvailableProductTypesResponse, error) {
+	return c.ListAvailableProductTypesWithContext(context.Background(), zoneId)
+}
+
+// ListAvailableProductTypesWithContext is ListAvailableProductTypes with a context that bounds the underlying API call.
+func (c KtCloudClient) ListAvailableProductTypesWithContext(ctx context.Context, zoneId string) (ListAvailableProductTypesResponse, error) {
 	var resp ListAvailableProductTypesResponse
 	params := url.Values{}
 
@@ -19,12 +25,10 @@ func (c KtCloudClient) ListAvailableProductTypes(zoneId string) (ListAvailablePr
 		params.Set("zoneid", zoneId)
 	}
 
-	response, err := NewRequest(c, "listAvailableProductTypes", params)
-	if err != nil {
+	if err := c.Do(ctx, "listAvailableProductTypes", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(ListAvailableProductTypesResponse)
-	return resp, err
+	return resp, nil
 }
 
 type ProductTypes struct {
@@ -43,7 +47,7 @@ type ProductTypes struct {
 
 type ListAvailableProductTypesResponse struct {
 	Listavailableproducttypesresponse struct {
-		Count          int          `json:"count"`
+		Count          FlexInt          `json:"count"`
 		ProductTypes []ProductTypes `json:"producttypes"`
 	} `json:"listavailableproducttypesresponse"`
 }
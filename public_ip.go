@@ -8,12 +8,14 @@
 package ktcloudsdk
 
 import (
+	"context"
 	"net/url"
+	"strings"
 )
 
 type AssociatePublicIpReqInfo struct {
 	ZoneId 				string
-	UsagePlanType		string
+	UsagePlanType		UsagePlanType
 	Account 			string
 	DomainId 			string
 	NetworkId			string
@@ -39,15 +41,40 @@ type ListPublicIpReqInfo struct {
 	ListAll				bool
 }
 
+// Checks the fields of the request before it is sent. A problem is returned as a *ValidationError.
+func (r AssociatePublicIpReqInfo) Validate() error {
+	var errs fieldErrors
+	errs.required("ZoneId", r.ZoneId)
+	knownEnum(&errs, "UsagePlanType", r.UsagePlanType)
+	return errs.err("AssociatePublicIpReqInfo")
+}
+
+// Checks the fields of the request before it is sent. A problem is returned as a *ValidationError.
+func (r ListPublicIpReqInfo) Validate() error {
+	var errs fieldErrors
+	errs.ip("IpAddress", r.IpAddress)
+	errs.paging(r.Page, r.PageSize)
+	errs.boolean("ForVirtualNetwork", r.ForVirtualNetwork)
+	errs.boolean("AllocatedOnly", r.AllocatedOnly)
+	return errs.err("ListPublicIpReqInfo")
+}
 
 func (c KtCloudClient) AssociateIpAddress(ipReqInfo AssociatePublicIpReqInfo) (AssociateIpAddressResponse, error) {
+	return c.AssociateIpAddressWithContext(context.Background(), ipReqInfo)
+}
+
+// AssociateIpAddressWithContext is AssociateIpAddress with a context that bounds the underlying API call.
+func (c KtCloudClient) AssociateIpAddressWithContext(ctx context.Context, ipReqInfo AssociatePublicIpReqInfo) (AssociateIpAddressResponse, error) {
 	var resp AssociateIpAddressResponse
+	if err := c.validate(ipReqInfo); err != nil {
+		return resp, err
+	}
 	params := url.Values{}
 
 	params.Set("zoneid", ipReqInfo.ZoneId)
 
 	if ipReqInfo.UsagePlanType != "" {
-		params.Set("usageplantype", ipReqInfo.UsagePlanType)
+		params.Set("usageplantype", string(ipReqInfo.UsagePlanType.Canonical()))
 	}
 	
 	if ipReqInfo.Account != "" {
@@ -62,17 +89,23 @@ func (c KtCloudClient) AssociateIpAddress(ipReqInfo AssociatePublicIpReqInfo) (A
 		params.Set("networkid", ipReqInfo.NetworkId)
 	}
 
-	response, err := NewRequest(c, "associateIpAddress", params)
-	if err != nil {
+	if err := c.Do(ctx, "associateIpAddress", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(AssociateIpAddressResponse)
-	return resp, err
+	return resp, nil
 }
 
 
 func (c KtCloudClient) ListPublicIpAddresses(ipListReqInfo ListPublicIpReqInfo) (ListPublicIpAddressesResponse, error) {
+	return c.ListPublicIpAddressesWithContext(context.Background(), ipListReqInfo)
+}
+
+// ListPublicIpAddressesWithContext is ListPublicIpAddresses with a context that bounds the underlying API call.
+func (c KtCloudClient) ListPublicIpAddressesWithContext(ctx context.Context, ipListReqInfo ListPublicIpReqInfo) (ListPublicIpAddressesResponse, error) {
 	var resp ListPublicIpAddressesResponse
+	if err := c.validate(ipListReqInfo); err != nil {
+		return resp, err
+	}
 	params := url.Values{}
 
 	if ipListReqInfo.ID != "" {
@@ -132,60 +165,61 @@ func (c KtCloudClient) ListPublicIpAddresses(ipListReqInfo ListPublicIpReqInfo)
 	}
 
 	if ipListReqInfo.ForVirtualNetwork != "" {
-		params.Set("forvirtualnetwork", ipListReqInfo.ForVirtualNetwork)
+		params.Set("forvirtualnetwork", strings.ToLower(ipListReqInfo.ForVirtualNetwork))
 	}
 
 	if ipListReqInfo.AllocatedOnly != "" {
-		params.Set("allocatedonly", ipListReqInfo.AllocatedOnly)
+		params.Set("allocatedonly", strings.ToLower(ipListReqInfo.AllocatedOnly))
 	}
 
 	if ipListReqInfo.ListAll {
 		params.Set("listall", "true")
 	}
 
-	response, err := NewRequest(c, "listPublicIpAddresses", params)
-	if err != nil {
+	if err := c.Do(ctx, "listPublicIpAddresses", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(ListPublicIpAddressesResponse)
-	return resp, err
+	return resp, nil
 }
 
 func (c KtCloudClient) DisassociateIpAddress(publicIpId string) (DisassociateIpAddressResponse, error) {
+	return c.DisassociateIpAddressWithContext(context.Background(), publicIpId)
+}
+
+// DisassociateIpAddressWithContext is DisassociateIpAddress with a context that bounds the underlying API call.
+func (c KtCloudClient) DisassociateIpAddressWithContext(ctx context.Context, publicIpId string) (DisassociateIpAddressResponse, error) {
 	var resp DisassociateIpAddressResponse
 	params := url.Values{}
 
 	params.Set("id", publicIpId)
 
-	response, err := NewRequest(c, "disassociateIpAddress", params)
-	if err != nil {
+	if err := c.Do(ctx, "disassociateIpAddress", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(DisassociateIpAddressResponse)
-	return resp, err
+	return resp, nil
 }
 
 type PublicIpAddress struct {
 	ID                    string            `json:"id"`
 	IpAddress			  string 			`json:"ipaddress"`
-	Allocated		      string 			`json:"allocated"`
+	Allocated		      Timestamp			`json:"allocated"`
 	ZoneId           	  string        	`json:"zoneid"`
 	ZoneName              string        	`json:"zonename"`
-	IsSourcenat 		  bool              `json:"issourcenat"`
+	IsSourcenat 		  FlexBool          `json:"issourcenat"`
 	Account           	  string        	`json:"account"`
 	DomainId         	  string        	`json:"domainid"`
 	Domain                string        	`json:"domain"`
-	ForVirtualNetwork	  string        	`json:"forvirtualnetwork"`
-	IsStaticNat 		  bool              `json:"isstaticnat"`
-	IsSystem 		 	  bool              `json:"issystem"`
+	ForVirtualNetwork	  FlexBool      	`json:"forvirtualnetwork"`
+	IsStaticNat 		  FlexBool          `json:"isstaticnat"`
+	IsSystem 		 	  FlexBool          `json:"issystem"`
 	AssociatedNetworkId   string            `json:"associatednetworkid"`
 	AssociatedNetworkName   string          `json:"associatednetworkname"`
 	NetworktId            string            `json:"networkid"`
 	State                 string            `json:"state"`
 	PhysicalNetworkId     string            `json:"physicalnetworkid"`
-	Tags                  []interface{} 	`json:"tags"`
-	IsPortable 		 	  bool              `json:"isportable"`
-	UsagePlanType         string            `json:"usageplantype"`
+	Tags                  Tags 			`json:"tags"`
+	IsPortable 		 	  FlexBool          `json:"isportable"`
+	UsagePlanType         UsagePlanType            `json:"usageplantype"`
 	Desc                  string            `json:"desc"`
 }
 
@@ -198,7 +232,7 @@ type AssociateIpAddressResponse struct {
 
 type ListPublicIpAddressesResponse struct {
 	Listpublicipaddressesresponse struct {
-		Count          	  int          			`json:"count"`
+		Count          	  FlexInt          			`json:"count"`
 		PublicIpAddress   []PublicIpAddress 	`json:"publicipaddress"`
 	} `json:"listpublicipaddressesresponse"`
 }
@@ -0,0 +1,218 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimit is a token bucket plus a cap on concurrent calls.
+type RateLimit struct {
+	RequestsPerSecond float64 // Sustained rate. 0 means no rate limit.
+	Burst             int     // Calls allowed at once above the rate. Defaults to 1.
+	MaxInFlight       int     // Calls running at the same time. 0 means no cap.
+}
+
+// RateLimiter throttles the API calls of one or more KtCloudClients, per endpoint (BaseURL) and per command.
+// A call must pass both the limit of its endpoint and the limit of its command.
+// A RateLimiter is safe for concurrent use, and every client (and copy of a client) given the same RateLimiter shares its budget.
+type RateLimiter struct {
+	mu sync.Mutex
+
+	defaultLimit   RateLimit
+	endpointLimits map[string]RateLimit
+	commandLimits  map[string]RateLimit
+
+	buckets map[string]*limitBucket // "endpoint|" or "endpoint|command" => state
+}
+
+// Creates a RateLimiter that applies defaultLimit to every endpoint without its own limit.
+// Example) NLB web server lists are known to be rejected when called in quick succession :
+//
+//	limiter := ktsdk.NewRateLimiter(ktsdk.RateLimit{RequestsPerSecond: 10, Burst: 10, MaxInFlight: 8})
+//	limiter.SetCommandLimit("listLoadBalancerWebServers", ktsdk.RateLimit{RequestsPerSecond: 1})
+func NewRateLimiter(defaultLimit RateLimit) *RateLimiter {
+	return &RateLimiter{
+		defaultLimit:   defaultLimit,
+		endpointLimits: make(map[string]RateLimit),
+		commandLimits:  make(map[string]RateLimit),
+		buckets:        make(map[string]*limitBucket),
+	}
+}
+
+// Sets the limit of all the calls to an endpoint. (ex. APIv1URL)
+// The calls already running still count against the new limit.
+func (r *RateLimiter) SetEndpointLimit(endpoint string, limit RateLimit) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.endpointLimits[endpoint] = limit
+	if b, ok := r.buckets[endpoint+"|"]; ok {
+		b.setLimit(limit)
+	}
+}
+
+// Sets the limit of a command, counted separately on each endpoint. (ex. "listVirtualMachines")
+// The calls already running still count against the new limit.
+func (r *RateLimiter) SetCommandLimit(command string, limit RateLimit) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.commandLimits[command] = limit
+	for key, b := range r.buckets {
+		if strings.HasSuffix(key, "|"+command) {
+			b.setLimit(limit)
+		}
+	}
+}
+
+// wait blocks until a call of command to endpoint may start, or ctx is done.
+// On success, the returned func must be called when the call has finished.
+// The command limit is waited for first, so that a throttled command doesn't hold the endpoint's in-flight slots
+// while it is queued, which would hold back the other commands of the endpoint.
+func (r *RateLimiter) wait(ctx context.Context, endpoint string, command string) (func(), error) {
+	r.mu.Lock()
+	var buckets []*limitBucket
+	if cmdLimit, ok := r.commandLimits[command]; ok {
+		buckets = append(buckets, r.bucket(endpoint+"|"+command, cmdLimit))
+	}
+	limit, ok := r.endpointLimits[endpoint]
+	if !ok {
+		limit = r.defaultLimit
+	}
+	buckets = append(buckets, r.bucket(endpoint+"|", limit))
+	r.mu.Unlock()
+
+	var releases []func()
+	release := func() {
+		for _, rel := range releases {
+			rel()
+		}
+	}
+	for _, b := range buckets {
+		rel, err := b.acquire(ctx)
+		if err != nil {
+			release()
+			return nil, err
+		}
+		releases = append(releases, rel)
+	}
+	return release, nil
+}
+
+// bucket returns the state of key, creating it on first use. r.mu must be held.
+func (r *RateLimiter) bucket(key string, limit RateLimit) *limitBucket {
+	b, ok := r.buckets[key]
+	if !ok {
+		b = newLimitBucket(limit)
+		r.buckets[key] = b
+	}
+	return b
+}
+
+// limitBucket is the token bucket and in-flight count of a single endpoint or command.
+type limitBucket struct {
+	mu     sync.Mutex
+	limit  RateLimit
+	tokens float64
+	last   time.Time
+
+	inFlight int           // Calls running, counted even without MaxInFlight so that a later cap applies to them
+	changed  chan struct{} // Closed when a call finishes or the limit changes
+}
+
+func newLimitBucket(limit RateLimit) *limitBucket {
+	if limit.Burst < 1 {
+		limit.Burst = 1
+	}
+	return &limitBucket{
+		limit:   limit,
+		tokens:  float64(limit.Burst),
+		last:    time.Now(),
+		changed: make(chan struct{}),
+	}
+}
+
+// setLimit changes the limit, keeping the tokens (up to the new burst) and the calls in flight.
+func (b *limitBucket) setLimit(limit RateLimit) {
+	if limit.Burst < 1 {
+		limit.Burst = 1
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.limit = limit
+	if max := float64(limit.Burst); b.tokens > max {
+		b.tokens = max
+	}
+	b.notify()
+}
+
+// notify wakes up the calls waiting for an in-flight slot. b.mu must be held.
+func (b *limitBucket) notify() {
+	close(b.changed)
+	b.changed = make(chan struct{})
+}
+
+func (b *limitBucket) acquire(ctx context.Context) (func(), error) {
+	for {
+		b.mu.Lock()
+		if b.limit.MaxInFlight <= 0 || b.inFlight < b.limit.MaxInFlight {
+			b.inFlight++
+			b.mu.Unlock()
+			break
+		}
+		changed := b.changed
+		b.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	release := func() {
+		b.mu.Lock()
+		b.inFlight--
+		b.notify()
+		b.mu.Unlock()
+	}
+
+	for {
+		delay := b.reserve()
+		if delay == 0 {
+			return release, nil
+		}
+		if err := sleepContext(ctx, delay); err != nil {
+			release()
+			return nil, err
+		}
+	}
+}
+
+// reserve takes a token and returns 0, or returns how long to wait until one is available.
+func (b *limitBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.limit.RequestsPerSecond <= 0 {
+		return 0
+	}
+
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.limit.RequestsPerSecond
+	if max := float64(b.limit.Burst); b.tokens > max {
+		b.tokens = max
+	}
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+	return time.Duration((1 - b.tokens) / b.limit.RequestsPerSecond * float64(time.Second))
+}
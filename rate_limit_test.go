@@ -0,0 +1,184 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+	"github.com/cloud-barista/ktcloud-sdk-go/ktcloudtest"
+)
+
+// concurrencyRecorder is a transport keeping the highest number of requests it saw at the same time.
+type concurrencyRecorder struct {
+	next http.RoundTripper
+
+	mu      sync.Mutex
+	current int
+	max     int
+}
+
+func (cr *concurrencyRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	cr.mu.Lock()
+	cr.current++
+	if cr.current > cr.max {
+		cr.max = cr.current
+	}
+	cr.mu.Unlock()
+	defer func() {
+		cr.mu.Lock()
+		cr.current--
+		cr.mu.Unlock()
+	}()
+	return cr.next.RoundTrip(req)
+}
+
+func (cr *concurrencyRecorder) highest() int {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	return cr.max
+}
+
+// listZonesConcurrently makes n 'listZones' calls at the same time and returns the first error.
+func listZonesConcurrently(client *ktsdk.KtCloudClient, n int) error {
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.ListZones(true, "", "", "")
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestRateLimit(t *testing.T) {
+	tests := []struct {
+		name        string
+		limit       ktsdk.RateLimit
+		calls       int
+		delay       time.Duration // Server delay of every call
+		minElapsed  time.Duration
+		maxElapsed  time.Duration
+		maxInFlight int // Highest number of concurrent calls allowed. 0 : not checked
+	}{
+		{"rate", ktsdk.RateLimit{RequestsPerSecond: 20}, 5, 0, 180 * time.Millisecond, 2 * time.Second, 0},
+		{"burst", ktsdk.RateLimit{RequestsPerSecond: 1, Burst: 5}, 5, 0, 0, 500 * time.Millisecond, 0},
+		{"max in flight", ktsdk.RateLimit{MaxInFlight: 2}, 6, 50 * time.Millisecond, 150 * time.Millisecond, 2 * time.Second, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := ktcloudtest.NewServer()
+			defer srv.Close()
+			if tt.delay > 0 {
+				srv.InjectFault(ktcloudtest.Fault{Delay: tt.delay})
+			}
+			recorder := &concurrencyRecorder{next: srv.Server.Client().Transport}
+			client := srv.Client(ktsdk.WithRateLimit(tt.limit), ktsdk.WithTransport(recorder))
+
+			start := time.Now()
+			if err := listZonesConcurrently(client, tt.calls); err != nil {
+				t.Fatalf("ListZones() = %v", err)
+			}
+			if elapsed := time.Since(start); elapsed < tt.minElapsed || elapsed > tt.maxElapsed {
+				t.Errorf("%d calls took %v, want between %v and %v", tt.calls, elapsed, tt.minElapsed, tt.maxElapsed)
+			}
+			if tt.maxInFlight > 0 && recorder.highest() > tt.maxInFlight {
+				t.Errorf("%d calls ran at the same time, want %d at most", recorder.highest(), tt.maxInFlight)
+			}
+		})
+	}
+}
+
+func TestRateLimitCancellation(t *testing.T) {
+	srv := ktcloudtest.NewServer()
+	defer srv.Close()
+	client := srv.Client(ktsdk.WithRateLimit(ktsdk.RateLimit{RequestsPerSecond: 0.1}))
+
+	// The first call takes the only token.
+	if _, err := client.ListZones(true, "", "", ""); err != nil {
+		t.Fatalf("ListZones() = %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := client.ListZonesWithContext(ctx, true, "", "", "")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("ListZones() = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("the cancelled call waited %v", elapsed)
+	}
+}
+
+func TestRateLimitCommandDoesNotStarveEndpoint(t *testing.T) {
+	srv := ktcloudtest.NewServer()
+	defer srv.Close()
+	limiter := ktsdk.NewRateLimiter(ktsdk.RateLimit{MaxInFlight: 1})
+	limiter.SetCommandLimit("listVirtualMachines", ktsdk.RateLimit{RequestsPerSecond: 0.5})
+	client := srv.Client(ktsdk.WithRateLimiter(limiter))
+
+	// The second listVirtualMachines call is queued for about 2s.
+	if _, err := client.ListVirtualMachines(ktsdk.ListVMReqInfo{}); err != nil {
+		t.Fatalf("ListVirtualMachines() = %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go client.ListVirtualMachinesWithContext(ctx, ktsdk.ListVMReqInfo{})
+	time.Sleep(50 * time.Millisecond)
+
+	start := time.Now()
+	if _, err := client.ListZones(true, "", "", ""); err != nil {
+		t.Fatalf("ListZones() = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("ListZones() waited %v behind the throttled command", elapsed)
+	}
+}
+
+func TestRateLimitChangeKeepsInFlightCalls(t *testing.T) {
+	srv := ktcloudtest.NewServer()
+	defer srv.Close()
+	srv.InjectFault(ktcloudtest.Fault{Delay: 200 * time.Millisecond})
+	limiter := ktsdk.NewRateLimiter(ktsdk.RateLimit{MaxInFlight: 1})
+	recorder := &concurrencyRecorder{next: srv.Server.Client().Transport}
+	client := srv.Client(ktsdk.WithRateLimiter(limiter), ktsdk.WithTransport(recorder))
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.ListZones(true, "", "", "")
+		done <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	// The running call still counts against the new limit.
+	limiter.SetEndpointLimit(srv.URL, ktsdk.RateLimit{MaxInFlight: 1})
+	if _, err := client.ListZones(true, "", "", ""); err != nil {
+		t.Fatalf("ListZones() = %v", err)
+	}
+	if err := <-done; err != nil {
+		t.Fatalf("ListZones() = %v", err)
+	}
+	if recorder.highest() > 1 {
+		t.Errorf("%d calls ran at the same time, want 1 at most", recorder.highest())
+	}
+}
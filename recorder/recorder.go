@@ -0,0 +1,218 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+// Package recorder provides an http.RoundTripper that records KT Cloud API traffic to a cassette file,
+// and serves it back later so that tests can run without KT Cloud credentials or network access.
+//
+//	rec, err := recorder.New("testdata/deploy_vm.json", recorder.ModeReplay, nil)
+//	...
+//	client := ktsdk.KtCloudClient{}.New(ktsdk.APIv1URL, "", "", false, ktsdk.WithTransport(rec))
+//
+// Requests are matched by command and canonicalized params. The keys, signatures and expiry params
+// are never written to the cassette and are ignored when matching, so replays work with any credentials.
+// Passwords and private keys, in the params or in the response bodies, are masked in the cassette,
+// so a replayed 'createSSHKeyPair' answers with a masked private key.
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/cloud-barista/ktcloud-sdk-go/internal/redact"
+)
+
+// Mode is the operating mode of a Recorder.
+type Mode int
+
+const (
+	ModeRecord Mode = iota // Sends the requests to KT Cloud and records the interactions.
+	ModeReplay             // Serves the recorded interactions and never touches the network.
+)
+
+// Params stripped from the recorded requests, and ignored when matching.
+var volatileParams = []string{"apikey", "signature", "signatureversion", "expires", "response"}
+
+// Interaction is a recorded API call.
+type Interaction struct {
+	Command    string `json:"command"`
+	Params     string `json:"params"` // Canonical (sorted, encoded) params without the volatile ones
+	StatusCode int    `json:"status"`
+	Body       string `json:"body"`
+}
+
+// Cassette is the content of a cassette file.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Recorder records or replays KT Cloud API calls. It is safe for concurrent use.
+type Recorder struct {
+	mode      Mode
+	filename  string
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+// Creates a Recorder for the cassette file.
+// In ModeRecord, requests go through transport (http.DefaultTransport if nil) and Save() writes the cassette.
+// In ModeReplay, the cassette is loaded and transport is not used.
+func New(filename string, mode Mode, transport http.RoundTripper) (*Recorder, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	r := &Recorder{
+		mode:      mode,
+		filename:  filename,
+		transport: transport,
+	}
+	if mode == ModeReplay {
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to Read the cassette '%s' : %w", filename, err)
+		}
+		if err := json.Unmarshal(data, &r.cassette); err != nil {
+			return nil, fmt.Errorf("Failed to Decode the cassette '%s' : %w", filename, err)
+		}
+		r.used = make([]bool, len(r.cassette.Interactions))
+	}
+	return r, nil
+}
+
+// Returns the interactions recorded or loaded so far.
+func (r *Recorder) Interactions() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Interaction(nil), r.cassette.Interactions...)
+}
+
+// Writes the recorded interactions to the cassette file. It does nothing in ModeReplay.
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+	r.mu.Lock()
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.filename), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.filename, data, 0o644)
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	params, err := requestParams(req)
+	if err != nil {
+		return nil, err
+	}
+	command := params.Get("command")
+	canonical := canonicalParams(params)
+
+	if r.mode == ModeReplay {
+		interaction, ok := r.match(command, canonical)
+		if !ok {
+			return nil, fmt.Errorf("No recorded interaction for '%s' with params [%s] in '%s'", command, canonical, r.filename)
+		}
+		return newResponse(req, interaction.StatusCode, []byte(interaction.Body)), nil
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	// The caller gets the real body. Only the cassette, meant to be committed, has the secrets masked.
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Command:    command,
+		Params:     canonical,
+		StatusCode: resp.StatusCode,
+		Body:       string(redact.JSON(body)),
+	})
+	r.mu.Unlock()
+	return resp, nil
+}
+
+// match returns the first unused interaction of the call, in recording order.
+// Once they are all used, the last one keeps being served, e.g. for a job polled more often than when recorded.
+func (r *Recorder) match(command string, canonical string) (Interaction, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	last := -1
+	for i, interaction := range r.cassette.Interactions {
+		if interaction.Command != command || interaction.Params != canonical {
+			continue
+		}
+		if !r.used[i] {
+			r.used[i] = true
+			return interaction, true
+		}
+		last = i
+	}
+	if last < 0 {
+		return Interaction{}, false
+	}
+	return r.cassette.Interactions[last], true
+}
+
+// requestParams reads the params of a GET query or a form-encoded POST body, leaving the body readable.
+func requestParams(req *http.Request) (url.Values, error) {
+	if req.Method != http.MethodPost || req.Body == nil {
+		return req.URL.Query(), nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return url.ParseQuery(string(body))
+}
+
+func canonicalParams(params url.Values) string {
+	canonical := url.Values{}
+	for key, values := range params {
+		canonical[key] = values
+	}
+	for _, key := range volatileParams {
+		canonical.Del(key)
+	}
+	redact.Params(canonical)
+	return canonical.Encode()
+}
+
+func newResponse(req *http.Request, statusCode int, body []byte) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json; charset=UTF-8"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,121 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package recorder_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+	"github.com/cloud-barista/ktcloud-sdk-go/ktcloudtest"
+	"github.com/cloud-barista/ktcloud-sdk-go/recorder"
+)
+
+// deployAndList deploys a VM, waits for it and lists it. It returns the listed VM ID.
+func deployAndList(t *testing.T, client *ktsdk.KtCloudClient) string {
+	t.Helper()
+	resp, err := client.DeployVirtualMachine(ktsdk.DeployVMReqInfo{ZoneId: ktcloudtest.ZoneSeoulM, ServiceOfferingId: "s", TemplateId: "t"})
+	if err != nil {
+		t.Fatalf("DeployVirtualMachine() = %v", err)
+	}
+	if err := client.WaitForAsyncJob(resp.Deployvirtualmachineresponse.JobId, 10*time.Second); err != nil {
+		t.Fatalf("WaitForAsyncJob() = %v", err)
+	}
+	vms, err := client.ListVirtualMachines(ktsdk.ListVMReqInfo{VMId: resp.Deployvirtualmachineresponse.ID})
+	if err != nil {
+		t.Fatalf("ListVirtualMachines() = %v", err)
+	}
+	if len(vms.Listvirtualmachinesresponse.Virtualmachine) != 1 {
+		t.Fatalf("ListVirtualMachines() listed %d VMs, want 1", len(vms.Listvirtualmachinesresponse.Virtualmachine))
+	}
+	return vms.Listvirtualmachinesresponse.Virtualmachine[0].ID
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	cassette := filepath.Join(t.TempDir(), "testdata", "deploy_vm.json")
+
+	srv := ktcloudtest.NewServer()
+	rec, err := recorder.New(cassette, recorder.ModeRecord, nil)
+	if err != nil {
+		t.Fatalf("New(ModeRecord) = %v", err)
+	}
+	recordedId := deployAndList(t, srv.Client(ktsdk.WithTransport(rec), ktsdk.WithSignatureExpiry(time.Minute)))
+	if err := rec.Save(); err != nil {
+		t.Fatalf("Save() = %v", err)
+	}
+	srv.Close()
+
+	data, err := os.ReadFile(cassette)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{srv.APIKey, "signature=", "expires="} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("the cassette contains '%s'", secret)
+		}
+	}
+
+	// The server is gone, and the keys are different : only the cassette can answer.
+	player, err := recorder.New(cassette, recorder.ModeReplay, nil)
+	if err != nil {
+		t.Fatalf("New(ModeReplay) = %v", err)
+	}
+	client := ktsdk.KtCloudClient{}.New(srv.URL, "other-api-key", "other-secret-key", false, ktsdk.WithTransport(player))
+	if replayedId := deployAndList(t, client); replayedId != recordedId {
+		t.Errorf("replayed VM '%s', want the recorded '%s'", replayedId, recordedId)
+	}
+
+	if _, err := client.ListZones(true, "", "", ""); err == nil {
+		t.Errorf("ListZones() = nil, want an error as it wasn't recorded")
+	}
+}
+
+func TestRecordedSecretsAreMasked(t *testing.T) {
+	cassette := filepath.Join(t.TempDir(), "keypair.json")
+	srv := ktcloudtest.NewServer()
+	defer srv.Close()
+
+	rec, err := recorder.New(cassette, recorder.ModeRecord, nil)
+	if err != nil {
+		t.Fatalf("New(ModeRecord) = %v", err)
+	}
+	created, err := srv.Client(ktsdk.WithTransport(rec)).CreateSSHKeyPair("key")
+	if err != nil {
+		t.Fatalf("CreateSSHKeyPair() = %v", err)
+	}
+	privateKey := created.Createsshkeypairresponse.KeyPair.PrivateKey
+	if !strings.Contains(privateKey, "PRIVATE KEY") {
+		t.Fatalf("the recording client got the private key %q, want the real one", privateKey)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatalf("Save() = %v", err)
+	}
+
+	data, err := os.ReadFile(cassette)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "PRIVATE KEY") {
+		t.Errorf("the cassette contains the private key : %s", data)
+	}
+
+	player, err := recorder.New(cassette, recorder.ModeReplay, nil)
+	if err != nil {
+		t.Fatalf("New(ModeReplay) = %v", err)
+	}
+	replayed, err := ktsdk.KtCloudClient{}.New(srv.URL, "", "", false, ktsdk.WithTransport(player)).CreateSSHKeyPair("key")
+	if err != nil {
+		t.Fatalf("CreateSSHKeyPair() = %v", err)
+	}
+	if got := replayed.Createsshkeypairresponse.KeyPair.PrivateKey; got != "****" {
+		t.Errorf("replayed private key = %q, want it masked", got)
+	}
+}
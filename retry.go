@@ -0,0 +1,135 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy controls how a KtCloudClient retries a failed API call.
+// Only read-only commands ('list...', 'query...') are retried unless a command is added to MutatingCommands
+// or RetryMutating is set, because retrying e.g. 'deployVirtualMachine' after a lost response can create a second VM.
+type RetryPolicy struct {
+	MaxAttempts int           // Total number of attempts including the first one. 1 or less disables retries.
+	BaseDelay   time.Duration // Delay before the first retry. It doubles on every further retry.
+	MaxDelay    time.Duration // Upper bound of a single delay, including a 'Retry-After' value
+
+	// Jitter randomizes each delay by up to this fraction of it. (0 ~ 1)
+	Jitter float64
+
+	// RetryMutating allows retries of every command, not only the read-only ones.
+	RetryMutating bool
+
+	// MutatingCommands lists the non read-only commands that may be retried. (ex. "deployVirtualMachine")
+	MutatingCommands []string
+
+	// Retryable decides whether an error is worth another attempt. Defaults to IsRetryableError().
+	Retryable func(err error) bool
+}
+
+// Returns the policy used by KtCloudClient{}.New() : up to 4 attempts for read-only commands.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 4,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    20 * time.Second,
+		Jitter:      0.2,
+	}
+}
+
+// Checks whether err is a transient failure : a retryable *APIError, a reset or refused connection,
+// an unexpected EOF or a network timeout. Cancellation of the caller's context is never retryable.
+func IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if IsRetryable(err) {
+		return true
+	}
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// isReadOnlyCommand reports whether a KT Cloud command only reads, judging by its name.
+func isReadOnlyCommand(command string) bool {
+	return strings.HasPrefix(command, "list") || strings.HasPrefix(command, "query")
+}
+
+func (p *RetryPolicy) allows(command string) bool {
+	if p == nil || p.MaxAttempts <= 1 {
+		return false
+	}
+	if p.RetryMutating || isReadOnlyCommand(command) {
+		return true
+	}
+	for _, cmd := range p.MutatingCommands {
+		if strings.EqualFold(cmd, command) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *RetryPolicy) retryable(err error) bool {
+	if p.Retryable != nil {
+		return p.Retryable(err)
+	}
+	return IsRetryableError(err)
+}
+
+// delay returns how long to wait before the given retry (1 for the first retry).
+// A 'Retry-After' value from the server wins over the backoff, still capped by MaxDelay.
+func (p *RetryPolicy) delay(retry int, retryAfter time.Duration) time.Duration {
+	d := p.BaseDelay
+	for i := 1; i < retry && (p.MaxDelay <= 0 || d < p.MaxDelay); i++ {
+		d *= 2
+	}
+	if p.Jitter > 0 && d > 0 {
+		d += time.Duration((rand.Float64()*2 - 1) * p.Jitter * float64(d))
+	}
+	if retryAfter > d {
+		d = retryAfter
+	}
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	return d
+}
+
+// parseRetryAfter reads a 'Retry-After' header given either in seconds or as an HTTP date.
+func parseRetryAfter(h http.Header) time.Duration {
+	v := strings.TrimSpace(h.Get("Retry-After"))
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs > 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
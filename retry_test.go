@@ -0,0 +1,151 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk_test
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+	"github.com/cloud-barista/ktcloud-sdk-go/ktcloudtest"
+)
+
+// requestRecorder is a transport keeping the requests sent through it.
+type requestRecorder struct {
+	mu       sync.Mutex
+	requests []*http.Request
+}
+
+func (rr *requestRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	rr.mu.Lock()
+	rr.requests = append(rr.requests, req)
+	rr.mu.Unlock()
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func (rr *requestRecorder) count() int {
+	rr.mu.Lock()
+	defer rr.mu.Unlock()
+	return len(rr.requests)
+}
+
+func TestRetry(t *testing.T) {
+	tests := []struct {
+		name         string
+		fault        ktcloudtest.Fault
+		call         func(c *ktsdk.KtCloudClient) error
+		wantErr      bool
+		maxDelay     time.Duration // MaxDelay of the policy. Default : 5s
+		wantAttempts int
+		minElapsed   time.Duration
+		maxElapsed   time.Duration
+	}{
+		{
+			name:         "transient errors",
+			fault:        ktcloudtest.Fault{Command: "listZones", Times: 2, HTTPStatus: http.StatusServiceUnavailable},
+			wantAttempts: 3,
+		},
+		{
+			name:         "attempts exhausted",
+			fault:        ktcloudtest.Fault{Command: "listZones", Times: 3, HTTPStatus: http.StatusBadGateway},
+			wantErr:      true,
+			wantAttempts: 3,
+		},
+		{
+			name:         "not retryable",
+			fault:        ktcloudtest.Fault{Command: "listZones", Times: 1, HTTPStatus: http.StatusUnauthorized},
+			wantErr:      true,
+			wantAttempts: 1,
+		},
+		{
+			name:  "mutating command",
+			fault: ktcloudtest.Fault{Command: "deployVirtualMachine", Times: 1, HTTPStatus: http.StatusServiceUnavailable},
+			call: func(c *ktsdk.KtCloudClient) error {
+				_, err := c.DeployVirtualMachine(ktsdk.DeployVMReqInfo{ZoneId: ktcloudtest.ZoneSeoulM, ServiceOfferingId: "s", TemplateId: "t"})
+				return err
+			},
+			wantErr:      true,
+			wantAttempts: 1,
+		},
+		{
+			name:         "Retry-After",
+			fault:        ktcloudtest.Fault{Command: "listZones", Times: 1, HTTPStatus: http.StatusTooManyRequests, RetryAfter: time.Second},
+			wantAttempts: 2,
+			minElapsed:   time.Second,
+		},
+		{
+			name:         "Retry-After capped by MaxDelay",
+			fault:        ktcloudtest.Fault{Command: "listZones", Times: 1, HTTPStatus: http.StatusTooManyRequests, RetryAfter: time.Minute},
+			maxDelay:     100 * time.Millisecond,
+			wantAttempts: 2,
+			maxElapsed:   5 * time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := ktcloudtest.NewServer()
+			defer srv.Close()
+			srv.InjectFault(tt.fault)
+			policy := &ktsdk.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Second}
+			if tt.maxDelay > 0 {
+				policy.MaxDelay = tt.maxDelay
+			}
+			var requests requestRecorder
+			client := srv.Client(ktsdk.WithRetryPolicy(policy), ktsdk.WithTransport(&requests))
+
+			call := tt.call
+			if call == nil {
+				call = func(c *ktsdk.KtCloudClient) error {
+					_, err := c.ListZones(true, "", "", "")
+					return err
+				}
+			}
+			start := time.Now()
+			err := call(client)
+			elapsed := time.Since(start)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("call = %v, want error: %v", err, tt.wantErr)
+			}
+			if got := requests.count(); got != tt.wantAttempts {
+				t.Errorf("%d attempts, want %d", got, tt.wantAttempts)
+			}
+			if elapsed < tt.minElapsed || (tt.maxElapsed > 0 && elapsed > tt.maxElapsed) {
+				t.Errorf("took %v, want between %v and %v", elapsed, tt.minElapsed, tt.maxElapsed)
+			}
+		})
+	}
+}
+
+func TestRetryOfTransportErrors(t *testing.T) {
+	srv := ktcloudtest.NewServer()
+	srv.Close()
+	policy := &ktsdk.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}
+	client := srv.Client(ktsdk.WithRetryPolicy(policy))
+
+	_, err := client.ListZones(true, "", "", "")
+	if !ktsdk.IsRetryableError(err) {
+		t.Fatalf("ListZones() of a closed server = %v, want a retryable error", err)
+	}
+	// The error is logged on every retry, so it must not carry the signed URL as is.
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		t.Fatalf("ListZones() = %T, want a *url.Error", err)
+	}
+	query, _ := url.ParseQuery(strings.SplitN(urlErr.URL, "?", 2)[1])
+	for _, key := range []string{"apikey", "signature"} {
+		if query.Get(key) != "****" {
+			t.Errorf("%s = %q in the error, want it masked", key, query.Get(key))
+		}
+	}
+}
@@ -10,6 +10,7 @@
 package ktcloudsdk
 
 import (
+	"context"
 	"net/url"
 )
 // KT Cloud (G1/G2 Platform) Image-Snapshot API : https://cloud.kt.com/docs/open-api-guide/g/computing/image-snapshot
@@ -28,7 +29,7 @@ type CreateTemplateReqInfo struct {
 }
 
 type ListTemplateReqInfo struct {
-	TemplateFilter	 string // 'self' : image created by the user. 'selfexecutable' : created by the user and currently available.
+	TemplateFilter	 TemplateFilter // 'self' : image created by the user. 'selfexecutable' : created by the user and currently available. Empty : the default filter of KT Cloud.
 	Account          string // Accounts with generated Image belong
 	DomainId         string
 	IsRecursive      bool   // Used with "DomainId" field, in case of 'true', all account inquiry included in the domain (Default: false)
@@ -41,9 +42,36 @@ type ListTemplateReqInfo struct {
 		// true: Inquiry of all resources lists that the account can list
 }
 
+// Checks the fields of the request before it is sent. A problem is returned as a *ValidationError.
+func (r CreateTemplateReqInfo) Validate() error {
+	var errs fieldErrors
+	errs.required("Name", r.Name)
+	errs.required("DisplayText", r.DisplayText)
+	errs.required("OsTypeId", r.OsTypeId)
+	errs.required("VolumeId", r.VolumeId)
+	errs.oneOf("Bits", r.Bits, "32", "64")
+	return errs.err("CreateTemplateReqInfo")
+}
+
+// Checks the fields of the request before it is sent. A problem is returned as a *ValidationError.
+func (r ListTemplateReqInfo) Validate() error {
+	var errs fieldErrors
+	knownEnum(&errs, "TemplateFilter", r.TemplateFilter)
+	errs.paging(r.Page, r.PageSize)
+	return errs.err("ListTemplateReqInfo")
+}
+
 // # Create a Image Template (Server Image) of a VM
 func (c KtCloudClient) CreateTemplate(req *CreateTemplateReqInfo) (CreateTemplateResponse, error) {
+	return c.CreateTemplateWithContext(context.Background(), req)
+}
+
+// CreateTemplateWithContext is CreateTemplate with a context that bounds the underlying API call.
+func (c KtCloudClient) CreateTemplateWithContext(ctx context.Context, req *CreateTemplateReqInfo) (CreateTemplateResponse, error) {
 	var resp CreateTemplateResponse
+	if err := c.validate(req); err != nil {
+		return resp, err
+	}
 	params := url.Values{}
 
 	params.Set("name", req.Name)
@@ -70,21 +98,29 @@ func (c KtCloudClient) CreateTemplate(req *CreateTemplateReqInfo) (CreateTemplat
 		params.Set("requireshvm", "true")
 	}
 
-	response, err := NewRequest(c, "createTemplate", params) // Request Command according to KT Cloud API doc.
-	if err != nil {
+	if err := c.Do(ctx, "createTemplate", params, &resp); err != nil { // Request Command according to KT Cloud API doc.
 		return resp, err
 	}
-	resp = response.(CreateTemplateResponse)
 	return resp, nil
 }
 // (Note) The 'queryasyncjobresultresponse' processing method is the same as in 'DeployVirtualMachine()'.
 
 // # List Available Image Templates
 func (c KtCloudClient) ListTemplates(req *ListTemplateReqInfo) (ListTemplatesResponse, error) {
+	return c.ListTemplatesWithContext(context.Background(), req)
+}
+
+// ListTemplatesWithContext is ListTemplates with a context that bounds the underlying API call.
+func (c KtCloudClient) ListTemplatesWithContext(ctx context.Context, req *ListTemplateReqInfo) (ListTemplatesResponse, error) {
 	var resp ListTemplatesResponse
+	if err := c.validate(req); err != nil {
+		return resp, err
+	}
 	params := url.Values{}
 
-	params.Set("templatefilter", req.TemplateFilter)
+	if req.TemplateFilter != "" {
+		params.Set("templatefilter", string(req.TemplateFilter.Canonical()))
+	}
 
 	if req.Account != "" {
 		params.Set("account", req.Account)
@@ -108,27 +144,28 @@ func (c KtCloudClient) ListTemplates(req *ListTemplateReqInfo) (ListTemplatesRes
 		params.Set("install", "true")
 	}
 
-	response, err := NewRequest(c, "listTemplates", params)
-	if err != nil {
+	if err := c.Do(ctx, "listTemplates", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(ListTemplatesResponse)
 	return resp, nil
 }
 
 // # Delete a Image Template
 func (c KtCloudClient) DeleteTemplate(id string, zoneId string) (DeleteTemplateResponse, error) {
+	return c.DeleteTemplateWithContext(context.Background(), id, zoneId)
+}
+
+// DeleteTemplateWithContext is DeleteTemplate with a context that bounds the underlying API call.
+func (c KtCloudClient) DeleteTemplateWithContext(ctx context.Context, id string, zoneId string) (DeleteTemplateResponse, error) {
 	var resp DeleteTemplateResponse
 	params := url.Values{}
 	
 	params.Set("id", id)
 	params.Set("zoneid", zoneId)
 
-	response, err := NewRequest(c, "deleteTemplate", params)
-	if err != nil {
+	if err := c.Do(ctx, "deleteTemplate", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(DeleteTemplateResponse)
 	return resp, nil
 }
 
@@ -136,33 +173,33 @@ type Template struct {
 	ID                    string            `json:"id"`
 	Name                  string            `json:"name"`
 	DisplayText           string            `json:"displaytext"`
-	IsPublic              bool              `json:"ispublic"`
-	Created               string            `json:"created"`
-	IsReady               bool              `json:"isready"`
-	PasswordEnabled       bool              `json:"passwordenabled"`
+	IsPublic              FlexBool          `json:"ispublic"`
+	Created               Timestamp         `json:"created"`
+	IsReady               FlexBool          `json:"isready"`
+	PasswordEnabled       FlexBool          `json:"passwordenabled"`
 	Format                string            `json:"format"`
-	IsFeatured            bool              `json:"isfeatured"`
-	CrossZones            bool              `json:"crossZones"`
+	IsFeatured            FlexBool          `json:"isfeatured"`
+	CrossZones            FlexBool          `json:"crossZones"`
 	OSTypeId              string            `json:"ostypeid"`
 	OSTypeName            string            `json:"ostypename"`
 	Account               string            `json:"account"`
 	ZoneId                string            `json:"zoneid"`
 	ZoneName              string            `json:"zonename"`
 	Status                string            `json:"status"`
-	Size                  int	            `json:"size"`
-	PhysicalSize          int   	        `json:"physicalsize"`
+	Size                  FlexInt	        `json:"size"`
+	PhysicalSize          FlexInt	        `json:"physicalsize"`
 	TemplateType          string            `json:"templatetype"`
 	Hypervisor            string            `json:"hypervisor"`
 	Domain                string            `json:"domain"`
 	DomainId              string            `json:"domainid"`
-	IsExtractable         bool              `json:"isextractable"`
+	IsExtractable         FlexBool          `json:"isextractable"`
 	SourceTemplateId      string            `json:"sourcetemplateid"`
 	Details               map[string]string `json:"details"`
-	Bits                  int               `json:"bits"`
-	SshKeyEnabled         bool              `json:"sshkeyenabled"`
-	IsDynamicallyScalable bool              `json:"isdynamicallyscalable"`
-	CacheInPrimary        bool              `json:"cacheinprimary"`
-	Tags                  []interface{}     `json:"tags"`
+	Bits                  FlexInt           `json:"bits"`
+	SshKeyEnabled         FlexBool          `json:"sshkeyenabled"`
+	IsDynamicallyScalable FlexBool          `json:"isdynamicallyscalable"`
+	CacheInPrimary        FlexBool          `json:"cacheinprimary"`
+	Tags                  Tags              `json:"tags"`
 }
 
 type CreateTemplateResponse struct {
@@ -174,7 +211,7 @@ type CreateTemplateResponse struct {
 
 type ListTemplatesResponse struct {
 	Listtemplatesresponse struct {
-		Count    int    	`json:"count"`
+		Count    FlexInt    	`json:"count"`
 		Template []Template `json:"template"`
 	} `json:"listtemplatesresponse"`
 }
@@ -0,0 +1,65 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This code is based on the following material: https://github.com/mindjiver/gopherstack (MIT License)
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Layout of the 'expires' parameter of signature version 3. (ex. 2026-10-18T09:30:00+0000)
+const signatureExpiresLayout = "2006-01-02T15:04:05-0700"
+
+// signatureExpires returns when a request signed at now expires.
+// 'expires' only has whole seconds, so it is rounded up, and an expiry under a second is made one second:
+// a shorter one would be rejected right away.
+func signatureExpires(now time.Time, expiry time.Duration) time.Time {
+	if expiry < time.Second {
+		expiry = time.Second
+	}
+	expires := now.UTC().Add(expiry)
+	if rounded := expires.Truncate(time.Second); rounded.Before(expires) {
+		expires = rounded.Add(time.Second)
+	}
+	return expires
+}
+
+// signedQuery returns the encoded params followed by their 'signature'.
+// The result is used as the query string of a GET request, or as the form body of a POST request.
+// With a positive expiry, 'signatureversion=3' and 'expires' are added to params and signed along,
+// so that KT Cloud rejects the request once the expiry has passed.
+func signedQuery(params url.Values, secretKey string, expiry time.Duration) string {
+	if expiry > 0 {
+		params.Set("signatureversion", "3")
+		params.Set("expires", signatureExpires(time.Now(), expiry).Format(signatureExpiresLayout))
+	}
+
+	// Generate signature for API call
+	// * Serialize parameters and sort them by key, done by Encode()
+	// * Use byte sequence for '+' character as KT Cloud requires this
+	// * For the signature only, un-encode [ and ].
+	// * Convert the entire argument string to lowercase
+	// * Calculate HMAC SHA1 of argument string with KT Cloud secret key
+	// * URL encode the string and convert to base64
+	s := params.Encode()
+	s2 := strings.Replace(s, "+", "%20", -1)
+	s3 := strings.ToLower(strings.Replace(strings.Replace(s2, "%5B", "[", -1), "%5D", "]", -1))
+	mac := hmac.New(sha1.New, []byte(secretKey))
+	mac.Write([]byte(s3))
+	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	signature = url.QueryEscape(signature)
+
+	// For some reason KT Cloud refuses to accept '+' as a space character so we byte escape it instead.
+	return s2 + "&signature=" + signature
+}
@@ -0,0 +1,65 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk_test
+
+import (
+	"testing"
+	"time"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+	"github.com/cloud-barista/ktcloud-sdk-go/ktcloudtest"
+)
+
+func TestSignatureExpiry(t *testing.T) {
+	tests := []struct {
+		name        string
+		expiry      time.Duration
+		wantExpires bool
+		wantExpiry  time.Duration // Shortest expiry wanted in 'expires'
+	}{
+		{"no expiry", 0, false, 0},
+		{"valid expiry", time.Hour, true, time.Hour},
+		{"fraction of a second", 1500 * time.Millisecond, true, 1500 * time.Millisecond},
+		{"under a second", time.Nanosecond, true, time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := ktcloudtest.NewServer()
+			defer srv.Close()
+			var requests requestRecorder
+			client := srv.Client(ktsdk.WithSignatureExpiry(tt.expiry), ktsdk.WithTransport(&requests), ktsdk.WithRetryPolicy(nil))
+
+			before := time.Now()
+			if _, err := client.ListZones(true, "", "", ""); err != nil {
+				t.Errorf("ListZones() = %v", err)
+			}
+
+			if requests.count() != 1 {
+				t.Fatalf("%d requests sent, want 1", requests.count())
+			}
+			query := requests.requests[0].URL.Query()
+			if !tt.wantExpires {
+				if query.Has("expires") || query.Has("signatureversion") {
+					t.Errorf("query = %v, want no expiry", query)
+				}
+				return
+			}
+			if query.Get("signatureversion") != "3" {
+				t.Errorf("signatureversion = %q, want 3", query.Get("signatureversion"))
+			}
+			expires, err := time.Parse(ktsdk.TimestampLayout, query.Get("expires"))
+			if err != nil {
+				t.Fatalf("expires = %q : %v", query.Get("expires"), err)
+			}
+			// Rounded up to the second, so never before the wanted expiry.
+			if expires.Before(before.Add(tt.wantExpiry)) || expires.After(time.Now().Add(tt.wantExpiry+time.Second)) {
+				t.Errorf("expires = %v, want at least %v from %v, rounded up to the second", expires, tt.wantExpiry, before)
+			}
+		})
+	}
+}
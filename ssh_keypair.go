@@ -10,25 +10,34 @@
 package ktcloudsdk
 
 import (
+	"context"
 	"net/url"
 )
 
 // Create a SSH key pair
 func (c KtCloudClient) CreateSSHKeyPair(name string) (CreateSshKeyPairResponse, error) {
+	return c.CreateSSHKeyPairWithContext(context.Background(), name)
+}
+
+// CreateSSHKeyPairWithContext is CreateSSHKeyPair with a context that bounds the underlying API call.
+func (c KtCloudClient) CreateSSHKeyPairWithContext(ctx context.Context, name string) (CreateSshKeyPairResponse, error) {
 	var resp CreateSshKeyPairResponse
 	params := url.Values{}
 	params.Set("name", name)
 
-	response, err := NewRequest(c, "createSSHKeyPair", params)
-	if err != nil {
+	if err := c.Do(ctx, "createSSHKeyPair", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(CreateSshKeyPairResponse)
 	return resp, nil
 }
 
 // List SSH keypairs
 func (c KtCloudClient) ListSSHKeyPairs(name string) (ListSshKeyPairsResponse, error) {
+	return c.ListSSHKeyPairsWithContext(context.Background(), name)
+}
+
+// ListSSHKeyPairsWithContext is ListSSHKeyPairs with a context that bounds the underlying API call.
+func (c KtCloudClient) ListSSHKeyPairsWithContext(ctx context.Context, name string) (ListSshKeyPairsResponse, error) {
 	var resp ListSshKeyPairsResponse
 	params := url.Values{}
 
@@ -36,26 +45,27 @@ func (c KtCloudClient) ListSSHKeyPairs(name string) (ListSshKeyPairsResponse, er
 		params.Set("name", name)
 	}
 	
-	response, err := NewRequest(c, "listSSHKeyPairs", params)
-	if err != nil {
+	if err := c.Do(ctx, "listSSHKeyPairs", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(ListSshKeyPairsResponse)
 	return resp, nil
 }
 //~~~ keypairs res... 에서 중간 s자 주의
 
 // Deletes an SSH key pair
 func (c KtCloudClient) DeleteSSHKeyPair(name string) (DeleteSshKeyPairResponse, error) {
+	return c.DeleteSSHKeyPairWithContext(context.Background(), name)
+}
+
+// DeleteSSHKeyPairWithContext is DeleteSSHKeyPair with a context that bounds the underlying API call.
+func (c KtCloudClient) DeleteSSHKeyPairWithContext(ctx context.Context, name string) (DeleteSshKeyPairResponse, error) {
 	var resp DeleteSshKeyPairResponse
 	params := url.Values{}
 	params.Set("name", name)
-	response, err := NewRequest(c, "deleteSSHKeyPair", params)
-	if err != nil {
+	if err := c.Do(ctx, "deleteSSHKeyPair", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(DeleteSshKeyPairResponse)
-	return resp, err
+	return resp, nil
 }
 
 type KeyPair struct {
@@ -77,7 +87,7 @@ type CreateSshKeyPairResponse struct {
 
 type ListSshKeyPairsResponse struct {
 	Listsshkeypairsresponse struct {
-		Count      	   int      	`json:"count"`
+		Count      	   FlexInt      	`json:"count"`
 		KeyPair 	   []KeyPair 	`json:"sshkeypair"`		
 	} `json:"listsshkeypairsresponse"`
 }
@@ -86,6 +96,6 @@ type ListSshKeyPairsResponse struct {
 
 type DeleteSshKeyPairResponse struct {
 	Deletesshkeypairresponse struct {
-		Success 		string 		`json:"success"`
+		Success 		FlexBool 		`json:"success"`
 	} `json:"deletesshkeypairresponse"`
 }
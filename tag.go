@@ -10,30 +10,33 @@
 package ktcloudsdk
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
 )
 
 type CreateTagsReqInfo struct {
-	ResourceType string   `json:"resourcetype`
+	ResourceType string   `json:"resourcetype"`
 	ResourceIds  []string `json:"resourceids"`
-	Tags         []TagArg `json:"tags`
+	Tags         []TagArg `json:"tags"`
 }
 
 type ListTagsReqInfo struct {
 	Account      string `json:"account"`
 	DomainId     string `json:"domainid"`
 	Key          string `json:"key"`
-	Value        string `json:"value`
-	ResourceType string `json:"resourcetype`
+	Value        string `json:"value"`
+	ResourceType string `json:"resourcetype"`
 	ResourceIds  string `json:"resourceids"`
 }
 
 type DeleteTagsReqInfo struct {
-	ResourceType string   `json:"resourcetype`
+	ResourceType string   `json:"resourcetype"`
 	ResourceIds  []string `json:"resourceids"`
-	Tags         []TagArg `json:"tags`
+	Tags         []TagArg `json:"tags"`
 }
 
 type TagArg struct {
@@ -41,9 +44,54 @@ type TagArg struct {
 	Value string `json:"value"`
 }
 
+// Checks the fields of the request before it is sent. A problem is returned as a *ValidationError.
+func (r CreateTagsReqInfo) Validate() error {
+	var errs fieldErrors
+	errs.required("ResourceType", r.ResourceType)
+	if len(r.ResourceIds) == 0 {
+		errs.add("ResourceIds", "", "is required")
+	}
+	for i, id := range r.ResourceIds {
+		errs.required(fmt.Sprintf("ResourceIds[%d]", i), id)
+	}
+	if len(r.Tags) == 0 {
+		errs.add("Tags", "", "is required")
+	}
+	errs.tags("Tags", r.Tags)
+	return errs.err("CreateTagsReqInfo")
+}
+
+// ListTagsReqInfo only has optional filters. Validate() is there for the sake of consistency, and never fails.
+func (r ListTagsReqInfo) Validate() error {
+	return nil
+}
+
+// Checks the fields of the request before it is sent. A problem is returned as a *ValidationError.
+// Tags may be empty, which deletes all the tags of the resources.
+func (r DeleteTagsReqInfo) Validate() error {
+	var errs fieldErrors
+	errs.required("ResourceType", r.ResourceType)
+	if len(r.ResourceIds) == 0 {
+		errs.add("ResourceIds", "", "is required")
+	}
+	for i, id := range r.ResourceIds {
+		errs.required(fmt.Sprintf("ResourceIds[%d]", i), id)
+	}
+	errs.tags("Tags", r.Tags)
+	return errs.err("DeleteTagsReqInfo")
+}
+
 // Add tags to specified resources
 func (c KtCloudClient) CreateTags(options *CreateTagsReqInfo) (CreateTagsResponse, error) {
+	return c.CreateTagsWithContext(context.Background(), options)
+}
+
+// CreateTagsWithContext is CreateTags with a context that bounds the underlying API call.
+func (c KtCloudClient) CreateTagsWithContext(ctx context.Context, options *CreateTagsReqInfo) (CreateTagsResponse, error) {
 	var resp CreateTagsResponse
+	if err := c.validate(options); err != nil {
+		return resp, err
+	}
 	params := url.Values{}
 
 	params.Set("resourceids", strings.Join(options.ResourceIds, ","))
@@ -53,18 +101,23 @@ func (c KtCloudClient) CreateTags(options *CreateTagsReqInfo) (CreateTagsRespons
 		params.Set("tags["+strconv.Itoa(j+1)+"].value", tag.Value)
 	}
 
-	response, err := NewRequest(c, "createTags", params)
-	if err != nil {
+	if err := c.Do(ctx, "createTags", params, &resp); err != nil {
 		return resp, err
 	}
-
-	resp = response.(CreateTagsResponse)
-	return resp, err
+	return resp, nil
 }
 
 // Returns all items with a particular tag
 func (c KtCloudClient) ListTags(options *ListTagsReqInfo) (ListTagsResponse, error) {
+	return c.ListTagsWithContext(context.Background(), options)
+}
+
+// ListTagsWithContext is ListTags with a context that bounds the underlying API call.
+func (c KtCloudClient) ListTagsWithContext(ctx context.Context, options *ListTagsReqInfo) (ListTagsResponse, error) {
 	var resp ListTagsResponse
+	if err := c.validate(options); err != nil {
+		return resp, err
+	}
 	params := url.Values{}
 
 	if options.Account != "" {
@@ -91,18 +144,23 @@ func (c KtCloudClient) ListTags(options *ListTagsReqInfo) (ListTagsResponse, err
 		params.Set("resourcetype", options.ResourceType)
 	}
 
-	response, err := NewRequest(c, "listTags", params)
-	if err != nil {
+	if err := c.Do(ctx, "listTags", params, &resp); err != nil {
 		return resp, err
 	}
-
-	resp = response.(ListTagsResponse)
-	return resp, err
+	return resp, nil
 }
 
 // Remove tags from specified resources
 func (c KtCloudClient) DeleteTags(options *DeleteTagsReqInfo) (DeleteTagsResponse, error) {
+	return c.DeleteTagsWithContext(context.Background(), options)
+}
+
+// DeleteTagsWithContext is DeleteTags with a context that bounds the underlying API call.
+func (c KtCloudClient) DeleteTagsWithContext(ctx context.Context, options *DeleteTagsReqInfo) (DeleteTagsResponse, error) {
 	var resp DeleteTagsResponse
+	if err := c.validate(options); err != nil {
+		return resp, err
+	}
 	params := url.Values{}
 
 	params.Set("resourceids", strings.Join(options.ResourceIds, ","))
@@ -113,25 +171,81 @@ func (c KtCloudClient) DeleteTags(options *DeleteTagsReqInfo) (DeleteTagsRespons
 		params.Set("tags["+strconv.Itoa(j+1)+"].value", tag.Value)
 	}
 
-	response, err := NewRequest(c, "deleteTags", params)
-	if err != nil {
+	if err := c.Do(ctx, "deleteTags", params, &resp); err != nil {
 		return resp, err
 	}
-
-	resp = response.(DeleteTagsResponse)
-	return resp, err
+	return resp, nil
 }
 
 type Tag struct {
 	Key          string `json:"key"`
-	Value        string `json:"value`
-	ResourceType string `json:"resourcetype`
+	Value        string `json:"value"`
+	ResourceType string `json:"resourcetype"`
 	ResourceId   string `json:"resourceid"`
 	Account      string `json:"account"`
 	DomainId     string `json:"domainid"`
 	Domain       string `json:"domain"`
 }
 
+// Tags are the tags of a resource, as returned along with it. (ex. Virtualmachine.Tags)
+type Tags []Tag
+
+// Returns the value of the tag with the given key, and whether the resource has it.
+func (t Tags) Get(key string) (string, bool) {
+	for _, tag := range t {
+		if tag.Key == key {
+			return tag.Value, true
+		}
+	}
+	return "", false
+}
+
+// Checks whether the resource has the tag with the given key and value.
+func (t Tags) Has(key string, value string) bool {
+	for _, tag := range t {
+		if tag.Key == key && tag.Value == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the tags as a key => value map.
+func (t Tags) AsMap() map[string]string {
+	m := make(map[string]string, len(t))
+	for _, tag := range t {
+		m[tag.Key] = tag.Value
+	}
+	return m
+}
+
+// Tags are usually sent as objects, but a tag sent as a plain string (ex. by 'listVolumes') is taken as a key without value.
+func (t *Tags) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("%s is not a valid list of tags", data)
+	}
+	if raw == nil {
+		*t = nil
+		return nil
+	}
+	tags := make(Tags, 0, len(raw))
+	for _, r := range raw {
+		var key string
+		if json.Unmarshal(r, &key) == nil {
+			tags = append(tags, Tag{Key: key})
+			continue
+		}
+		var tag Tag
+		if err := json.Unmarshal(r, &tag); err != nil {
+			return fmt.Errorf("%s is not a valid tag", r)
+		}
+		tags = append(tags, tag)
+	}
+	*t = tags
+	return nil
+}
+
 type CreateTagsResponse struct {
 	Createtagsresponse struct {
 		JobId string `json:"jobid"`
@@ -140,7 +254,7 @@ type CreateTagsResponse struct {
 
 type ListTagsResponse struct {
 	Listtagsresponse struct {
-		Count int `json:"count"`
+		Count FlexInt `json:"count"`
 		Tag   []Tag   `json:"tag"`
 	} `json:"listtagsresponse"`
 }
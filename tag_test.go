@@ -0,0 +1,66 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+)
+
+func TestTagsDecoding(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		want    ktsdk.Tags
+		wantErr bool
+	}{
+		{"objects", `[{"key":"env","value":"prod","resourcetype":"UserVm","resourceid":"vm-1"}]`,
+			ktsdk.Tags{{Key: "env", Value: "prod", ResourceType: "UserVm", ResourceId: "vm-1"}}, false},
+		{"plain strings", `["env","team"]`, ktsdk.Tags{{Key: "env"}, {Key: "team"}}, false},
+		{"mixed", `["env",{"key":"team","value":"sdk"}]`, ktsdk.Tags{{Key: "env"}, {Key: "team", Value: "sdk"}}, false},
+		{"empty", `[]`, ktsdk.Tags{}, false},
+		{"null", `null`, nil, false},
+		{"not a list", `{"key":"env"}`, nil, true},
+		{"bad tag", `[1]`, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got ktsdk.Tags
+			err := json.Unmarshal([]byte(tt.json), &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unmarshal(%s) = %v, want error: %v", tt.json, err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Unmarshal(%s) = %#v, want %#v", tt.json, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTagsLookup(t *testing.T) {
+	tags := ktsdk.Tags{{Key: "env", Value: "prod"}, {Key: "team", Value: ""}}
+
+	if value, ok := tags.Get("env"); !ok || value != "prod" {
+		t.Errorf("Get(env) = %q, %v, want prod, true", value, ok)
+	}
+	if value, ok := tags.Get("team"); !ok || value != "" {
+		t.Errorf("Get(team) = %q, %v, want \"\", true", value, ok)
+	}
+	if _, ok := tags.Get("owner"); ok {
+		t.Errorf("Get(owner) found a tag")
+	}
+	if !tags.Has("env", "prod") || tags.Has("env", "dev") {
+		t.Errorf("Has() doesn't match on both key and value")
+	}
+	if got, want := tags.AsMap(), map[string]string{"env": "prod", "team": ""}; !reflect.DeepEqual(got, want) {
+		t.Errorf("AsMap() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,149 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/trace"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+// Instrumentation scope name of the spans and metrics of this SDK.
+const instrumentationName = "github.com/cloud-barista/ktcloud-sdk-go"
+
+// Span and metric attribute keys.
+const (
+	attrCommand     = attribute.Key("ktcloud.command")
+	attrZoneId      = attribute.Key("ktcloud.zone_id")
+	attrErrorCode   = attribute.Key("ktcloud.error_code")
+	attrCSErrorCode = attribute.Key("ktcloud.cs_error_code")
+	attrJobId       = attribute.Key("ktcloud.job_id")
+	attrVMId        = attribute.Key("ktcloud.vm_id")
+	attrAttempt     = attribute.Key("ktcloud.attempt")
+	attrJobStatus   = attribute.Key("ktcloud.job_status")
+	attrVMState     = attribute.Key("ktcloud.vm_state")
+	attrHTTPStatus  = attribute.Key("http.response.status_code")
+)
+
+// telemetry holds the OpenTelemetry instruments of a client. A nil *telemetry records nothing.
+type telemetry struct {
+	tracer   trace.Tracer
+	requests metric.Int64Counter
+	errors   metric.Int64Counter
+	duration metric.Float64Histogram
+}
+
+func newTelemetry(tp trace.TracerProvider, mp metric.MeterProvider) *telemetry {
+	if tp == nil {
+		tp = tracenoop.NewTracerProvider()
+	}
+	if mp == nil {
+		mp = metricnoop.NewMeterProvider()
+	}
+	meter := mp.Meter(instrumentationName)
+	t := &telemetry{
+		tracer: tp.Tracer(instrumentationName),
+	}
+
+	var err error
+	if t.requests, err = meter.Int64Counter("ktcloud.client.requests",
+		metric.WithDescription("Number of KT Cloud API calls"), metric.WithUnit("{call}")); err != nil {
+		t.requests, _ = metricnoop.Meter{}.Int64Counter("")
+	}
+	if t.errors, err = meter.Int64Counter("ktcloud.client.errors",
+		metric.WithDescription("Number of failed KT Cloud API calls"), metric.WithUnit("{call}")); err != nil {
+		t.errors, _ = metricnoop.Meter{}.Int64Counter("")
+	}
+	if t.duration, err = meter.Float64Histogram("ktcloud.client.request.duration",
+		metric.WithDescription("Duration of KT Cloud API calls, including retries"), metric.WithUnit("s")); err != nil {
+		t.duration, _ = metricnoop.Meter{}.Float64Histogram("")
+	}
+	return t
+}
+
+// startSpan starts an internal span, e.g. of a waiter. It works on a nil *telemetry too.
+func (t *telemetry) startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if t == nil {
+		return ctx, tracenoop.Span{}
+	}
+	return t.tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// startCall starts the client span of an API command. The returned func ends it with the outcome of the call.
+func (t *telemetry) startCall(ctx context.Context, command string, params url.Values) (context.Context, func(error)) {
+	if t == nil {
+		return ctx, func(error) {}
+	}
+
+	attrs := []attribute.KeyValue{attrCommand.String(command)}
+	if zoneId := params.Get("zoneid"); zoneId != "" {
+		attrs = append(attrs, attrZoneId.String(zoneId))
+	}
+	ctx, span := t.tracer.Start(ctx, "KT Cloud "+command,
+		trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+	start := time.Now()
+
+	return ctx, func(err error) {
+		var apiErr *APIError
+		switch {
+		case err == nil:
+			span.SetAttributes(attrHTTPStatus.Int(http.StatusOK))
+		case errors.As(err, &apiErr):
+			span.SetAttributes(attrHTTPStatus.Int(apiErr.HTTPStatus))
+			if apiErr.ErrorCode != 0 {
+				span.SetAttributes(attrErrorCode.Int(apiErr.ErrorCode))
+			}
+			if apiErr.CSErrorCode != 0 {
+				span.SetAttributes(attrCSErrorCode.Int(apiErr.CSErrorCode))
+			}
+		}
+
+		metricAttrs := metric.WithAttributes(attrs...)
+		t.requests.Add(ctx, 1, metricAttrs)
+		t.duration.Record(ctx, time.Since(start).Seconds(), metricAttrs)
+		if err != nil {
+			recordError(span, err)
+			errAttrs := append(attrs[:len(attrs):len(attrs)], attrErrorCode.Int(errorCodeOf(err)))
+			t.errors.Add(ctx, 1, metric.WithAttributes(errAttrs...))
+		}
+		span.End()
+	}
+}
+
+// endSpan ends a span started by startSpan(), recording err if any.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		recordError(span, err)
+	}
+	span.End()
+}
+
+// recordError marks span as failed with err, whose signed URL, if any, is masked first.
+func recordError(span trace.Span, err error) {
+	err = redactError(err)
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// errorCodeOf returns the KT Cloud error code of err, or 0 when it isn't an *APIError.
+func errorCodeOf(err error) int {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode
+	}
+	return 0
+}
@@ -0,0 +1,83 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+	"github.com/cloud-barista/ktcloud-sdk-go/ktcloudtest"
+)
+
+// spanRecorder is a TracerProvider keeping the errors recorded on its spans.
+type spanRecorder struct {
+	noop.TracerProvider
+	mu     sync.Mutex
+	errors []string
+}
+
+func (sr *spanRecorder) Tracer(name string, opts ...trace.TracerOption) trace.Tracer {
+	return recordingTracer{sr: sr}
+}
+
+func (sr *spanRecorder) recorded() string {
+	sr.mu.Lock()
+	defer sr.mu.Unlock()
+	return strings.Join(sr.errors, "\n")
+}
+
+type recordingTracer struct {
+	noop.Tracer
+	sr *spanRecorder
+}
+
+func (rt recordingTracer) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return ctx, recordingSpan{sr: rt.sr}
+}
+
+type recordingSpan struct {
+	noop.Span
+	sr *spanRecorder
+}
+
+func (rs recordingSpan) RecordError(err error, opts ...trace.EventOption) {
+	rs.sr.mu.Lock()
+	defer rs.sr.mu.Unlock()
+	rs.sr.errors = append(rs.sr.errors, err.Error())
+}
+
+func (rs recordingSpan) SetStatus(code codes.Code, description string) {
+	rs.sr.mu.Lock()
+	defer rs.sr.mu.Unlock()
+	rs.sr.errors = append(rs.sr.errors, description)
+}
+
+func TestTelemetryErrorRedaction(t *testing.T) {
+	srv := ktcloudtest.NewServer()
+	srv.Close()
+	var spans spanRecorder
+	client := srv.Client(ktsdk.WithTracerProvider(&spans), ktsdk.WithRetryPolicy(nil))
+
+	if _, err := client.ListZones(true, "", "", ""); err == nil {
+		t.Fatalf("ListZones() of a closed server = nil, want an error")
+	}
+	recorded := spans.recorded()
+	if !strings.Contains(recorded, srv.URL) {
+		t.Errorf("recorded errors = %q, want the transport error", recorded)
+	}
+	if strings.Contains(recorded, srv.APIKey) || !strings.Contains(recorded, "signature=%2A%2A%2A%2A") {
+		t.Errorf("recorded errors = %q, want the apikey and the signature masked", recorded)
+	}
+}
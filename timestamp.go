@@ -0,0 +1,77 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Layout of the timestamps in KT Cloud responses. (ex. 2024-01-15T10:20:30+0900)
+const TimestampLayout = "2006-01-02T15:04:05-0700"
+
+// Layouts tried in order when decoding a Timestamp.
+var timestampLayouts = []string{
+	TimestampLayout,
+	time.RFC3339Nano, // Covers time.RFC3339 as well
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+}
+
+// Timestamp is a time sent by KT Cloud. (ex. 'created', 'allocated', 'attached')
+// An empty or null value decodes to the zero Timestamp (IsZero() is true), and is encoded back as "".
+type Timestamp struct {
+	time.Time
+}
+
+// Parses a timestamp in one of the layouts KT Cloud uses. An empty string gives the zero Timestamp.
+// A value without time zone is taken as UTC.
+func ParseTimestamp(s string) (Timestamp, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Timestamp{}, nil
+	}
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return Timestamp{Time: t}, nil
+		}
+	}
+	return Timestamp{}, fmt.Errorf("'%s' is not a valid KT Cloud timestamp", s)
+}
+
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*t = Timestamp{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("%s is not a valid KT Cloud timestamp", data)
+	}
+	parsed, err := ParseTimestamp(s)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// Returns the timestamp in TimestampLayout, or "" for the zero Timestamp.
+func (t Timestamp) String() string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(TimestampLayout)
+}
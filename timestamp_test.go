@@ -0,0 +1,74 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+)
+
+func TestTimestamp(t *testing.T) {
+	kst := time.FixedZone("", 9*60*60)
+	tests := []struct {
+		json    string
+		want    time.Time // Zero when the Timestamp is expected to be zero
+		wantErr bool
+	}{
+		{`"2024-01-15T10:20:30+0900"`, time.Date(2024, 1, 15, 10, 20, 30, 0, kst), false},
+		{`"2024-01-15T10:20:30+09:00"`, time.Date(2024, 1, 15, 10, 20, 30, 0, kst), false},
+		{`"2024-01-15T01:20:30.5Z"`, time.Date(2024, 1, 15, 1, 20, 30, 500000000, time.UTC), false},
+		{`"2024-01-15T10:20:30"`, time.Date(2024, 1, 15, 10, 20, 30, 0, time.UTC), false},
+		{`"2024-01-15 10:20:30"`, time.Date(2024, 1, 15, 10, 20, 30, 0, time.UTC), false},
+		{`""`, time.Time{}, false},
+		{`null`, time.Time{}, false},
+		{`"yesterday"`, time.Time{}, true},
+		{`1705281630`, time.Time{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.json, func(t *testing.T) {
+			var got ktsdk.Timestamp
+			err := json.Unmarshal([]byte(tt.json), &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unmarshal(%s) = %v, want error: %v", tt.json, err, tt.wantErr)
+			}
+			if tt.want.IsZero() {
+				if !got.IsZero() {
+					t.Errorf("Unmarshal(%s) = %v, want the zero Timestamp", tt.json, got)
+				}
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Unmarshal(%s) = %v, want %v", tt.json, got.Time, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimestampEncoding(t *testing.T) {
+	ts, err := ktsdk.ParseTimestamp("2024-01-15T10:20:30+0900")
+	if err != nil {
+		t.Fatalf("ParseTimestamp() = %v", err)
+	}
+	if got := ts.String(); got != "2024-01-15T10:20:30+0900" {
+		t.Errorf("String() = %q, want the KT Cloud layout", got)
+	}
+
+	data, err := json.Marshal(struct {
+		Created  ktsdk.Timestamp `json:"created"`
+		Attached ktsdk.Timestamp `json:"attached"`
+	}{Created: ts})
+	if err != nil {
+		t.Fatalf("Marshal() = %v", err)
+	}
+	if got, want := string(data), `{"created":"2024-01-15T10:20:30+0900","attached":""}`; got != want {
+		t.Errorf("Marshal() = %s, want %s", got, want)
+	}
+}
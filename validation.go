@@ -0,0 +1,181 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// FieldError is a problem with a single field of a request. (ex. ZoneId is empty)
+type FieldError struct {
+	Field   string // Name of the field in the request struct. (ex. ZoneId, Tags[0].Key)
+	Value   string // The rejected value
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return e.Field + " " + e.Message
+}
+
+// ValidationError is returned by the Validate() methods of the request types, and by the client methods
+// given an invalid request. The request is not sent to KT Cloud.
+type ValidationError struct {
+	Request string // Name of the request type. (ex. DeployVMReqInfo)
+	Errors  []*FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, fe := range e.Errors {
+		msgs[i] = fe.Error()
+	}
+	return fmt.Sprintf("Invalid %s : %s", e.Request, strings.Join(msgs, "; "))
+}
+
+// Unwrap makes each FieldError reachable by errors.As().
+func (e *ValidationError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, fe := range e.Errors {
+		errs[i] = fe
+	}
+	return errs
+}
+
+// Checks whether err is a *ValidationError, i.e. the request was rejected before being sent.
+func IsValidationError(err error) bool {
+	var validationErr *ValidationError
+	return errors.As(err, &validationErr)
+}
+
+// validate runs req.Validate() unless WithoutValidation() is given.
+func (c KtCloudClient) validate(req interface{ Validate() error }) error {
+	if c.skipValidation {
+		return nil
+	}
+	return req.Validate()
+}
+
+// fieldErrors collects the problems found by a Validate() method.
+type fieldErrors []*FieldError
+
+func (e *fieldErrors) add(field string, value string, format string, args ...interface{}) {
+	*e = append(*e, &FieldError{Field: field, Value: value, Message: fmt.Sprintf(format, args...)})
+}
+
+// err returns nil when nothing was found.
+func (e fieldErrors) err(request string) error {
+	if len(e) == 0 {
+		return nil
+	}
+	return &ValidationError{Request: request, Errors: e}
+}
+
+func (e *fieldErrors) required(field string, value string) {
+	if strings.TrimSpace(value) == "" {
+		e.add(field, value, "is required")
+	}
+}
+
+// oneOf accepts an empty value; use required() as well when it must be set.
+// The match is exact, as the value is sent as given. Enum types are checked with knownEnum() instead.
+func (e *fieldErrors) oneOf(field string, value string, allowed ...string) {
+	if value == "" {
+		return
+	}
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+	e.add(field, value, "must be one of %s", strings.Join(allowed, ", "))
+}
+
+// boolean checks an optional "true" / "false" field, in any case. The builder sends it in lower case.
+func (e *fieldErrors) boolean(field string, value string) {
+	if value != "" && !strings.EqualFold(value, "true") && !strings.EqualFold(value, "false") {
+		e.add(field, value, "must be true or false")
+	}
+}
+
+// number checks an optional numeric field, and returns its value (0 when empty or invalid).
+func (e *fieldErrors) number(field string, value string, min int) int {
+	if value == "" {
+		return 0
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < min {
+		e.add(field, value, "must be a number not less than %d", min)
+		return 0
+	}
+	return n
+}
+
+// port checks an optional port number, and returns its value (0 when empty or invalid).
+func (e *fieldErrors) port(field string, value string) int {
+	if value == "" {
+		return 0
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 || n > 65535 {
+		e.add(field, value, "must be a port number between 1 and 65535")
+		return 0
+	}
+	return n
+}
+
+// portRange checks that end, if given, isn't before start.
+func (e *fieldErrors) portRange(startField string, start string, endField string, end string) {
+	s, n := e.port(startField, start), e.port(endField, end)
+	if s > 0 && n > 0 && n < s {
+		e.add(endField, end, "must not be less than %s", startField)
+	}
+}
+
+func (e *fieldErrors) paging(page string, pageSize string) {
+	e.number("Page", page, 1)
+	e.number("PageSize", pageSize, 1)
+}
+
+func (e *fieldErrors) tags(field string, tags []TagArg) {
+	for i, tag := range tags {
+		e.required(fmt.Sprintf("%s[%d].Key", field, i), tag.Key)
+	}
+}
+
+func (e *fieldErrors) ip(field string, value string) {
+	if value != "" && net.ParseIP(value) == nil {
+		e.add(field, value, "must be an IP address")
+	}
+}
+
+// cidrList checks a comma-separated list of CIDRs. (ex. "10.0.0.0/8,192.168.0.0/16")
+func (e *fieldErrors) cidrList(field string, value string) {
+	if value == "" {
+		return
+	}
+	for _, cidr := range strings.Split(value, ",") {
+		if _, _, err := net.ParseCIDR(strings.TrimSpace(cidr)); err != nil {
+			e.add(field, value, "must be a comma-separated list of CIDRs")
+			return
+		}
+	}
+}
+
+// knownEnum checks an optional enum field against its constants.
+func knownEnum[T interface {
+	~string
+	IsKnown() bool
+}](e *fieldErrors, field string, value T) {
+	if value != "" && !value.IsKnown() {
+		e.add(field, string(value), "is not a known %T", value)
+	}
+}
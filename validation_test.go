@@ -0,0 +1,151 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+	"github.com/cloud-barista/ktcloud-sdk-go/ktcloudtest"
+)
+
+func TestValidate(t *testing.T) {
+	validNLB := ktsdk.CreateNLBReqInfo{
+		Name:            "nlb",
+		ZoneId:          ktcloudtest.ZoneSeoulM,
+		NLBOption:       ktsdk.NLBOptionRoundRobin,
+		ServicePort:     "80",
+		ServiceType:     ktsdk.ServiceTypeHTTP,
+		HealthCheckType: ktsdk.HealthCheckTypeTCP,
+	}
+	httpsNLB := validNLB
+	httpsNLB.ServiceType = "HTTPS"
+
+	tests := []struct {
+		name   string
+		req    interface{ Validate() error }
+		fields []string // Fields of the expected FieldErrors, in order. nil when the request is valid.
+	}{
+		{"deploy ok", ktsdk.DeployVMReqInfo{ZoneId: "z", ServiceOfferingId: "s", TemplateId: "t"}, nil},
+		{"deploy missing ids", ktsdk.DeployVMReqInfo{ServiceOfferingId: "s"}, []string{"ZoneId", "TemplateId"}},
+		{"deploy usage plan in upper case", ktsdk.DeployVMReqInfo{ZoneId: "z", ServiceOfferingId: "s", TemplateId: "t", UsagePlanType: "HOURLY"}, nil},
+		{"deploy unknown usage plan", ktsdk.DeployVMReqInfo{ZoneId: "z", ServiceOfferingId: "s", TemplateId: "t", UsagePlanType: "yearly"}, []string{"UsagePlanType"}},
+		{"list vms state in lower case", ktsdk.ListVMReqInfo{State: "running"}, nil},
+		{"list vms bad paging and detail", ktsdk.ListVMReqInfo{Page: "0", PageSize: "x", Details: []string{"nics", "all-of-it"}}, []string{"Page", "PageSize", "Details[1]"}},
+		{"list vms empty tag key", ktsdk.ListVMReqInfo{Tags: []ktsdk.TagArg{{Key: "", Value: "v"}}}, []string{"Tags[0].Key"}},
+		{"create volume by product code", ktsdk.CreateVolumeReqInfo{Name: "v", ZoneId: "z", ProductCode: "SSD 100G"}, nil},
+		{"create volume without offering", ktsdk.CreateVolumeReqInfo{Name: "v", ZoneId: "z"}, []string{"DiskOfferingId"}},
+		{"create volume vm without snapshot", ktsdk.CreateVolumeReqInfo{Name: "v", ZoneId: "z", DiskOfferingId: "d", VMId: "vm"}, []string{"VMId"}},
+		{"list volumes type in lower case", ktsdk.ListVolumeReqInfo{Type: "datadisk"}, nil},
+		{"resize ok", ktsdk.ResizeVolumeReqInfo{ID: "v", VMId: "vm", Size: "80", IsLinux: "N"}, nil},
+		{"resize bad size and flag", ktsdk.ResizeVolumeReqInfo{ID: "v", VMId: "vm", Size: "60", IsLinux: "yes"}, []string{"Size", "IsLinux"}},
+		{"resize 50 on windows", ktsdk.ResizeVolumeReqInfo{ID: "v", VMId: "vm", Size: "50", IsLinux: "N"}, []string{"Size"}},
+		{"attach bad device", ktsdk.AttachVolumeReqInfo{ID: "v", VMId: "vm", DeviceId: "-1"}, []string{"DeviceId"}},
+		{"firewall tcp ok", ktsdk.CreateFirewallRuleReqInfo{IpAddressId: "ip", Protocol: "TCP", StartPort: "22", EndPort: "80", CidrList: "10.0.0.0/8, 192.168.0.0/16"}, nil},
+		{"firewall tcp with icmp fields", ktsdk.CreateFirewallRuleReqInfo{IpAddressId: "ip", Protocol: ktsdk.ProtocolTCP, StartPort: "80", EndPort: "22", IcmpType: "8", CidrList: "10.0.0.0"}, []string{"CidrList", "EndPort", "IcmpType"}},
+		{"firewall tcp without ports", ktsdk.CreateFirewallRuleReqInfo{IpAddressId: "ip", Protocol: ktsdk.ProtocolTCP}, nil},
+		{"firewall icmp ok", ktsdk.CreateFirewallRuleReqInfo{IpAddressId: "ip", Protocol: "ICMP", IcmpType: "-1", IcmpCode: "-1"}, nil},
+		{"firewall icmp with port", ktsdk.CreateFirewallRuleReqInfo{IpAddressId: "ip", Protocol: "icmp", StartPort: "22"}, []string{"StartPort"}},
+		{"firewall unknown protocol", ktsdk.CreateFirewallRuleReqInfo{IpAddressId: "ip", Protocol: "sctp", StartPort: "22"}, []string{"Protocol"}},
+		{"port forwarding upper case", ktsdk.CreatePortForwardingRuleReqInfo{IpAddressId: "ip", VirtualmachineId: "vm", Protocol: "TCP", PrivatePort: "22", PublicPort: "2222"}, nil},
+		{"port forwarding icmp", ktsdk.CreatePortForwardingRuleReqInfo{IpAddressId: "ip", VirtualmachineId: "vm", Protocol: "icmp", PrivatePort: "22", PublicPort: "70000"}, []string{"Protocol", "PublicPort"}},
+		{"nlb ok", validNLB, nil},
+		{"nlb https without tls settings", httpsNLB, []string{"CipherGroupName", "SSLv3", "TLSv1", "TLSv11", "TLSv12"}},
+		{"add nlb vm bad ip", ktsdk.AddNLBVMReqInfo{NLBId: "1", VMId: "vm", IpAddress: "1.2.3", PublicPort: "80"}, []string{"IpAddress"}},
+		{"list templates without filter", ktsdk.ListTemplateReqInfo{}, nil},
+		{"list templates filter in upper case", ktsdk.ListTemplateReqInfo{TemplateFilter: "SELF"}, nil},
+		{"list public ips booleans in upper case", ktsdk.ListPublicIpReqInfo{ForVirtualNetwork: "TRUE", AllocatedOnly: "False"}, nil},
+		{"list public ips bad booleans", ktsdk.ListPublicIpReqInfo{ForVirtualNetwork: "yes", AllocatedOnly: "1"}, []string{"ForVirtualNetwork", "AllocatedOnly"}},
+		{"create tags without tags", ktsdk.CreateTagsReqInfo{ResourceType: "userVm", ResourceIds: []string{"vm"}}, []string{"Tags"}},
+		{"delete tags of all", ktsdk.DeleteTagsReqInfo{ResourceType: "userVm", ResourceIds: []string{"vm"}}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if tt.fields == nil {
+				if err != nil {
+					t.Fatalf("Validate() = %v, want nil", err)
+				}
+				return
+			}
+			var validationErr *ktsdk.ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("Validate() = %v, want a *ValidationError", err)
+			}
+			var fields []string
+			for _, fe := range validationErr.Errors {
+				fields = append(fields, fe.Field)
+			}
+			if !reflect.DeepEqual(fields, tt.fields) {
+				t.Errorf("fields = %v, want %v (%v)", fields, tt.fields, err)
+			}
+		})
+	}
+}
+
+func TestValidationByClient(t *testing.T) {
+	srv := ktcloudtest.NewServer()
+	defer srv.Close()
+
+	var sent url.Values
+	capture := func(ctx context.Context, command string, params url.Values, out interface{}, next ktsdk.Invoker) error {
+		sent = params
+		return next(ctx, command, params, out)
+	}
+	client := srv.Client(ktsdk.WithInterceptors(capture))
+
+	_, err := client.DeployVirtualMachine(ktsdk.DeployVMReqInfo{ServiceOfferingId: "s"})
+	if !ktsdk.IsValidationError(err) {
+		t.Fatalf("DeployVirtualMachine() = %v, want a validation error", err)
+	}
+	if sent != nil {
+		t.Errorf("an invalid request was sent : %v", sent)
+	}
+
+	// Enum values are matched regardless of case, and sent as their constant.
+	if _, err := client.ListVirtualMachines(ktsdk.ListVMReqInfo{State: "RUNNING"}); err != nil {
+		t.Fatalf("ListVirtualMachines() = %v", err)
+	}
+	if got := sent.Get("state"); got != string(ktsdk.VMStateRunning) {
+		t.Errorf("state = %q, want %q", got, ktsdk.VMStateRunning)
+	}
+
+	// Booleans are sent in lower case, and optional fields only when set.
+	if _, err := client.ListPublicIpAddresses(ktsdk.ListPublicIpReqInfo{ForVirtualNetwork: "TRUE"}); err != nil {
+		t.Fatalf("ListPublicIpAddresses() = %v", err)
+	}
+	if got := sent.Get("forvirtualnetwork"); got != "true" {
+		t.Errorf("forvirtualnetwork = %q, want %q", got, "true")
+	}
+	if _, err := client.ListTemplates(&ktsdk.ListTemplateReqInfo{}); err != nil {
+		t.Fatalf("ListTemplates() = %v", err)
+	}
+	if _, ok := sent["templatefilter"]; ok {
+		t.Errorf("templatefilter = %q, want it left out", sent.Get("templatefilter"))
+	}
+	if _, err := client.CreateVolume(ktsdk.CreateVolumeReqInfo{Name: "v", ZoneId: ktcloudtest.ZoneSeoulM, ProductCode: "SSD 100G"}); err != nil {
+		t.Fatalf("CreateVolume() = %v", err)
+	}
+	if _, ok := sent["diskofferingid"]; ok {
+		t.Errorf("diskofferingid = %q, want it left out with a ProductCode", sent.Get("diskofferingid"))
+	}
+
+	// WithoutValidation() leaves it to KT Cloud.
+	sent = nil
+	_, err = srv.Client(ktsdk.WithInterceptors(capture), ktsdk.WithoutValidation()).DeployVirtualMachine(ktsdk.DeployVMReqInfo{ServiceOfferingId: "s"})
+	if err == nil || ktsdk.IsValidationError(err) {
+		t.Errorf("DeployVirtualMachine() without validation = %v, want an API error", err)
+	}
+	if sent == nil {
+		t.Errorf("the request wasn't sent without validation")
+	}
+}
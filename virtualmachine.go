@@ -10,8 +10,11 @@
 package ktcloudsdk
 
 import (
+	"context"
 	"encoding/base64"
+	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
 )
 
@@ -23,7 +26,7 @@ type DeployVMReqInfo struct {
 	ProductCode 		string
 	VMHostName 			string
 	DisplayName 		string
-	UsagePlanType 		string
+	UsagePlanType 		UsagePlanType
 	RunSysPrep 			bool
 	Account 			string
 	DomainId 			string
@@ -40,11 +43,60 @@ type DeployVMReqInfo struct {
 type ListVMReqInfo struct {
 	ZoneId 				string
 	VMId 				string
+	Name 				string
+	State 				VMState  // ex) Running, Stopped, Starting, Stopping, Destroyed, Error
+	Keyword 			string
+	GroupId 			string   // VM Group ID
+	NetworkId 			string
+	Account 			string
+	DomainId 			string
+	Tags 				[]TagArg // VMs having all the given tags
+	IsRecursive 		bool     // Used with 'DomainId'. In case of 'true', the VMs of all the accounts in the domain are listed. (Default : false)
+	ListAll 			bool
+	Page 				string
+	PageSize 			string
+	Details 			[]string // Details to return : all, group, nics, stats, secgrp, tmpl, servoff, iso, volume, min (Default : all)
+}
+
+// Checks the fields of the request before it is sent. A problem is returned as a *ValidationError.
+func (r DeployVMReqInfo) Validate() error {
+	var errs fieldErrors
+	errs.required("ZoneId", r.ZoneId)
+	errs.required("ServiceOfferingId", r.ServiceOfferingId)
+	errs.required("TemplateId", r.TemplateId)
+	knownEnum(&errs, "UsagePlanType", r.UsagePlanType)
+	for i, ip := range r.IPtoNetworkList {
+		errs.required(fmt.Sprintf("IPtoNetworkList[%d]", i), ip)
+	}
+	for i, id := range r.NetworkIds {
+		errs.required(fmt.Sprintf("NetworkIds[%d]", i), id)
+	}
+	return errs.err("DeployVMReqInfo")
+}
+
+// Checks the fields of the request before it is sent. A problem is returned as a *ValidationError.
+func (r ListVMReqInfo) Validate() error {
+	var errs fieldErrors
+	knownEnum(&errs, "State", r.State)
+	errs.tags("Tags", r.Tags)
+	errs.paging(r.Page, r.PageSize)
+	for i, detail := range r.Details {
+		errs.oneOf(fmt.Sprintf("Details[%d]", i), detail, "all", "group", "nics", "stats", "secgrp", "tmpl", "servoff", "iso", "volume", "min")
+	}
+	return errs.err("ListVMReqInfo")
 }
 
 // Deploys a Virtual Machine and returns it's id
 func (c KtCloudClient) DeployVirtualMachine(vmReqInfo DeployVMReqInfo) (DeployVirtualMachineResponse, error) {
+	return c.DeployVirtualMachineWithContext(context.Background(), vmReqInfo)
+}
+
+// DeployVirtualMachineWithContext is DeployVirtualMachine with a context that bounds the underlying API call.
+func (c KtCloudClient) DeployVirtualMachineWithContext(ctx context.Context, vmReqInfo DeployVMReqInfo) (DeployVirtualMachineResponse, error) {
 	var resp DeployVirtualMachineResponse
+	if err := c.validate(vmReqInfo); err != nil {
+		return resp, err
+	}
 	params := url.Values{}
 
 	params.Set("zoneid", vmReqInfo.ZoneId)
@@ -64,7 +116,7 @@ func (c KtCloudClient) DeployVirtualMachine(vmReqInfo DeployVMReqInfo) (DeployVi
 		params.Set("displayname", vmReqInfo.DisplayName)
 	}
 	if vmReqInfo.UsagePlanType != "" {
-		params.Set("usageplantype", vmReqInfo.UsagePlanType)
+		params.Set("usageplantype", string(vmReqInfo.UsagePlanType.Canonical()))
 	}
 	if vmReqInfo.RunSysPrep {
 		params.Set("runsysprep", "true")
@@ -97,68 +149,86 @@ func (c KtCloudClient) DeployVirtualMachine(vmReqInfo DeployVMReqInfo) (DeployVi
 		params.Set("userdata", base64.StdEncoding.EncodeToString([]byte(vmReqInfo.UserData)))
 	}
 
-	response, err := NewRequest(c, "deployVirtualMachine", params)
-	if err != nil {
+	if err := c.Do(ctx, "deployVirtualMachine", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(DeployVirtualMachineResponse)
 	return resp, nil
 }
 
 // Start a Virtual Machine
 func (c KtCloudClient) StartVirtualMachine(vmId string) (StartVirtualMachineResponse, error) {
+	return c.StartVirtualMachineWithContext(context.Background(), vmId)
+}
+
+// StartVirtualMachineWithContext is StartVirtualMachine with a context that bounds the underlying API call.
+func (c KtCloudClient) StartVirtualMachineWithContext(ctx context.Context, vmId string) (StartVirtualMachineResponse, error) {
 	var resp StartVirtualMachineResponse
 	params := url.Values{}
 	params.Set("id", vmId)
-	response, err := NewRequest(c, "startVirtualMachine", params)
-	if err != nil {
+	if err := c.Do(ctx, "startVirtualMachine", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(StartVirtualMachineResponse)
 	return resp, nil
 }
 
 // Stops a Virtual Machine
 func (c KtCloudClient) StopVirtualMachine(vmId string) (StopVirtualMachineResponse, error) {
+	return c.StopVirtualMachineWithContext(context.Background(), vmId)
+}
+
+// StopVirtualMachineWithContext is StopVirtualMachine with a context that bounds the underlying API call.
+func (c KtCloudClient) StopVirtualMachineWithContext(ctx context.Context, vmId string) (StopVirtualMachineResponse, error) {
 	var resp StopVirtualMachineResponse
 	params := url.Values{}
 	params.Set("id", vmId)
-	response, err := NewRequest(c, "stopVirtualMachine", params)
-	if err != nil {
+	if err := c.Do(ctx, "stopVirtualMachine", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(StopVirtualMachineResponse)
 	return resp, nil
 }
 
 // Reboot a Virtual Machine
 func (c KtCloudClient) RebootVirtualMachine(vmId string) (RebootVirtualMachineResponse, error) {
+	return c.RebootVirtualMachineWithContext(context.Background(), vmId)
+}
+
+// RebootVirtualMachineWithContext is RebootVirtualMachine with a context that bounds the underlying API call.
+func (c KtCloudClient) RebootVirtualMachineWithContext(ctx context.Context, vmId string) (RebootVirtualMachineResponse, error) {
 	var resp RebootVirtualMachineResponse
 	params := url.Values{}
 	params.Set("id", vmId)
-	response, err := NewRequest(c, "rebootVirtualMachine", params)
-	if err != nil {
+	if err := c.Do(ctx, "rebootVirtualMachine", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(RebootVirtualMachineResponse)
 	return resp, nil
 }
 
 // Destroys a Virtual Machine
 func (c KtCloudClient) DestroyVirtualMachine(vmId string) (DestroyVirtualMachineResponse, error) {
+	return c.DestroyVirtualMachineWithContext(context.Background(), vmId)
+}
+
+// DestroyVirtualMachineWithContext is DestroyVirtualMachine with a context that bounds the underlying API call.
+func (c KtCloudClient) DestroyVirtualMachineWithContext(ctx context.Context, vmId string) (DestroyVirtualMachineResponse, error) {
 	var resp DestroyVirtualMachineResponse
 	params := url.Values{}
 	params.Set("id", vmId)
-	response, err := NewRequest(c, "destroyVirtualMachine", params)
-	if err != nil {
+	if err := c.Do(ctx, "destroyVirtualMachine", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(DestroyVirtualMachineResponse)
 	return resp, nil
 }
 
 func (c KtCloudClient) ListVirtualMachines(vmListReqInfo ListVMReqInfo) (ListVirtualMachinesResponse, error) {
+	return c.ListVirtualMachinesWithContext(context.Background(), vmListReqInfo)
+}
+
+// ListVirtualMachinesWithContext is ListVirtualMachines with a context that bounds the underlying API call.
+func (c KtCloudClient) ListVirtualMachinesWithContext(ctx context.Context, vmListReqInfo ListVMReqInfo) (ListVirtualMachinesResponse, error) {
 	var resp ListVirtualMachinesResponse
+	if err := c.validate(vmListReqInfo); err != nil {
+		return resp, err
+	}
 	params := url.Values{}
 
 	if vmListReqInfo.ZoneId != "" {
@@ -167,17 +237,60 @@ func (c KtCloudClient) ListVirtualMachines(vmListReqInfo ListVMReqInfo) (ListVir
 	if vmListReqInfo.VMId != "" {
 		params.Set("id", vmListReqInfo.VMId)
 	}
+	if vmListReqInfo.Name != "" {
+		params.Set("name", vmListReqInfo.Name)
+	}
+	if vmListReqInfo.State != "" {
+		params.Set("state", string(vmListReqInfo.State.Canonical()))
+	}
+	if vmListReqInfo.Keyword != "" {
+		params.Set("keyword", vmListReqInfo.Keyword)
+	}
+	if vmListReqInfo.GroupId != "" {
+		params.Set("groupid", vmListReqInfo.GroupId)
+	}
+	if vmListReqInfo.NetworkId != "" {
+		params.Set("networkid", vmListReqInfo.NetworkId)
+	}
+	if vmListReqInfo.Account != "" {
+		params.Set("account", vmListReqInfo.Account)
+	}
+	if vmListReqInfo.DomainId != "" {
+		params.Set("domainid", vmListReqInfo.DomainId)
+	}
+	for j, tag := range vmListReqInfo.Tags {
+		params.Set("tags["+strconv.Itoa(j+1)+"].key", tag.Key)
+		params.Set("tags["+strconv.Itoa(j+1)+"].value", tag.Value)
+	}
+	if vmListReqInfo.IsRecursive {
+		params.Set("isrecursive", "true")
+	}
+	if vmListReqInfo.ListAll {
+		params.Set("listall", "true")
+	}
+	if vmListReqInfo.Page != "" {
+		params.Set("page", vmListReqInfo.Page)
+	}
+	if vmListReqInfo.PageSize != "" {
+		params.Set("pagesize", vmListReqInfo.PageSize)
+	}
+	if len(vmListReqInfo.Details) > 0 {
+		params.Set("details", strings.Join(vmListReqInfo.Details, ","))
+	}
 
-	response, err := NewRequest(c, "listVirtualMachines", params)
-	if err != nil {
+	if err := c.Do(ctx, "listVirtualMachines", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(ListVirtualMachinesResponse)
 	return resp, nil
 }
 
 // KT Cloud > Computing > Server Management > 'Server : 부가 정보 변경'
 func (c KtCloudClient) UpdateVirtualMachine(vmId string, displayname string, haenable string) (UpdateVirtualMachineResponse, error) {
+	return c.UpdateVirtualMachineWithContext(context.Background(), vmId, displayname, haenable)
+}
+
+// UpdateVirtualMachineWithContext is UpdateVirtualMachine with a context that bounds the underlying API call.
+func (c KtCloudClient) UpdateVirtualMachineWithContext(ctx context.Context, vmId string, displayname string, haenable string) (UpdateVirtualMachineResponse, error) {
 	var resp UpdateVirtualMachineResponse
 	params := url.Values{}
 
@@ -185,11 +298,9 @@ func (c KtCloudClient) UpdateVirtualMachine(vmId string, displayname string, hae
 	params.Set("displayname", displayname)
 	params.Set("haenable", haenable)
 
-	response, err := NewRequest(c, "updateVirtualMachine", params)
-	if err != nil {
+	if err := c.Do(ctx, "updateVirtualMachine", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(UpdateVirtualMachineResponse)
 	return resp, nil
 }
 
@@ -236,7 +347,7 @@ type Nic struct {
 	BroadcastUri   	string	 `json:"broadcasturi"`
 	TrafficType 	string	 `json:"traffictype"`
 	Type        	string	 `json:"type"`
-	IsDefault   	bool  	 `json:"isdefault"`
+	IsDefault   	FlexBool `json:"isdefault"`
 	MacAddress  	string	 `json:"macaddress"`
 	SecondaryIp   	string	 `json:"secondaryip"`
 }
@@ -250,22 +361,22 @@ type Virtualmachine struct {
 	UserName            string        `json:"username"`
 	DomainId            string        `json:"domainid"`
 	Domain              string        `json:"domain"`
-	Created             string        `json:"created"`
-	State               string        `json:"state"`
-	Haenable            bool          `json:"haenable"`
+	Created             Timestamp     `json:"created"`
+	State               VMState        `json:"state"`
+	Haenable            FlexBool      `json:"haenable"`
 	ZoneId              string        `json:"zoneid"`
 	ZoneName            string        `json:"zonename"`
 	TemplateId          string        `json:"templateid"`  // VMImage ID
 	TemplateName        string        `json:"templatename"`
 	TemplateDisplayText string        `json:"templatedisplaytext"`
-	PasswordEnabled     bool          `json:"passwordenabled"`
+	PasswordEnabled     FlexBool      `json:"passwordenabled"`
 	ServiceOfferingId   string        `json:"serviceofferingid"`  // VMSpec ID  
 	ServiceOfferingName string        `json:"serviceofferingname"`
-	CpuNumber           float64       `json:"cpunumber"`
-	CpuSpeed            float64       `json:"cpuspeed"`
-	Memory              float64       `json:"memory"`
+	CpuNumber           FlexInt       `json:"cpunumber"`
+	CpuSpeed            FlexInt       `json:"cpuspeed"`
+	Memory              FlexInt       `json:"memory"`
 	GuestOsId           string        `json:"guestosid"`
-	RootDeviceId        float64       `json:"rootdeviceid"`
+	RootDeviceId        FlexInt       `json:"rootdeviceid"`
 	RootDeviceType      string        `json:"rootdevicetype"`
 	SecurityGroup       []interface{} `json:"securitygroup"`
 	Password      		string        `json:"password"`	// KT Cloud API로는 지원하지 않는다고함.(Blank)
@@ -273,14 +384,16 @@ type Virtualmachine struct {
 	Hypervisor          string        `json:"hypervisor"`
 	KeyPair             string        `json:"keypair"`	// ### Manual에는 parameter가 없으나 response 값 존재
 	AffinityGroup       string        `json:"affinitygroup"`
-	IsDynamicallyScalable string      `json:"isdynamicallyscalable"` // VM의 cpu 와 memory 에 대한 Scale up/down을 지원하기 위한 tools 포함 여부
-	OsTypeId            int       	  `json:"ostypeid"`
-	Tags                []interface{} `json:"tags"`
+	Group               string        `json:"group"`
+	GroupId             string        `json:"groupid"`
+	IsDynamicallyScalable FlexBool    `json:"isdynamicallyscalable"` // VM의 cpu 와 memory 에 대한 Scale up/down을 지원하기 위한 tools 포함 여부
+	OsTypeId            FlexString  `json:"ostypeid"`
+	Tags                Tags          `json:"tags"`
 }
 
 type ListVirtualMachinesResponse struct {
 	Listvirtualmachinesresponse struct {
-		Count          float64          `json:"count"`
+		Count          FlexInt          `json:"count"`
 		Virtualmachine []Virtualmachine `json:"virtualmachine"`
 	} `json:"listvirtualmachinesresponse"`
 }
@@ -0,0 +1,98 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk_test
+
+import (
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+	"github.com/cloud-barista/ktcloud-sdk-go/ktcloudtest"
+)
+
+func TestListVirtualMachinesFilters(t *testing.T) {
+	tests := []struct {
+		name string
+		req  ktsdk.ListVMReqInfo
+		want url.Values // Params sent, other than apikey, command, response and signature
+	}{
+		{"no filter", ktsdk.ListVMReqInfo{}, url.Values{}},
+		{
+			name: "every filter",
+			req: ktsdk.ListVMReqInfo{
+				ZoneId:      ktcloudtest.ZoneSeoulM,
+				VMId:        "vm-1",
+				Name:        "web-1",
+				State:       "running",
+				Keyword:     "web",
+				GroupId:     "group-1",
+				NetworkId:   "network-1",
+				Account:     "account-1",
+				DomainId:    "domain-1",
+				Tags:        []ktsdk.TagArg{{Key: "env", Value: "prod"}, {Key: "team", Value: "cloud barista"}},
+				IsRecursive: true,
+				ListAll:     true,
+				Page:        "2",
+				PageSize:    "50",
+				Details:     []string{"nics", "stats"},
+			},
+			want: url.Values{
+				"zoneid":        {ktcloudtest.ZoneSeoulM},
+				"id":            {"vm-1"},
+				"name":          {"web-1"},
+				"state":         {"Running"},
+				"keyword":       {"web"},
+				"groupid":       {"group-1"},
+				"networkid":     {"network-1"},
+				"account":       {"account-1"},
+				"domainid":      {"domain-1"},
+				"tags[1].key":   {"env"},
+				"tags[1].value": {"prod"},
+				"tags[2].key":   {"team"},
+				"tags[2].value": {"cloud barista"},
+				"isrecursive":   {"true"},
+				"listall":       {"true"},
+				"page":          {"2"},
+				"pagesize":      {"50"},
+				"details":       {"nics,stats"},
+			},
+		},
+		{
+			name: "tag without value",
+			req:  ktsdk.ListVMReqInfo{Tags: []ktsdk.TagArg{{Key: "env"}}},
+			want: url.Values{"tags[1].key": {"env"}, "tags[1].value": {""}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := ktcloudtest.NewServer()
+			defer srv.Close()
+			var requests requestRecorder
+			client := srv.Client(ktsdk.WithTransport(&requests))
+
+			// The server checks the signature, so the call also tells that the tags are signed the way KT Cloud expects.
+			if _, err := client.ListVirtualMachines(tt.req); err != nil {
+				t.Fatalf("ListVirtualMachines() = %v", err)
+			}
+			sent := requests.requests[0].URL
+			got := sent.Query()
+			for _, key := range []string{"apikey", "command", "response", "signature"} {
+				got.Del(key)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("params = %v, want %v", got, tt.want)
+			}
+			// The brackets of the tag index are escaped in the URL.
+			if len(tt.req.Tags) > 0 && !strings.Contains(sent.RawQuery, "tags%5B1%5D.key=env") {
+				t.Errorf("query = %s, want the escaped tags[1].key", sent.RawQuery)
+			}
+		})
+	}
+}
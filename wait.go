@@ -10,137 +10,147 @@
 package ktcloudsdk
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"time"
-	"github.com/sirupsen/logrus"
 
 	cblog "github.com/cloud-barista/cb-log"
 )
 
-var cblogger *logrus.Logger
-
-func init() {
-	// cblog is a global variable.
-	cblogger = cblog.GetLogger("KT Cloud SDK Go")
-}
+// cblog is a global variable.
+var cblogger = cblog.GetLogger("KT Cloud SDK Go")
 
 // Blocks until the the asynchronous job has executed or has timed out.
 // time.Duration unit => 1 nanosecond.  timeOut * 1,000,000,000 => 1 second
 func (c KtCloudClient) WaitForAsyncJob(jobId string, timeOut time.Duration) error {
-	done := make(chan struct{})
-	defer close(done)
-
-	result := make(chan error, 1)
-	go func() {
-		attempts := 0
-		for {
-			attempts += 1
-
-			cblogger.Infof("Checking the async job status... (attempt: %d)", attempts)
-			response, err := c.QueryAsyncJobResult(jobId)
-			if err != nil {
-				result <- err
-				return
-			}
-
-			// Check status of the job
-			// 0 - Pending / In progress, Continue job
-			// 1 - Succeeded
-			// 2 - Failed
-			status := response.Queryasyncjobresultresponse.JobStatus
-			cblogger.Infof("The job status : %d", status)
-			switch status {
-			case 1:
-				result <- nil
-				return
-			case 2:
-				err := fmt.Errorf("WaitForAsyncJob() failed. : %s", response.Queryasyncjobresultresponse.JobResult.ErrorText)
-				result <- err
-				return
-			}
-
-			// Wait 3 seconds between requests
-			time.Sleep(3 * time.Second)
-
-			// Verify whether we shouldn't exit or ...
-			select {
-			case <-done:
-				// Finished, so just exit the goroutine
-				return
-			default:
-				// Keep going
-			}
-		}
-	}()
+	return c.WaitForAsyncJobWithContext(context.Background(), jobId, timeOut)
+}
+
+// WaitForAsyncJobWithContext is WaitForAsyncJob that also gives up as soon as ctx is done.
+// The status queries are issued with ctx, so an in-flight poll is cancelled as well.
+func (c KtCloudClient) WaitForAsyncJobWithContext(ctx context.Context, jobId string, timeOut time.Duration) (err error) {
+	ctx, span := c.telemetry.startSpan(ctx, "WaitForAsyncJob", attrJobId.String(jobId))
+	defer func() { endSpan(span, err) }()
+
+	ctx, cancel := context.WithTimeout(ctx, timeOut)
+	defer cancel()
 
 	cblogger.Infof("# Waiting for up to %f seconds for async job : %s", timeOut.Seconds(), jobId)
-	select {
-	case err := <-result:
-		return err
-	case <-time.After(timeOut):
-		err := fmt.Errorf("Timeout while waiting to for the async job to finish")
-		return err
+	attempts := 0
+	for {
+		attempts += 1
+
+		cblogger.Infof("Checking the async job status... (attempt: %d)", attempts)
+		pollCtx, pollSpan := c.telemetry.startSpan(ctx, "WaitForAsyncJob poll", attrJobId.String(jobId), attrAttempt.Int(attempts))
+		response, err := c.QueryAsyncJobResultWithContext(pollCtx, jobId)
+		if err == nil {
+			pollSpan.SetAttributes(attrJobStatus.Int(int(response.Queryasyncjobresultresponse.JobStatus)))
+		}
+		endSpan(pollSpan, err)
+		if err != nil {
+			return waitError(ctx, err, "Timeout while waiting to for the async job to finish")
+		}
+
+		// Check status of the job
+		// 0 - Pending / In progress, Continue job
+		// 1 - Succeeded
+		// 2 - Failed
+		status := response.Queryasyncjobresultresponse.JobStatus
+		cblogger.Infof("The job status : %d", status)
+		switch status {
+		case 1:
+			return nil
+		case 2:
+			jobResult := response.Queryasyncjobresultresponse.JobResult
+			return fmt.Errorf("WaitForAsyncJob() failed. : %w", &APIError{
+				Command:     response.Queryasyncjobresultresponse.Cmd,
+				HTTPStatus:  http.StatusOK,
+				ErrorCode:   int(jobResult.ErrorCode),
+				CSErrorCode: int(jobResult.CSErrorCode),
+				ErrorText:   jobResult.ErrorText,
+			})
+		}
+
+		// Wait 3 seconds between requests
+		if err := sleepContext(ctx, 3*time.Second); err != nil {
+			return waitError(ctx, err, "Timeout while waiting to for the async job to finish")
+		}
 	}
 }
 
 // WaitForVirtualMachineState simply blocks until the virtual machine is in the specified state.
 func (c KtCloudClient) WaitForVirtualMachineState(zoneId string, vmId string, wantedState string, timeOut time.Duration) error {
+	return c.WaitForVirtualMachineStateWithContext(context.Background(), zoneId, vmId, wantedState, timeOut)
+}
+
+// WaitForVirtualMachineStateWithContext is WaitForVirtualMachineState that also gives up as soon as ctx is done.
+func (c KtCloudClient) WaitForVirtualMachineStateWithContext(ctx context.Context, zoneId string, vmId string, wantedState string, timeOut time.Duration) (err error) {
 	vmListReqInfo := ListVMReqInfo{
 		ZoneId: 	zoneId,
 		VMId: 		vmId,
 	}
+	// The states in the responses are decoded to their constants, so the wanted one must be too. (ex. "Running" => "running")
+	wanted := VMState(wantedState).Canonical()
 
-	done := make(chan struct{})
-	defer close(done)
-
-	result := make(chan error, 1)
-	go func() {
-		attempts := 0
-		for {
-			attempts += 1
-
-			cblogger.Infof("Checking the VM state... (attempt: %d)", attempts)
-			response, err := c.ListVirtualMachines(vmListReqInfo)
-			if err != nil {
-				result <- err
-				return
-			}
-
-			count := response.Listvirtualmachinesresponse.Count
-			if count != 1 {
-				result <- err
-				return
-			}
-
-			currentState := response.Listvirtualmachinesresponse.Virtualmachine[0].State
-			// Check what the real state will be.
-			cblogger.Infof("Current state: %s", currentState)
-			cblogger.Infof("Wanted state:  %s", wantedState)
-			if currentState == wantedState {
-				result <- nil
-				return
-			}
-
-			// Wait 3 seconds in between
-			time.Sleep(3 * time.Second)
-
-			// Verify whether we shouldn't exit or ...
-			select {
-			case <-done:
-				// Finished, so just exit the goroutine
-				return
-			default:
-				// Keep going
-			}
-		}
-	}()
+	ctx, span := c.telemetry.startSpan(ctx, "WaitForVirtualMachineState", attrVMId.String(vmId), attrVMState.String(string(wanted)))
+	defer func() { endSpan(span, err) }()
+
+	ctx, cancel := context.WithTimeout(ctx, timeOut)
+	defer cancel()
 
 	cblogger.Infof("# Waiting for up to %f seconds for VM state to converge", timeOut.Seconds())
+	attempts := 0
+	for {
+		attempts += 1
+
+		cblogger.Infof("Checking the VM state... (attempt: %d)", attempts)
+		pollCtx, pollSpan := c.telemetry.startSpan(ctx, "WaitForVirtualMachineState poll", attrVMId.String(vmId), attrAttempt.Int(attempts))
+		response, err := c.ListVirtualMachinesWithContext(pollCtx, vmListReqInfo)
+		endSpan(pollSpan, err)
+		if err != nil {
+			return waitError(ctx, err, "Timeout while waiting to for the VM to converge")
+		}
+
+		count := response.Listvirtualmachinesresponse.Count
+		if count != 1 {
+			// As before, a VM that doesn't show up in the list ends the wait without an error.
+			return nil
+		}
+
+		currentState := response.Listvirtualmachinesresponse.Virtualmachine[0].State
+		// Check what the real state will be.
+		cblogger.Infof("Current state: %s", currentState)
+		cblogger.Infof("Wanted state:  %s", wanted)
+		if currentState == wanted {
+			return nil
+		}
+
+		// Wait 3 seconds in between
+		if err := sleepContext(ctx, 3*time.Second); err != nil {
+			return waitError(ctx, err, "Timeout while waiting to for the VM to converge")
+		}
+	}
+}
+
+// sleepContext pauses for d, returning early with ctx.Err() if ctx is done first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
 	select {
-	case err := <-result:
-		return err
-	case <-time.After(timeOut):
-		err := fmt.Errorf("Timeout while waiting to for the VM to converge")
-		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// waitError keeps the historical timeout message when the waiter's own deadline expired,
+// and otherwise returns err as is so callers can still see the cause.
+func waitError(ctx context.Context, err error, timeoutMsg string) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("%s : %w", timeoutMsg, err)
 	}
+	return err
 }
@@ -0,0 +1,188 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+	"github.com/cloud-barista/ktcloud-sdk-go/ktcloudtest"
+)
+
+// deployRunningVM deploys a VM with client and waits for its deploy job.
+func deployRunningVM(t *testing.T, client *ktsdk.KtCloudClient) string {
+	t.Helper()
+	resp, err := client.DeployVirtualMachine(ktsdk.DeployVMReqInfo{ZoneId: ktcloudtest.ZoneSeoulM, ServiceOfferingId: "s", TemplateId: "t"})
+	if err != nil {
+		t.Fatalf("DeployVirtualMachine() = %v", err)
+	}
+	if err := client.WaitForAsyncJob(resp.Deployvirtualmachineresponse.JobId, 10*time.Second); err != nil {
+		t.Fatalf("WaitForAsyncJob() = %v", err)
+	}
+	return resp.Deployvirtualmachineresponse.ID
+}
+
+func TestWaitForVirtualMachineState(t *testing.T) {
+	srv := ktcloudtest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	vmId := deployRunningVM(t, client)
+
+	tests := []struct {
+		name    string
+		wanted  string
+		wantErr bool
+	}{
+		{"constant", string(ktsdk.VMStateRunning), false},
+		{"lower case", "running", false},
+		{"upper case", "RUNNING", false},
+		{"other state", string(ktsdk.VMStateStopped), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := client.WaitForVirtualMachineState(ktcloudtest.ZoneSeoulM, vmId, tt.wanted, 500*time.Millisecond)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("WaitForVirtualMachineState(%q) = %v, want error: %v", tt.wanted, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWaitForAsyncJobFailures(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(srv *ktcloudtest.Server)
+		jobId   string // Default : the job of a new deploy
+		timeout time.Duration
+		cancel  time.Duration // Cancel the context after this long. 0 : not cancelled
+		check   func(t *testing.T, err error)
+	}{
+		{
+			name:  "failed job",
+			setup: func(srv *ktcloudtest.Server) { srv.FailJobs("deployVirtualMachine", "Insufficient capacity", 1) },
+			check: func(t *testing.T, err error) {
+				// The job failed, not the HTTP request : the *APIError has HTTPStatus 200 and the job result.
+				var apiErr *ktsdk.APIError
+				if !errors.As(err, &apiErr) {
+					t.Fatalf("WaitForAsyncJob() = %v, want an *APIError", err)
+				}
+				want := ktsdk.APIError{Command: "deployVirtualMachine", HTTPStatus: http.StatusOK, ErrorCode: ktsdk.ErrCodeInternalError,
+					CSErrorCode: 4250, ErrorText: "Insufficient capacity"}
+				if *apiErr != want {
+					t.Errorf("WaitForAsyncJob() = %+v, want %+v", *apiErr, want)
+				}
+			},
+		},
+		{
+			name:    "timeout",
+			setup:   func(srv *ktcloudtest.Server) { srv.HoldJobs("deployVirtualMachine") },
+			timeout: 200 * time.Millisecond,
+			check: func(t *testing.T, err error) {
+				if !errors.Is(err, context.DeadlineExceeded) || !strings.HasPrefix(err.Error(), "Timeout while waiting") {
+					t.Errorf("WaitForAsyncJob() = %v, want a timeout", err)
+				}
+			},
+		},
+		{
+			name:   "cancelled",
+			setup:  func(srv *ktcloudtest.Server) { srv.HoldJobs("deployVirtualMachine") },
+			cancel: 100 * time.Millisecond,
+			check: func(t *testing.T, err error) {
+				if !errors.Is(err, context.Canceled) || strings.HasPrefix(err.Error(), "Timeout") {
+					t.Errorf("WaitForAsyncJob() = %v, want %v", err, context.Canceled)
+				}
+			},
+		},
+		{
+			name: "failed poll",
+			setup: func(srv *ktcloudtest.Server) {
+				srv.InjectFault(ktcloudtest.Fault{Command: "queryAsyncJobResult", Times: 1, HTTPStatus: http.StatusUnauthorized})
+			},
+			check: func(t *testing.T, err error) {
+				if !ktsdk.IsAuthError(err) {
+					t.Errorf("WaitForAsyncJob() = %v, want the auth error of the poll", err)
+				}
+			},
+		},
+		{
+			name:  "unknown job",
+			jobId: "no-such-job",
+			check: func(t *testing.T, err error) {
+				if !ktsdk.IsNotFound(err) {
+					t.Errorf("WaitForAsyncJob() = %v, want a not found error", err)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := ktcloudtest.NewServer()
+			defer srv.Close()
+			client := srv.Client()
+
+			if tt.setup != nil {
+				tt.setup(srv)
+			}
+			jobId := tt.jobId
+			if jobId == "" {
+				resp, err := client.DeployVirtualMachine(ktsdk.DeployVMReqInfo{ZoneId: ktcloudtest.ZoneSeoulM, ServiceOfferingId: "s", TemplateId: "t"})
+				if err != nil {
+					t.Fatalf("DeployVirtualMachine() = %v", err)
+				}
+				jobId = resp.Deployvirtualmachineresponse.JobId
+			}
+			ctx := context.Background()
+			if tt.cancel > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithCancel(ctx)
+				time.AfterFunc(tt.cancel, cancel)
+			}
+			timeout := tt.timeout
+			if timeout == 0 {
+				timeout = 10 * time.Second
+			}
+
+			start := time.Now()
+			err := client.WaitForAsyncJobWithContext(ctx, jobId, timeout)
+			if elapsed := time.Since(start); elapsed > 2*time.Second {
+				t.Errorf("WaitForAsyncJob() returned after %v", elapsed)
+			}
+			tt.check(t, err)
+		})
+	}
+}
+
+func TestWaitForVirtualMachineStateFailures(t *testing.T) {
+	srv := ktcloudtest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	vmId := deployRunningVM(t, client)
+
+	err := client.WaitForVirtualMachineState(ktcloudtest.ZoneSeoulM, vmId, string(ktsdk.VMStateStopped), 200*time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) || !strings.HasPrefix(err.Error(), "Timeout while waiting") {
+		t.Errorf("WaitForVirtualMachineState() = %v, want a timeout", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+	err = client.WaitForVirtualMachineStateWithContext(ctx, ktcloudtest.ZoneSeoulM, vmId, string(ktsdk.VMStateStopped), 10*time.Second)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("WaitForVirtualMachineState() = %v, want %v", err, context.Canceled)
+	}
+
+	srv.InjectFault(ktcloudtest.Fault{Command: "listVirtualMachines", Times: 1, HTTPStatus: http.StatusUnauthorized})
+	err = client.WaitForVirtualMachineState(ktcloudtest.ZoneSeoulM, vmId, string(ktsdk.VMStateRunning), 10*time.Second)
+	if !ktsdk.IsAuthError(err) {
+		t.Errorf("WaitForVirtualMachineState() = %v, want the auth error of the poll", err)
+	}
+}
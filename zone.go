@@ -8,10 +8,16 @@
 package ktcloudsdk
 
 import (
+	"context"
 	"net/url"
 )
 
 func (c KtCloudClient) ListZones(isAvailable bool, domainId string, zoneId string, keyword string) (ListZonesResponse, error) {
+	return c.ListZonesWithContext(context.Background(), isAvailable, domainId, zoneId, keyword)
+}
+
+// ListZonesWithContext is ListZones with a context that bounds the underlying API call.
+func (c KtCloudClient) ListZonesWithContext(ctx context.Context, isAvailable bool, domainId string, zoneId string, keyword string) (ListZonesResponse, error) {
 	var resp ListZonesResponse
 	params := url.Values{}
 
@@ -31,28 +37,26 @@ func (c KtCloudClient) ListZones(isAvailable bool, domainId string, zoneId strin
 		params.Set("keyword", keyword)
 	}
 
-	response, err := NewRequest(c, "listZones", params)
-	if err != nil {
+	if err := c.Do(ctx, "listZones", params, &resp); err != nil {
 		return resp, err
 	}
-	resp = response.(ListZonesResponse)
-	return resp, err
+	return resp, nil
 }
 
 type Zone struct {
 	ID	                  string            `json:"id"`
 	NetworkType           string            `json:"networktype"`
-	SecurityGroupsEnabled bool              `json:"securitygroupsenabled"`
+	SecurityGroupsEnabled FlexBool          `json:"securitygroupsenabled"`
 	AllocationState       string            `json:"allocationstate"`
 	DhcpProvider          string            `json:"dhcpprovider"`
-	LocalStorageEnabled   bool              `json:"localstorageenabled"`
-	Tags                  []interface{} 	`json:"tags"`
+	LocalStorageEnabled   FlexBool          `json:"localstorageenabled"`
+	Tags                  Tags 			`json:"tags"`
 	Name                  string            `json:"name"`
 }
 
 type ListZonesResponse struct {
 	Listzonesresponse struct {
-		Count          int          `json:"count"`
+		Count          FlexInt          `json:"count"`
 		Zone 		   []Zone 		`json:"zone"`
 	} `json:"listZonesResponse"`
 }
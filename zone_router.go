@@ -0,0 +1,1026 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// KT Cloud API endpoints. The 'KOR-Seoul M2' zone is only served by API v2, the other zones by API v1.
+const (
+	APIv1URL = "https://api.ucloudbiz.olleh.com/server/v1/client/api"
+	APIv2URL = "https://api.ucloudbiz.olleh.com/server/v2/client/api"
+)
+
+// Minimum time between two zone discoveries triggered by a zone ID that isn't known.
+// In between, such a zone ID fails right away.
+const zoneRefreshInterval = time.Minute
+
+// ZoneAwareClient talks to several KT Cloud API endpoints at once and routes each call to the endpoint
+// that serves the zone (or owns the resource) it is about, so callers don't have to pick the API version.
+// The zone to endpoint mapping is discovered with 'listZones' on the first use and then cached.
+// It is safe for concurrent use.
+type ZoneAwareClient struct {
+	clients []*KtCloudClient
+
+	mu        sync.RWMutex
+	zones     []Zone
+	zoneIndex map[string]*KtCloudClient // Zone ID => client of the endpoint serving it
+	refreshed time.Time                 // When the zones were last discovered
+	owners    map[string]*KtCloudClient // Resource or async job ID => client of the endpoint owning it. Removed once deleted or over.
+}
+
+// Creates a ZoneAwareClient for the KT Cloud API v1 and v2 endpoints.
+func NewZoneAwareClient(apiKey string, secretKey string, insecureSkipVerify bool, opts ...ClientOption) *ZoneAwareClient {
+	return NewZoneAwareClientWithEndpoints([]string{APIv1URL, APIv2URL}, apiKey, secretKey, insecureSkipVerify, opts...)
+}
+
+// Creates a ZoneAwareClient for the given API endpoints. When zones show up on several endpoints, the earlier one wins.
+func NewZoneAwareClientWithEndpoints(apiUrls []string, apiKey string, secretKey string, insecureSkipVerify bool, opts ...ClientOption) *ZoneAwareClient {
+	z := &ZoneAwareClient{
+		owners: make(map[string]*KtCloudClient),
+	}
+	for _, apiUrl := range apiUrls {
+		z.clients = append(z.clients, KtCloudClient{}.New(apiUrl, apiKey, secretKey, insecureSkipVerify, opts...))
+	}
+	return z
+}
+
+// Returns the per endpoint clients, in the order of the endpoints.
+func (z *ZoneAwareClient) Endpoints() []*KtCloudClient {
+	return z.clients
+}
+
+// Discovers the zones of every endpoint again and replaces the cached zone mapping.
+// The zones of the endpoints that answered are kept even when others fail, whose errors are returned joined.
+// The mapping is left as is when no endpoint answers.
+func (z *ZoneAwareClient) Refresh(ctx context.Context) error {
+	var zones []Zone
+	var errs []error
+	zoneIndex := make(map[string]*KtCloudClient)
+	for _, c := range z.clients {
+		response, err := c.ListZonesWithContext(ctx, true, "", "", "")
+		if err != nil {
+			errs = append(errs, fmt.Errorf("Failed to Get the List of Zones from '%s' : %w", c.BaseURL, err))
+			continue
+		}
+		for _, zone := range response.Listzonesresponse.Zone {
+			if _, ok := zoneIndex[zone.ID]; ok {
+				continue
+			}
+			zoneIndex[zone.ID] = c
+			zones = append(zones, zone)
+		}
+	}
+
+	if len(errs) == len(z.clients) && len(z.clients) > 0 {
+		return errors.Join(errs...)
+	}
+
+	z.mu.Lock()
+	z.zones = zones
+	z.zoneIndex = zoneIndex
+	z.refreshed = time.Now()
+	z.mu.Unlock()
+	return errors.Join(errs...)
+}
+
+// refreshDue checks whether a zone discovery may be made for an unknown zone ID, and if so claims it,
+// so that concurrent callers don't make one each.
+func (z *ZoneAwareClient) refreshDue() bool {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+	if time.Since(z.refreshed) < zoneRefreshInterval {
+		return false
+	}
+	z.refreshed = time.Now()
+	return true
+}
+
+func (z *ZoneAwareClient) ensureZones(ctx context.Context) error {
+	z.mu.RLock()
+	loaded := z.zoneIndex != nil
+	z.mu.RUnlock()
+	if loaded {
+		return nil
+	}
+	// Endpoints that failed are tried again when one of their zones is asked for. See ClientForZone().
+	err := z.Refresh(ctx)
+	z.mu.RLock()
+	loaded = z.zoneIndex != nil
+	z.mu.RUnlock()
+	if loaded {
+		return nil
+	}
+	return err
+}
+
+// Returns the available zones of all the endpoints.
+func (z *ZoneAwareClient) ListZones(ctx context.Context) ([]Zone, error) {
+	if err := z.ensureZones(ctx); err != nil {
+		return nil, err
+	}
+	z.mu.RLock()
+	defer z.mu.RUnlock()
+	return append([]Zone(nil), z.zones...), nil
+}
+
+// Returns the client of the endpoint that serves the zone.
+func (z *ZoneAwareClient) ClientForZone(ctx context.Context, zoneId string) (*KtCloudClient, error) {
+	if err := z.ensureZones(ctx); err != nil {
+		return nil, err
+	}
+	z.mu.RLock()
+	c, ok := z.zoneIndex[zoneId]
+	z.mu.RUnlock()
+	if ok {
+		return c, nil
+	}
+
+	// The zone may have been added after the discovery. Not more than once per zoneRefreshInterval though.
+	var refreshErr error
+	if z.refreshDue() {
+		refreshErr = z.Refresh(ctx)
+		z.mu.RLock()
+		c, ok = z.zoneIndex[zoneId]
+		z.mu.RUnlock()
+	}
+	if !ok {
+		if refreshErr != nil {
+			return nil, fmt.Errorf("Failed to Find the Zone '%s' on any KT Cloud API endpoint : %w", zoneId, refreshErr)
+		}
+		return nil, fmt.Errorf("Failed to Find the Zone '%s' on any KT Cloud API endpoint", zoneId)
+	}
+	return c, nil
+}
+
+// Returns the client of the endpoint that owns the VM.
+func (z *ZoneAwareClient) ClientForVM(ctx context.Context, vmId string) (*KtCloudClient, error) {
+	return z.clientForResource(ctx, "VM", vmId, func(ctx context.Context, c *KtCloudClient) (bool, error) {
+		response, err := c.ListVirtualMachinesWithContext(ctx, ListVMReqInfo{VMId: vmId})
+		if err != nil {
+			return false, err
+		}
+		return len(response.Listvirtualmachinesresponse.Virtualmachine) > 0, nil
+	})
+}
+
+// Returns the client of the endpoint that owns the disk volume.
+func (z *ZoneAwareClient) ClientForVolume(ctx context.Context, volumeId string) (*KtCloudClient, error) {
+	return z.clientForResource(ctx, "Volume", volumeId, func(ctx context.Context, c *KtCloudClient) (bool, error) {
+		response, err := c.ListVolumesWithContext(ctx, ListVolumeReqInfo{ID: volumeId})
+		if err != nil {
+			return false, err
+		}
+		return len(response.Listvolumesresponse.Volume) > 0, nil
+	})
+}
+
+// Returns the client of the endpoint that owns the public IP.
+func (z *ZoneAwareClient) ClientForPublicIp(ctx context.Context, publicIpId string) (*KtCloudClient, error) {
+	return z.clientForResource(ctx, "Public IP", publicIpId, func(ctx context.Context, c *KtCloudClient) (bool, error) {
+		response, err := c.ListPublicIpAddressesWithContext(ctx, ListPublicIpReqInfo{ID: publicIpId})
+		if err != nil {
+			return false, err
+		}
+		return len(response.Listpublicipaddressesresponse.PublicIpAddress) > 0, nil
+	})
+}
+
+// Returns the client of the endpoint that owns the Load-Balancer.
+func (z *ZoneAwareClient) ClientForNLB(ctx context.Context, nlbId string) (*KtCloudClient, error) {
+	return z.clientForResource(ctx, "Load-Balancer", nlbId, func(ctx context.Context, c *KtCloudClient) (bool, error) {
+		response, err := c.ListNLBsWithContext(ctx, ListNLBsReqInfo{NLBId: nlbId})
+		if err != nil {
+			return false, err
+		}
+		return len(response.Listnlbsresponse.NLB) > 0, nil
+	})
+}
+
+// Returns the client of the endpoint that owns the firewall rule.
+func (z *ZoneAwareClient) ClientForFirewallRule(ctx context.Context, ruleId string) (*KtCloudClient, error) {
+	return z.clientForResource(ctx, "Firewall Rule", ruleId, func(ctx context.Context, c *KtCloudClient) (bool, error) {
+		response, err := c.ListFirewallRulesWithContext(ctx, ListFirewallRulesReqInfo{ID: ruleId})
+		if err != nil {
+			return false, err
+		}
+		return len(response.Listfirewallrulesresponse.FirewallRule) > 0, nil
+	})
+}
+
+// Returns the client of the endpoint that owns the port forwarding rule.
+func (z *ZoneAwareClient) ClientForPortForwardingRule(ctx context.Context, ruleId string) (*KtCloudClient, error) {
+	return z.clientForResource(ctx, "Port Forwarding Rule", ruleId, func(ctx context.Context, c *KtCloudClient) (bool, error) {
+		response, err := c.ListPortForwardingRulesWithContext(ctx, ListPortForwardingRulesReqInfo{ID: ruleId})
+		if err != nil {
+			return false, err
+		}
+		return len(response.Listportforwardingrulesresponse.PortForwardingRule) > 0, nil
+	})
+}
+
+// Returns the client of the endpoint that owns the Load-Balancer service (VM added to a Load-Balancer).
+// An unknown service is looked for in the services of every Load-Balancer.
+func (z *ZoneAwareClient) ClientForNLBVM(ctx context.Context, serviceId string) (*KtCloudClient, error) {
+	return z.clientForResource(ctx, "Load-Balancer service", serviceId, func(ctx context.Context, c *KtCloudClient) (bool, error) {
+		nlbs, err := c.ListNLBsWithContext(ctx, ListNLBsReqInfo{})
+		if err != nil {
+			return false, err
+		}
+		for _, nlb := range nlbs.Listnlbsresponse.NLB {
+			response, err := c.ListNLBVMsWithContext(ctx, nlb.NLBId.String())
+			if err != nil {
+				return false, err
+			}
+			for _, nlbVM := range response.Listnlbvmsresponse.NLBVM {
+				if nlbVM.ServiceId.String() == serviceId {
+					return true, nil
+				}
+			}
+		}
+		return false, nil
+	})
+}
+
+// Returns the client of the endpoint that owns the (user created) template.
+func (z *ZoneAwareClient) ClientForTemplate(ctx context.Context, templateId string) (*KtCloudClient, error) {
+	return z.clientForResource(ctx, "Template", templateId, func(ctx context.Context, c *KtCloudClient) (bool, error) {
+		templates, err := c.ListAllTemplatesWithContext(ctx, &ListTemplateReqInfo{TemplateFilter: TemplateFilterSelf})
+		if err != nil {
+			return false, err
+		}
+		for _, template := range templates {
+			if template.ID == templateId {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+}
+
+// Returns the client of the endpoint that owns the SSH key pair. When several endpoints have a key pair of that name, the earlier one wins.
+func (z *ZoneAwareClient) ClientForSSHKeyPair(ctx context.Context, name string) (*KtCloudClient, error) {
+	return z.clientForResource(ctx, "SSH Key Pair", name, func(ctx context.Context, c *KtCloudClient) (bool, error) {
+		response, err := c.ListSSHKeyPairsWithContext(ctx, name)
+		if err != nil {
+			return false, err
+		}
+		return len(response.Listsshkeypairsresponse.KeyPair) > 0, nil
+	})
+}
+
+// Returns the client of the endpoint that owns the resources of a tag call, by the first of them.
+// The resource types are matched regardless of case. (ex. 'userVm', 'Volume', 'Template')
+func (z *ZoneAwareClient) clientForTagged(ctx context.Context, resourceType string, resourceId string) (*KtCloudClient, error) {
+	if c := z.owner(resourceId); c != nil {
+		return c, nil
+	}
+	switch strings.ToLower(resourceType) {
+	case "uservm":
+		return z.ClientForVM(ctx, resourceId)
+	case "volume":
+		return z.ClientForVolume(ctx, resourceId)
+	case "template":
+		return z.ClientForTemplate(ctx, resourceId)
+	case "publicipaddress":
+		return z.ClientForPublicIp(ctx, resourceId)
+	case "firewallrule":
+		return z.ClientForFirewallRule(ctx, resourceId)
+	case "portforwardingrule":
+		return z.ClientForPortForwardingRule(ctx, resourceId)
+	}
+	return nil, fmt.Errorf("Failed to Find the Endpoint of the '%s' resource '%s' : the resource type is not supported", resourceType, resourceId)
+}
+
+// Returns the client of the endpoint that owns the async job. Only jobs started through this ZoneAwareClient are known.
+func (z *ZoneAwareClient) ClientForJob(jobId string) (*KtCloudClient, error) {
+	if c := z.owner(jobId); c != nil {
+		return c, nil
+	}
+	return nil, fmt.Errorf("The async job '%s' was not started through this client", jobId)
+}
+
+// clientForResource looks up the owner of a resource in the cache, and otherwise asks every endpoint with find().
+func (z *ZoneAwareClient) clientForResource(ctx context.Context, kind string, id string, find func(context.Context, *KtCloudClient) (bool, error)) (*KtCloudClient, error) {
+	if c := z.owner(id); c != nil {
+		return c, nil
+	}
+	for _, c := range z.clients {
+		found, err := find(ctx, c)
+		if err != nil {
+			if IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		if found {
+			z.remember(c, id)
+			return c, nil
+		}
+	}
+	return nil, fmt.Errorf("Failed to Find the %s '%s' on any KT Cloud API endpoint", kind, id)
+}
+
+// fromEveryEndpoint calls list on every endpoint and returns the responses, in the order of the endpoints.
+// It stops at the first error.
+func fromEveryEndpoint[T any](ctx context.Context, z *ZoneAwareClient, list func(context.Context, *KtCloudClient) (T, error)) ([]T, error) {
+	var responses []T
+	for _, c := range z.clients {
+		resp, err := list(ctx, c)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to Get the List from '%s' : %w", c.BaseURL, err)
+		}
+		responses = append(responses, resp)
+	}
+	return responses, nil
+}
+
+func (z *ZoneAwareClient) owner(id string) *KtCloudClient {
+	z.mu.RLock()
+	defer z.mu.RUnlock()
+	return z.owners[id]
+}
+
+func (z *ZoneAwareClient) remember(c *KtCloudClient, ids ...string) {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+	for _, id := range ids {
+		if id != "" {
+			z.owners[id] = c
+		}
+	}
+}
+
+// forget drops resources that were deleted, and async jobs that are over.
+func (z *ZoneAwareClient) forget(ids ...string) {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+	for _, id := range ids {
+		delete(z.owners, id)
+	}
+}
+
+// # Zone-scoped calls
+
+// Deploys a Virtual Machine on the endpoint serving vmReqInfo.ZoneId
+func (z *ZoneAwareClient) DeployVirtualMachine(ctx context.Context, vmReqInfo DeployVMReqInfo) (DeployVirtualMachineResponse, error) {
+	c, err := z.ClientForZone(ctx, vmReqInfo.ZoneId)
+	if err != nil {
+		return DeployVirtualMachineResponse{}, err
+	}
+	resp, err := c.DeployVirtualMachineWithContext(ctx, vmReqInfo)
+	if err == nil {
+		r := resp.Deployvirtualmachineresponse
+		z.remember(c, r.ID, r.JobId, r.RootId)
+	}
+	return resp, err
+}
+
+// Lists the VMs of vmListReqInfo.ZoneId, or of the VM given by vmListReqInfo.VMId.
+// Without both, the VMs of every endpoint are listed and merged.
+func (z *ZoneAwareClient) ListVirtualMachines(ctx context.Context, vmListReqInfo ListVMReqInfo) (ListVirtualMachinesResponse, error) {
+	if vmListReqInfo.ZoneId == "" && vmListReqInfo.VMId == "" {
+		responses, err := fromEveryEndpoint(ctx, z, func(ctx context.Context, c *KtCloudClient) (ListVirtualMachinesResponse, error) {
+			resp, err := c.ListVirtualMachinesWithContext(ctx, vmListReqInfo)
+			for _, vm := range resp.Listvirtualmachinesresponse.Virtualmachine {
+				z.remember(c, vm.ID)
+			}
+			return resp, err
+		})
+		var merged ListVirtualMachinesResponse
+		for _, resp := range responses {
+			r := &merged.Listvirtualmachinesresponse
+			r.Count += resp.Listvirtualmachinesresponse.Count
+			r.Virtualmachine = append(r.Virtualmachine, resp.Listvirtualmachinesresponse.Virtualmachine...)
+		}
+		return merged, err
+	}
+
+	var c *KtCloudClient
+	var err error
+	if vmListReqInfo.ZoneId != "" {
+		c, err = z.ClientForZone(ctx, vmListReqInfo.ZoneId)
+	} else {
+		c, err = z.ClientForVM(ctx, vmListReqInfo.VMId)
+	}
+	if err != nil {
+		return ListVirtualMachinesResponse{}, err
+	}
+	return c.ListVirtualMachinesWithContext(ctx, vmListReqInfo)
+}
+
+// Creates a Disk Volume on the endpoint serving req.ZoneId
+func (z *ZoneAwareClient) CreateVolume(ctx context.Context, req CreateVolumeReqInfo) (CreateVolumeResponse, error) {
+	c, err := z.ClientForZone(ctx, req.ZoneId)
+	if err != nil {
+		return CreateVolumeResponse{}, err
+	}
+	resp, err := c.CreateVolumeWithContext(ctx, req)
+	if err == nil {
+		r := resp.Createvolumeresponse
+		z.remember(c, r.ID, r.JobId, r.Volume.ID)
+	}
+	return resp, err
+}
+
+// Lists the Product Types of the zone
+func (z *ZoneAwareClient) ListAvailableProductTypes(ctx context.Context, zoneId string) (ListAvailableProductTypesResponse, error) {
+	c, err := z.ClientForZone(ctx, zoneId)
+	if err != nil {
+		return ListAvailableProductTypesResponse{}, err
+	}
+	return c.ListAvailableProductTypesWithContext(ctx, zoneId)
+}
+
+// Creates a Load-Balancer on the endpoint serving req.ZoneId
+func (z *ZoneAwareClient) CreateNLB(ctx context.Context, req CreateNLBReqInfo) (CreateNLBResponse, error) {
+	c, err := z.ClientForZone(ctx, req.ZoneId)
+	if err != nil {
+		return CreateNLBResponse{}, err
+	}
+	resp, err := c.CreateNLBWithContext(ctx, req)
+	if err == nil {
+		z.remember(c, resp.Createnlbresponse.NLBId.String())
+	}
+	return resp, err
+}
+
+// Allocates a Public IP on the endpoint serving ipReqInfo.ZoneId
+func (z *ZoneAwareClient) AssociateIpAddress(ctx context.Context, ipReqInfo AssociatePublicIpReqInfo) (AssociateIpAddressResponse, error) {
+	c, err := z.ClientForZone(ctx, ipReqInfo.ZoneId)
+	if err != nil {
+		return AssociateIpAddressResponse{}, err
+	}
+	resp, err := c.AssociateIpAddressWithContext(ctx, ipReqInfo)
+	if err == nil {
+		r := resp.Associateipaddressresponse
+		z.remember(c, r.ID, r.JobId)
+	}
+	return resp, err
+}
+
+// Lists the Public IPs of req.ZoneId, or the one given by req.ID.
+// Without both, the Public IPs of every endpoint are listed and merged.
+func (z *ZoneAwareClient) ListPublicIpAddresses(ctx context.Context, req ListPublicIpReqInfo) (ListPublicIpAddressesResponse, error) {
+	var c *KtCloudClient
+	var err error
+	switch {
+	case req.ZoneId != "":
+		c, err = z.ClientForZone(ctx, req.ZoneId)
+	case req.ID != "":
+		c, err = z.ClientForPublicIp(ctx, req.ID)
+	default:
+		responses, err := fromEveryEndpoint(ctx, z, func(ctx context.Context, c *KtCloudClient) (ListPublicIpAddressesResponse, error) {
+			resp, err := c.ListPublicIpAddressesWithContext(ctx, req)
+			for _, ip := range resp.Listpublicipaddressesresponse.PublicIpAddress {
+				z.remember(c, ip.ID)
+			}
+			return resp, err
+		})
+		var merged ListPublicIpAddressesResponse
+		for _, resp := range responses {
+			r := &merged.Listpublicipaddressesresponse
+			r.Count += resp.Listpublicipaddressesresponse.Count
+			r.PublicIpAddress = append(r.PublicIpAddress, resp.Listpublicipaddressesresponse.PublicIpAddress...)
+		}
+		return merged, err
+	}
+	if err != nil {
+		return ListPublicIpAddressesResponse{}, err
+	}
+	return c.ListPublicIpAddressesWithContext(ctx, req)
+}
+
+// Creates an SSH key pair on the endpoint serving the zone, where the VMs using it are to be deployed
+func (z *ZoneAwareClient) CreateSSHKeyPair(ctx context.Context, zoneId string, name string) (CreateSshKeyPairResponse, error) {
+	c, err := z.ClientForZone(ctx, zoneId)
+	if err != nil {
+		return CreateSshKeyPairResponse{}, err
+	}
+	resp, err := c.CreateSSHKeyPairWithContext(ctx, name)
+	if err == nil {
+		z.remember(c, name)
+	}
+	return resp, err
+}
+
+// Lists the SSH key pairs of every endpoint, merged. With a name, only the key pairs of that name are listed.
+func (z *ZoneAwareClient) ListSSHKeyPairs(ctx context.Context, name string) (ListSshKeyPairsResponse, error) {
+	responses, err := fromEveryEndpoint(ctx, z, func(ctx context.Context, c *KtCloudClient) (ListSshKeyPairsResponse, error) {
+		return c.ListSSHKeyPairsWithContext(ctx, name)
+	})
+	var merged ListSshKeyPairsResponse
+	for _, resp := range responses {
+		r := &merged.Listsshkeypairsresponse
+		r.Count += resp.Listsshkeypairsresponse.Count
+		r.KeyPair = append(r.KeyPair, resp.Listsshkeypairsresponse.KeyPair...)
+	}
+	return merged, err
+}
+
+// Lists the Disk Volumes of req.ZoneId, of the volume given by req.ID or of the VM given by req.VMId.
+// Without any of them, the volumes of every endpoint are listed and merged.
+func (z *ZoneAwareClient) ListVolumes(ctx context.Context, req ListVolumeReqInfo) (ListVolumesResponse, error) {
+	var c *KtCloudClient
+	var err error
+	switch {
+	case req.ZoneId != "":
+		c, err = z.ClientForZone(ctx, req.ZoneId)
+	case req.ID != "":
+		c, err = z.ClientForVolume(ctx, req.ID)
+	case req.VMId != "":
+		c, err = z.ClientForVM(ctx, req.VMId)
+	default:
+		responses, err := fromEveryEndpoint(ctx, z, func(ctx context.Context, c *KtCloudClient) (ListVolumesResponse, error) {
+			return c.ListVolumesWithContext(ctx, req)
+		})
+		var merged ListVolumesResponse
+		for _, resp := range responses {
+			r := &merged.Listvolumesresponse
+			r.Count += resp.Listvolumesresponse.Count
+			r.Volume = append(r.Volume, resp.Listvolumesresponse.Volume...)
+		}
+		return merged, err
+	}
+	if err != nil {
+		return ListVolumesResponse{}, err
+	}
+	return c.ListVolumesWithContext(ctx, req)
+}
+
+// Lists the Load-Balancers of req.ZoneId, or the one given by req.NLBId.
+// Without both, the Load-Balancers of every endpoint are listed and merged.
+func (z *ZoneAwareClient) ListNLBs(ctx context.Context, req ListNLBsReqInfo) (ListNLBsResponse, error) {
+	var c *KtCloudClient
+	var err error
+	switch {
+	case req.ZoneId != "":
+		c, err = z.ClientForZone(ctx, req.ZoneId)
+	case req.NLBId != "":
+		c, err = z.ClientForNLB(ctx, req.NLBId)
+	default:
+		responses, err := fromEveryEndpoint(ctx, z, func(ctx context.Context, c *KtCloudClient) (ListNLBsResponse, error) {
+			return c.ListNLBsWithContext(ctx, req)
+		})
+		var merged ListNLBsResponse
+		for _, resp := range responses {
+			r := &merged.Listnlbsresponse
+			r.Count += resp.Listnlbsresponse.Count
+			r.NLB = append(r.NLB, resp.Listnlbsresponse.NLB...)
+		}
+		return merged, err
+	}
+	if err != nil {
+		return ListNLBsResponse{}, err
+	}
+	return c.ListNLBsWithContext(ctx, req)
+}
+
+// Lists the templates of every endpoint, merged. The paging of req applies to each endpoint.
+func (z *ZoneAwareClient) ListTemplates(ctx context.Context, req *ListTemplateReqInfo) (ListTemplatesResponse, error) {
+	responses, err := fromEveryEndpoint(ctx, z, func(ctx context.Context, c *KtCloudClient) (ListTemplatesResponse, error) {
+		return c.ListTemplatesWithContext(ctx, req)
+	})
+	var merged ListTemplatesResponse
+	for _, resp := range responses {
+		r := &merged.Listtemplatesresponse
+		r.Count += resp.Listtemplatesresponse.Count
+		r.Template = append(r.Template, resp.Listtemplatesresponse.Template...)
+	}
+	return merged, err
+}
+
+// Deletes a template in the zone, on the endpoint serving it
+func (z *ZoneAwareClient) DeleteTemplate(ctx context.Context, id string, zoneId string) (DeleteTemplateResponse, error) {
+	c, err := z.ClientForZone(ctx, zoneId)
+	if err != nil {
+		return DeleteTemplateResponse{}, err
+	}
+	resp, err := c.DeleteTemplateWithContext(ctx, id, zoneId)
+	if err == nil {
+		z.forget(id)
+		z.remember(c, resp.Deletetemplateresponse.JobId)
+	}
+	return resp, err
+}
+
+// # ID-based calls
+
+// Starts a Virtual Machine on the endpoint owning it
+func (z *ZoneAwareClient) StartVirtualMachine(ctx context.Context, vmId string) (StartVirtualMachineResponse, error) {
+	c, err := z.ClientForVM(ctx, vmId)
+	if err != nil {
+		return StartVirtualMachineResponse{}, err
+	}
+	resp, err := c.StartVirtualMachineWithContext(ctx, vmId)
+	if err == nil {
+		z.remember(c, resp.Startvirtualmachineresponse.JobId)
+	}
+	return resp, err
+}
+
+// Stops a Virtual Machine on the endpoint owning it
+func (z *ZoneAwareClient) StopVirtualMachine(ctx context.Context, vmId string) (StopVirtualMachineResponse, error) {
+	c, err := z.ClientForVM(ctx, vmId)
+	if err != nil {
+		return StopVirtualMachineResponse{}, err
+	}
+	resp, err := c.StopVirtualMachineWithContext(ctx, vmId)
+	if err == nil {
+		z.remember(c, resp.Stopvirtualmachineresponse.JobId)
+	}
+	return resp, err
+}
+
+// Reboots a Virtual Machine on the endpoint owning it
+func (z *ZoneAwareClient) RebootVirtualMachine(ctx context.Context, vmId string) (RebootVirtualMachineResponse, error) {
+	c, err := z.ClientForVM(ctx, vmId)
+	if err != nil {
+		return RebootVirtualMachineResponse{}, err
+	}
+	resp, err := c.RebootVirtualMachineWithContext(ctx, vmId)
+	if err == nil {
+		z.remember(c, resp.Rebootvirtualmachineresponse.JobId)
+	}
+	return resp, err
+}
+
+// Destroys a Virtual Machine on the endpoint owning it
+func (z *ZoneAwareClient) DestroyVirtualMachine(ctx context.Context, vmId string) (DestroyVirtualMachineResponse, error) {
+	c, err := z.ClientForVM(ctx, vmId)
+	if err != nil {
+		return DestroyVirtualMachineResponse{}, err
+	}
+	resp, err := c.DestroyVirtualMachineWithContext(ctx, vmId)
+	if err == nil {
+		z.forget(vmId)
+		z.remember(c, resp.Destroyvirtualmachineresponse.JobId)
+	}
+	return resp, err
+}
+
+// Attaches a Disk Volume to a VM on the endpoint owning the VM
+func (z *ZoneAwareClient) AttachVolume(ctx context.Context, req AttachVolumeReqInfo) (AttachVolumeResponse, error) {
+	c, err := z.ClientForVM(ctx, req.VMId)
+	if err != nil {
+		return AttachVolumeResponse{}, err
+	}
+	resp, err := c.AttachVolumeWithContext(ctx, req)
+	if err == nil {
+		z.remember(c, resp.Attachvolumeresponse.JobId)
+	}
+	return resp, err
+}
+
+// Detaches a Disk Volume on the endpoint owning it
+func (z *ZoneAwareClient) DetachVolume(ctx context.Context, req DetachVolumeReqInfo) (DetachVolumeResponse, error) {
+	c, err := z.ClientForVolume(ctx, req.ID)
+	if err != nil {
+		return DetachVolumeResponse{}, err
+	}
+	resp, err := c.DetachVolumeWithContext(ctx, req)
+	if err == nil {
+		z.remember(c, resp.Detachvolumeresponse.JobId)
+	}
+	return resp, err
+}
+
+// Deletes a Disk Volume on the endpoint owning it
+func (z *ZoneAwareClient) DeleteVolume(ctx context.Context, volumeId string) (DeleteVolumeResponse, error) {
+	c, err := z.ClientForVolume(ctx, volumeId)
+	if err != nil {
+		return DeleteVolumeResponse{}, err
+	}
+	resp, err := c.DeleteVolumeWithContext(ctx, volumeId)
+	if err == nil {
+		z.forget(volumeId)
+	}
+	return resp, err
+}
+
+// Releases a Public IP on the endpoint owning it
+func (z *ZoneAwareClient) DisassociateIpAddress(ctx context.Context, publicIpId string) (DisassociateIpAddressResponse, error) {
+	c, err := z.ClientForPublicIp(ctx, publicIpId)
+	if err != nil {
+		return DisassociateIpAddressResponse{}, err
+	}
+	resp, err := c.DisassociateIpAddressWithContext(ctx, publicIpId)
+	if err == nil {
+		z.forget(publicIpId)
+		z.remember(c, resp.Disassociateipaddressresponse.JobId)
+	}
+	return resp, err
+}
+
+// Deletes a Load-Balancer on the endpoint owning it
+func (z *ZoneAwareClient) DeleteNLB(ctx context.Context, nlbId string) (DeleteNLBResponse, error) {
+	c, err := z.ClientForNLB(ctx, nlbId)
+	if err != nil {
+		return DeleteNLBResponse{}, err
+	}
+	resp, err := c.DeleteNLBWithContext(ctx, nlbId)
+	if err == nil {
+		z.forget(nlbId)
+	}
+	return resp, err
+}
+
+// Updates a Virtual Machine on the endpoint owning it
+func (z *ZoneAwareClient) UpdateVirtualMachine(ctx context.Context, vmId string, displayname string, haenable string) (UpdateVirtualMachineResponse, error) {
+	c, err := z.ClientForVM(ctx, vmId)
+	if err != nil {
+		return UpdateVirtualMachineResponse{}, err
+	}
+	return c.UpdateVirtualMachineWithContext(ctx, vmId, displayname, haenable)
+}
+
+// Resizes a Disk Volume on the endpoint owning it
+func (z *ZoneAwareClient) ResizeVolume(ctx context.Context, req ResizeVolumeReqInfo) (ResizeVolumeResponse, error) {
+	c, err := z.ClientForVolume(ctx, req.ID)
+	if err != nil {
+		return ResizeVolumeResponse{}, err
+	}
+	resp, err := c.ResizeVolumeWithContext(ctx, req)
+	if err == nil {
+		z.remember(c, resp.Resizevolumeresponse.JobId)
+	}
+	return resp, err
+}
+
+// Creates a firewall rule of a Public IP on the endpoint owning the Public IP
+func (z *ZoneAwareClient) CreateFirewallRule(ctx context.Context, req CreateFirewallRuleReqInfo) (CreateFirewallRuleResponse, error) {
+	c, err := z.ClientForPublicIp(ctx, req.IpAddressId)
+	if err != nil {
+		return CreateFirewallRuleResponse{}, err
+	}
+	resp, err := c.CreateFirewallRuleWithContext(ctx, req)
+	if err == nil {
+		r := resp.Createfirewallruleresponse
+		z.remember(c, r.ID, r.JobId)
+	}
+	return resp, err
+}
+
+// Lists the firewall rules of the Public IP given by req.IpAddressId, or the one given by req.ID.
+// Without both, the rules of every endpoint are listed and merged.
+func (z *ZoneAwareClient) ListFirewallRules(ctx context.Context, req ListFirewallRulesReqInfo) (ListFirewallRulesResponse, error) {
+	var c *KtCloudClient
+	var err error
+	switch {
+	case req.IpAddressId != "":
+		c, err = z.ClientForPublicIp(ctx, req.IpAddressId)
+	case req.ID != "":
+		c, err = z.ClientForFirewallRule(ctx, req.ID)
+	default:
+		responses, err := fromEveryEndpoint(ctx, z, func(ctx context.Context, c *KtCloudClient) (ListFirewallRulesResponse, error) {
+			return c.ListFirewallRulesWithContext(ctx, req)
+		})
+		var merged ListFirewallRulesResponse
+		for _, resp := range responses {
+			r := &merged.Listfirewallrulesresponse
+			r.Count += resp.Listfirewallrulesresponse.Count
+			r.FirewallRule = append(r.FirewallRule, resp.Listfirewallrulesresponse.FirewallRule...)
+		}
+		return merged, err
+	}
+	if err != nil {
+		return ListFirewallRulesResponse{}, err
+	}
+	return c.ListFirewallRulesWithContext(ctx, req)
+}
+
+// Deletes a firewall rule on the endpoint owning it
+func (z *ZoneAwareClient) DeleteFirewallRule(ctx context.Context, ruleId string) (DeleteFirewallRuleResponse, error) {
+	c, err := z.ClientForFirewallRule(ctx, ruleId)
+	if err != nil {
+		return DeleteFirewallRuleResponse{}, err
+	}
+	resp, err := c.DeleteFirewallRuleWithContext(ctx, ruleId)
+	if err == nil {
+		z.forget(ruleId)
+		z.remember(c, resp.Deletefirewallruleresponse.JobId)
+	}
+	return resp, err
+}
+
+// Creates a port forwarding rule of a Public IP on the endpoint owning the Public IP
+func (z *ZoneAwareClient) CreatePortForwardingRule(ctx context.Context, req CreatePortForwardingRuleReqInfo) (CreatePortForwardingRuleResponse, error) {
+	c, err := z.ClientForPublicIp(ctx, req.IpAddressId)
+	if err != nil {
+		return CreatePortForwardingRuleResponse{}, err
+	}
+	resp, err := c.CreatePortForwardingRuleWithContext(ctx, req)
+	if err == nil {
+		r := resp.Createportforwardingruleresponse
+		z.remember(c, r.ID, r.JobId)
+	}
+	return resp, err
+}
+
+// Lists the port forwarding rules of the Public IP given by req.IpAddressId, or the one given by req.ID.
+// Without both, the rules of every endpoint are listed and merged.
+func (z *ZoneAwareClient) ListPortForwardingRules(ctx context.Context, req ListPortForwardingRulesReqInfo) (ListPortForwardingRulesResponse, error) {
+	var c *KtCloudClient
+	var err error
+	switch {
+	case req.IpAddressId != "":
+		c, err = z.ClientForPublicIp(ctx, req.IpAddressId)
+	case req.ID != "":
+		c, err = z.ClientForPortForwardingRule(ctx, req.ID)
+	default:
+		responses, err := fromEveryEndpoint(ctx, z, func(ctx context.Context, c *KtCloudClient) (ListPortForwardingRulesResponse, error) {
+			return c.ListPortForwardingRulesWithContext(ctx, req)
+		})
+		var merged ListPortForwardingRulesResponse
+		for _, resp := range responses {
+			r := &merged.Listportforwardingrulesresponse
+			r.Count += resp.Listportforwardingrulesresponse.Count
+			r.PortForwardingRule = append(r.PortForwardingRule, resp.Listportforwardingrulesresponse.PortForwardingRule...)
+		}
+		return merged, err
+	}
+	if err != nil {
+		return ListPortForwardingRulesResponse{}, err
+	}
+	return c.ListPortForwardingRulesWithContext(ctx, req)
+}
+
+// Deletes a port forwarding rule on the endpoint owning it
+func (z *ZoneAwareClient) DeletePortForwardingRule(ctx context.Context, ruleId string) (DeletePortForwardingRuleResponse, error) {
+	c, err := z.ClientForPortForwardingRule(ctx, ruleId)
+	if err != nil {
+		return DeletePortForwardingRuleResponse{}, err
+	}
+	resp, err := c.DeletePortForwardingRuleWithContext(ctx, ruleId)
+	if err == nil {
+		z.forget(ruleId)
+		z.remember(c, resp.Deleteportforwardingruleresponse.JobId)
+	}
+	return resp, err
+}
+
+// Deletes an SSH key pair on the endpoint owning it
+func (z *ZoneAwareClient) DeleteSSHKeyPair(ctx context.Context, name string) (DeleteSshKeyPairResponse, error) {
+	c, err := z.ClientForSSHKeyPair(ctx, name)
+	if err != nil {
+		return DeleteSshKeyPairResponse{}, err
+	}
+	resp, err := c.DeleteSSHKeyPairWithContext(ctx, name)
+	if err == nil {
+		z.forget(name)
+	}
+	return resp, err
+}
+
+// Adds a VM to a Load-Balancer on the endpoint owning the Load-Balancer
+func (z *ZoneAwareClient) AddNLBVM(ctx context.Context, req AddNLBVMReqInfo) (AddNLBVMResponse, error) {
+	c, err := z.ClientForNLB(ctx, req.NLBId)
+	if err != nil {
+		return AddNLBVMResponse{}, err
+	}
+	resp, err := c.AddNLBVMWithContext(ctx, req)
+	if err == nil {
+		z.remember(c, resp.Addnlbvmresponse.ServiceId.String())
+	}
+	return resp, err
+}
+
+// Lists the VMs of a Load-Balancer on the endpoint owning it
+func (z *ZoneAwareClient) ListNLBVMs(ctx context.Context, nlbId string) (ListNLBVMsResponse, error) {
+	c, err := z.ClientForNLB(ctx, nlbId)
+	if err != nil {
+		return ListNLBVMsResponse{}, err
+	}
+	return c.ListNLBVMsWithContext(ctx, nlbId)
+}
+
+// Removes a VM from a Load-Balancer on the endpoint owning the service
+func (z *ZoneAwareClient) RemoveNLBVM(ctx context.Context, serviceId string) (RemoveNLBVMResponse, error) {
+	c, err := z.ClientForNLBVM(ctx, serviceId)
+	if err != nil {
+		return RemoveNLBVMResponse{}, err
+	}
+	resp, err := c.RemoveNLBVMWithContext(ctx, serviceId)
+	if err == nil {
+		z.forget(serviceId)
+	}
+	return resp, err
+}
+
+// Creates a template from a Disk Volume on the endpoint owning the volume
+func (z *ZoneAwareClient) CreateTemplate(ctx context.Context, req *CreateTemplateReqInfo) (CreateTemplateResponse, error) {
+	c, err := z.ClientForVolume(ctx, req.VolumeId)
+	if err != nil {
+		return CreateTemplateResponse{}, err
+	}
+	resp, err := c.CreateTemplateWithContext(ctx, req)
+	if err == nil {
+		r := resp.Createtemplateresponse
+		z.remember(c, r.ID, r.JobId)
+	}
+	return resp, err
+}
+
+// Adds tags to resources on the endpoint owning them. The resources must be on the same endpoint.
+func (z *ZoneAwareClient) CreateTags(ctx context.Context, options *CreateTagsReqInfo) (CreateTagsResponse, error) {
+	if len(options.ResourceIds) == 0 {
+		return CreateTagsResponse{}, options.Validate()
+	}
+	c, err := z.clientForTagged(ctx, options.ResourceType, options.ResourceIds[0])
+	if err != nil {
+		return CreateTagsResponse{}, err
+	}
+	resp, err := c.CreateTagsWithContext(ctx, options)
+	if err == nil {
+		z.remember(c, resp.Createtagsresponse.JobId)
+	}
+	return resp, err
+}
+
+// Lists the tags of the resource given by options.ResourceIds, on the endpoint owning it.
+// Without it, the tags of every endpoint are listed and merged.
+func (z *ZoneAwareClient) ListTags(ctx context.Context, options *ListTagsReqInfo) (ListTagsResponse, error) {
+	if options.ResourceIds == "" {
+		responses, err := fromEveryEndpoint(ctx, z, func(ctx context.Context, c *KtCloudClient) (ListTagsResponse, error) {
+			return c.ListTagsWithContext(ctx, options)
+		})
+		var merged ListTagsResponse
+		for _, resp := range responses {
+			r := &merged.Listtagsresponse
+			r.Count += resp.Listtagsresponse.Count
+			r.Tag = append(r.Tag, resp.Listtagsresponse.Tag...)
+		}
+		return merged, err
+	}
+
+	c, err := z.clientForTagged(ctx, options.ResourceType, options.ResourceIds)
+	if err != nil {
+		return ListTagsResponse{}, err
+	}
+	return c.ListTagsWithContext(ctx, options)
+}
+
+// Removes tags from resources on the endpoint owning them. The resources must be on the same endpoint.
+func (z *ZoneAwareClient) DeleteTags(ctx context.Context, options *DeleteTagsReqInfo) (DeleteTagsResponse, error) {
+	if len(options.ResourceIds) == 0 {
+		return DeleteTagsResponse{}, options.Validate()
+	}
+	c, err := z.clientForTagged(ctx, options.ResourceType, options.ResourceIds[0])
+	if err != nil {
+		return DeleteTagsResponse{}, err
+	}
+	resp, err := c.DeleteTagsWithContext(ctx, options)
+	if err == nil {
+		z.remember(c, resp.Deletetagsresponse.JobId)
+	}
+	return resp, err
+}
+
+// Waits for an async job started through this client, on the endpoint that runs it
+func (z *ZoneAwareClient) WaitForAsyncJob(ctx context.Context, jobId string, timeOut time.Duration) error {
+	c, err := z.ClientForJob(jobId)
+	if err != nil {
+		return err
+	}
+	err = c.WaitForAsyncJobWithContext(ctx, jobId, timeOut)
+	// The job is over when it succeeded, or when KT Cloud answered with its failure. After a timeout, it may be waited for again.
+	var apiErr *APIError
+	if err == nil || errors.As(err, &apiErr) && apiErr.HTTPStatus == http.StatusOK {
+		z.forget(jobId)
+	}
+	return err
+}
+
+// Queries the status of an async job started through this client, on the endpoint that runs it
+func (z *ZoneAwareClient) QueryAsyncJobResult(ctx context.Context, jobId string) (QueryAsyncJobResultResponse, error) {
+	c, err := z.ClientForJob(jobId)
+	if err != nil {
+		return QueryAsyncJobResultResponse{}, err
+	}
+	resp, err := c.QueryAsyncJobResultWithContext(ctx, jobId)
+	if err == nil && resp.Queryasyncjobresultresponse.JobStatus != 0 { // 1 - Succeeded, 2 - Failed
+		z.forget(jobId)
+	}
+	return resp, err
+}
+
+// Waits for a VM to reach wantedState, on the endpoint owning it
+func (z *ZoneAwareClient) WaitForVirtualMachineState(ctx context.Context, vmId string, wantedState string, timeOut time.Duration) error {
+	c, err := z.ClientForVM(ctx, vmId)
+	if err != nil {
+		return err
+	}
+	return c.WaitForVirtualMachineStateWithContext(ctx, "", vmId, wantedState, timeOut)
+}
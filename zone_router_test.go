@@ -0,0 +1,207 @@
+// Proof of Concepts for the Cloud-Barista Multi-Cloud Project.
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// KT Cloud SDK go
+//
+// by ETRI, 2026.10.
+
+package ktcloudsdk_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ktsdk "github.com/cloud-barista/ktcloud-sdk-go"
+	"github.com/cloud-barista/ktcloud-sdk-go/ktcloudtest"
+)
+
+// newZoneAwareTest starts two fake endpoints and a ZoneAwareClient routing to them.
+func newZoneAwareTest(t *testing.T, opts ...ktsdk.ClientOption) (*ktcloudtest.Server, *ktcloudtest.Server, *ktsdk.ZoneAwareClient) {
+	t.Helper()
+	v1, v2 := ktcloudtest.NewServer(), ktcloudtest.NewServer()
+	t.Cleanup(v1.Close)
+	t.Cleanup(v2.Close)
+	z := ktsdk.NewZoneAwareClientWithEndpoints([]string{v1.URL, v2.URL}, ktcloudtest.DefaultAPIKey, ktcloudtest.DefaultSecretKey, false, opts...)
+	return v1, v2, z
+}
+
+func TestZoneAwareListVirtualMachines(t *testing.T) {
+	v1, v2, z := newZoneAwareTest(t)
+	ctx := context.Background()
+
+	vmIds := map[string]bool{}
+	for _, srv := range []*ktcloudtest.Server{v1, v2} {
+		vmIds[deployRunningVM(t, srv.Client())] = true
+	}
+
+	resp, err := z.ListVirtualMachines(ctx, ktsdk.ListVMReqInfo{})
+	if err != nil {
+		t.Fatalf("ListVirtualMachines() = %v", err)
+	}
+	r := resp.Listvirtualmachinesresponse
+	if r.Count != 2 || len(r.Virtualmachine) != 2 {
+		t.Fatalf("ListVirtualMachines() listed %d VMs (count %d), want the 2 of both endpoints", len(r.Virtualmachine), r.Count)
+	}
+	for _, vm := range r.Virtualmachine {
+		if !vmIds[vm.ID] {
+			t.Errorf("unexpected VM '%s'", vm.ID)
+		}
+	}
+
+	// The VM of the second endpoint is routed there.
+	for id := range vmIds {
+		resp, err := z.ListVirtualMachines(ctx, ktsdk.ListVMReqInfo{VMId: id})
+		if err != nil || len(resp.Listvirtualmachinesresponse.Virtualmachine) != 1 {
+			t.Errorf("ListVirtualMachines(VMId: %s) = %v, %v", id, resp.Listvirtualmachinesresponse.Virtualmachine, err)
+		}
+	}
+}
+
+// commandCounter counts the commands sent by the clients it is installed in.
+type commandCounter struct {
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+func (cc *commandCounter) intercept(ctx context.Context, command string, params url.Values, out interface{}, next ktsdk.Invoker) error {
+	cc.mu.Lock()
+	if cc.counts == nil {
+		cc.counts = map[string]int{}
+	}
+	cc.counts[command]++
+	cc.mu.Unlock()
+	return next(ctx, command, params, out)
+}
+
+func (cc *commandCounter) count(command string) int {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	return cc.counts[command]
+}
+
+func TestZoneAwareRouting(t *testing.T) {
+	var counter commandCounter
+	_, v2, z := newZoneAwareTest(t, ktsdk.WithInterceptors(counter.intercept))
+	ctx := context.Background()
+
+	// The resources live on the second endpoint only.
+	vmId := deployRunningVM(t, v2.Client())
+	ip, err := v2.Client().AssociateIpAddress(ktsdk.AssociatePublicIpReqInfo{ZoneId: ktcloudtest.ZoneSeoulM})
+	if err != nil {
+		t.Fatalf("AssociateIpAddress() = %v", err)
+	}
+	ipId := ip.Associateipaddressresponse.ID
+
+	if _, err := z.UpdateVirtualMachine(ctx, vmId, "renamed", ""); err != nil {
+		t.Errorf("UpdateVirtualMachine() = %v", err)
+	}
+
+	rule, err := z.CreateFirewallRule(ctx, ktsdk.CreateFirewallRuleReqInfo{IpAddressId: ipId, Protocol: ktsdk.ProtocolTCP, StartPort: "22", EndPort: "22"})
+	if err != nil {
+		t.Fatalf("CreateFirewallRule() = %v", err)
+	}
+	if err := z.WaitForAsyncJob(ctx, rule.Createfirewallruleresponse.JobId, 10*time.Second); err != nil {
+		t.Fatalf("WaitForAsyncJob() = %v", err)
+	}
+	if _, err := z.QueryAsyncJobResult(ctx, rule.Createfirewallruleresponse.JobId); err == nil {
+		t.Errorf("QueryAsyncJobResult() of a job that is over = nil, want an error as it is forgotten")
+	}
+	rules, err := z.ListFirewallRules(ctx, ktsdk.ListFirewallRulesReqInfo{})
+	if err != nil || rules.Listfirewallrulesresponse.Count != 1 {
+		t.Fatalf("ListFirewallRules() = %+v, %v, want the rule", rules.Listfirewallrulesresponse, err)
+	}
+
+	ruleId := rule.Createfirewallruleresponse.ID
+	if _, err := z.DeleteFirewallRule(ctx, ruleId); err != nil {
+		t.Fatalf("DeleteFirewallRule() = %v", err)
+	}
+	// A deleted rule is forgotten, so it is looked for again (and not found).
+	lookups := counter.count("listFirewallRules")
+	if _, err := z.DeleteFirewallRule(ctx, ruleId); err == nil {
+		t.Errorf("DeleteFirewallRule() of a deleted rule = nil, want an error")
+	}
+	if counter.count("listFirewallRules") == lookups {
+		t.Errorf("the deleted rule '%s' was still routed from the cache", ruleId)
+	}
+
+	ips, err := z.ListPublicIpAddresses(ctx, ktsdk.ListPublicIpReqInfo{})
+	if err != nil || ips.Listpublicipaddressesresponse.Count != 1 {
+		t.Errorf("ListPublicIpAddresses() = %+v, %v, want the Public IP", ips.Listpublicipaddressesresponse, err)
+	}
+	if ips, err := z.ListPublicIpAddresses(ctx, ktsdk.ListPublicIpReqInfo{ID: ipId}); err != nil || ips.Listpublicipaddressesresponse.Count != 1 {
+		t.Errorf("ListPublicIpAddresses(ID: %s) = %+v, %v, want the Public IP", ipId, ips.Listpublicipaddressesresponse, err)
+	}
+
+	if _, err := v2.Client().CreateSSHKeyPair("key"); err != nil {
+		t.Fatalf("CreateSSHKeyPair() = %v", err)
+	}
+	keys, err := z.ListSSHKeyPairs(ctx, "")
+	if err != nil || keys.Listsshkeypairsresponse.Count != 1 {
+		t.Errorf("ListSSHKeyPairs() = %+v, %v, want the key pair", keys.Listsshkeypairsresponse, err)
+	}
+	if _, err := z.DeleteSSHKeyPair(ctx, "key"); err != nil {
+		t.Errorf("DeleteSSHKeyPair() = %v", err)
+	}
+	if _, err := z.CreateSSHKeyPair(ctx, ktcloudtest.ZoneSeoulM, "key"); err != nil {
+		t.Errorf("CreateSSHKeyPair() = %v", err)
+	}
+
+	if _, err := z.CreateTags(ctx, &ktsdk.CreateTagsReqInfo{ResourceType: "userVm", ResourceIds: []string{vmId}, Tags: []ktsdk.TagArg{{Key: "env", Value: "test"}}}); err != nil {
+		t.Fatalf("CreateTags() = %v", err)
+	}
+	tags, err := z.ListTags(ctx, &ktsdk.ListTagsReqInfo{})
+	if err != nil || tags.Listtagsresponse.Count != 1 {
+		t.Errorf("ListTags() = %+v, %v, want the tag", tags.Listtagsresponse, err)
+	}
+}
+
+func TestZoneAwareUnknownZone(t *testing.T) {
+	var counter commandCounter
+	_, _, z := newZoneAwareTest(t, ktsdk.WithInterceptors(counter.intercept))
+	ctx := context.Background()
+
+	if _, err := z.ClientForZone(ctx, ktcloudtest.ZoneSeoulM); err != nil {
+		t.Fatalf("ClientForZone() = %v", err)
+	}
+	discoveries := counter.count("listZones")
+	for i := 0; i < 3; i++ {
+		if _, err := z.ClientForZone(ctx, "no-such-zone"); err == nil {
+			t.Fatalf("ClientForZone() of an unknown zone = nil, want an error")
+		}
+	}
+	if got := counter.count("listZones"); got != discoveries {
+		t.Errorf("unknown zones made %d more 'listZones' calls, want none right after a discovery", got-discoveries)
+	}
+}
+
+func TestZoneAwareRefresh(t *testing.T) {
+	v1, v2, z := newZoneAwareTest(t, ktsdk.WithRetryPolicy(nil))
+	ctx := context.Background()
+
+	// The zones of the endpoints that answer are used.
+	v1.InjectFault(ktcloudtest.Fault{Command: "listZones", HTTPStatus: http.StatusInternalServerError})
+	zones, err := z.ListZones(ctx)
+	if err != nil || len(zones) != 2 {
+		t.Fatalf("ListZones() = %v, %v, want the zones of the second endpoint", zones, err)
+	}
+	if c, err := z.ClientForZone(ctx, ktcloudtest.ZoneSeoulM); err != nil || c.BaseURL != v2.URL {
+		t.Errorf("ClientForZone() = %v, want the second endpoint", err)
+	}
+	if err := z.Refresh(ctx); err == nil || !strings.Contains(err.Error(), v1.URL) {
+		t.Errorf("Refresh() = %v, want the error of the first endpoint", err)
+	}
+
+	// The zones are kept when no endpoint answers.
+	v2.InjectFault(ktcloudtest.Fault{Command: "listZones", HTTPStatus: http.StatusInternalServerError})
+	if err := z.Refresh(ctx); err == nil {
+		t.Errorf("Refresh() = nil, want an error")
+	}
+	if c, err := z.ClientForZone(ctx, ktcloudtest.ZoneSeoulM); err != nil || c.BaseURL != v2.URL {
+		t.Errorf("ClientForZone() = %v after a failed refresh, want the second endpoint", err)
+	}
+}